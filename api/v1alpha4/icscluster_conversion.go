@@ -17,32 +17,69 @@ limitations under the License.
 package v1alpha4
 
 import (
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-	infrav1beta1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
 )
 
 
-// ConvertTo converts this ICSCluster to the Hub version (v1beta1).
+// ConvertTo converts this ICSCluster to the Hub version (v1beta2).
 func (src *ICSCluster) ConvertTo(dstRaw conversion.Hub) error {
-	dst := dstRaw.(*infrav1beta1.ICSCluster)
-	return Convert_v1alpha4_ICSCluster_To_v1beta1_ICSCluster(src, dst, nil)
+	dst := dstRaw.(*infrav1beta2.ICSCluster)
+	if err := Convert_v1alpha4_ICSCluster_To_v1beta2_ICSCluster(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1beta2.ICSCluster{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restoreICSClusterSpec(&restored.Spec, &dst.Spec)
+	dst.Status.Network = restored.Status.Network
+	dst.Status.SecurityGroups = restored.Status.SecurityGroups
+	dst.Status.LoadBalancer = restored.Status.LoadBalancer
+	dst.Status.LoadBalancerEnabled = restored.Status.LoadBalancerEnabled
+
+	return nil
 }
 
-// ConvertFrom converts from the Hub version (v1beta1) to this ICSCluster.
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSCluster.
 func (dst *ICSCluster) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*infrav1beta1.ICSCluster)
-	return Convert_v1beta1_ICSCluster_To_v1alpha4_ICSCluster(src, dst, nil)
+	src := srcRaw.(*infrav1beta2.ICSCluster)
+	if err := Convert_v1beta2_ICSCluster_To_v1alpha4_ICSCluster(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
 }
 
-// ConvertTo converts this ICSClusterList to the Hub version (v1beta1).
+// ConvertTo converts this ICSClusterList to the Hub version (v1beta2).
 func (src *ICSClusterList) ConvertTo(dstRaw conversion.Hub) error {
-	dst := dstRaw.(*infrav1beta1.ICSClusterList)
-	return Convert_v1alpha4_ICSClusterList_To_v1beta1_ICSClusterList(src, dst, nil)
+	dst := dstRaw.(*infrav1beta2.ICSClusterList)
+	return Convert_v1alpha4_ICSClusterList_To_v1beta2_ICSClusterList(src, dst, nil)
 }
 
-// ConvertFrom converts this ICSVM to the Hub version (v1beta1).
+// ConvertFrom converts this ICSVM to the Hub version (v1beta2).
 func (dst *ICSClusterList) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*infrav1beta1.ICSClusterList)
-	return Convert_v1beta1_ICSClusterList_To_v1alpha4_ICSClusterList(src, dst, nil)
-}
\ No newline at end of file
+	src := srcRaw.(*infrav1beta2.ICSClusterList)
+	return Convert_v1beta2_ICSClusterList_To_v1alpha4_ICSClusterList(src, dst, nil)
+}
+
+// restoreICSClusterSpec restores the fields of the spec that only exist in
+// the Hub version.
+func restoreICSClusterSpec(restored, dst *infrav1beta2.ICSClusterSpec) {
+	dst.Network = restored.Network
+	dst.ManagedSecurityGroups = restored.ManagedSecurityGroups
+	dst.LoadBalancer = restored.LoadBalancer
+	dst.ControlPlaneVIP = restored.ControlPlaneVIP
+	dst.LoadBalancerRef = restored.LoadBalancerRef
+	dst.MachineDefaults = restored.MachineDefaults
+}
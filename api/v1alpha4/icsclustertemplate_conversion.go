@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
+)
+
+// ConvertTo converts this ICSClusterTemplate to the Hub version (v1beta2).
+func (src *ICSClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSClusterTemplate)
+	if err := Convert_v1alpha4_ICSClusterTemplate_To_v1beta2_ICSClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1beta2.ICSClusterTemplate{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restoreICSClusterSpec(&restored.Spec.Template.Spec, &dst.Spec.Template.Spec)
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSClusterTemplate.
+func (dst *ICSClusterTemplate) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSClusterTemplate)
+	if err := Convert_v1beta2_ICSClusterTemplate_To_v1alpha4_ICSClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertTo converts this ICSClusterTemplateList to the Hub version (v1beta2).
+func (src *ICSClusterTemplateList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSClusterTemplateList)
+	return Convert_v1alpha4_ICSClusterTemplateList_To_v1beta2_ICSClusterTemplateList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSClusterTemplateList.
+func (dst *ICSClusterTemplateList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSClusterTemplateList)
+	return Convert_v1beta2_ICSClusterTemplateList_To_v1alpha4_ICSClusterTemplateList(src, dst, nil)
+}
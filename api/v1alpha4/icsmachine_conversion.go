@@ -21,40 +21,51 @@ import (
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-	infrav1beta1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
 )
 
 
-// ConvertTo converts this ICSMachine to the Hub version (v1beta1).
+// ConvertTo converts this ICSMachine to the Hub version (v1beta2).
 func (src *ICSMachine) ConvertTo(dstRaw conversion.Hub) error {
-	dst := dstRaw.(*infrav1beta1.ICSMachine)
-	if err := Convert_v1alpha4_ICSMachine_To_v1beta1_ICSMachine(src, dst, nil); err != nil {
+	dst := dstRaw.(*infrav1beta2.ICSMachine)
+	if err := Convert_v1alpha4_ICSMachine_To_v1beta2_ICSMachine(src, dst, nil); err != nil {
 		return err
 	}
 
 	// Manually restore data.
-	restored := &infrav1beta1.ICSMachine{}
+	restored := &infrav1beta2.ICSMachine{}
 	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
 		return err
 	}
 
+	restoreVirtualMachineCloneSpec(&restored.Spec.VirtualMachineCloneSpec, &dst.Spec.VirtualMachineCloneSpec)
+
 	return nil
 }
 
-// ConvertFrom converts from the Hub version (v1beta1) to this ICSMachine.
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSMachine.
 func (dst *ICSMachine) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*infrav1beta1.ICSMachine)
-	return Convert_v1beta1_ICSMachine_To_v1alpha4_ICSMachine(src, dst, nil)
+	src := srcRaw.(*infrav1beta2.ICSMachine)
+	if err := Convert_v1beta2_ICSMachine_To_v1alpha4_ICSMachine(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
 }
 
-// ConvertTo converts this ICSMachineList to the Hub version (v1beta1).
+// ConvertTo converts this ICSMachineList to the Hub version (v1beta2).
 func (src *ICSMachineList) ConvertTo(dstRaw conversion.Hub) error {
-	dst := dstRaw.(*infrav1beta1.ICSMachineList)
-	return Convert_v1alpha4_ICSMachineList_To_v1beta1_ICSMachineList(src, dst, nil)
+	dst := dstRaw.(*infrav1beta2.ICSMachineList)
+	return Convert_v1alpha4_ICSMachineList_To_v1beta2_ICSMachineList(src, dst, nil)
 }
 
-// ConvertFrom converts from the Hub version (v1beta1) to this ICSMachineList.
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSMachineList.
 func (dst *ICSMachineList) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*infrav1beta1.ICSMachineList)
-	return Convert_v1beta1_ICSMachineList_To_v1alpha4_ICSMachineList(src, dst, nil)
+	src := srcRaw.(*infrav1beta2.ICSMachineList)
+	return Convert_v1beta2_ICSMachineList_To_v1alpha4_ICSMachineList(src, dst, nil)
 }
\ No newline at end of file
@@ -24,28 +24,31 @@ import (
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-	infrav1beta1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
 )
 
 // ConvertTo.
 func (src *ICSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
-	dst := dstRaw.(*infrav1beta1.ICSMachineTemplate) //nolint:forcetypeassert
-	if err := Convert_v1alpha4_ICSMachineTemplate_To_v1beta1_ICSMachineTemplate(src, dst, nil); err != nil {
+	dst := dstRaw.(*infrav1beta2.ICSMachineTemplate) //nolint:forcetypeassert
+	if err := Convert_v1alpha4_ICSMachineTemplate_To_v1beta2_ICSMachineTemplate(src, dst, nil); err != nil {
 		return err
 	}
 
 	// Manually restore data.
-	restored := &infrav1beta1.ICSMachineTemplate{}
+	restored := &infrav1beta2.ICSMachineTemplate{}
 	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
 		return err
 	}
 
+	restoreVirtualMachineCloneSpec(&restored.Spec.Template.Spec.VirtualMachineCloneSpec, &dst.Spec.Template.Spec.VirtualMachineCloneSpec)
+	dst.Status = restored.Status
+
 	return nil
 }
 
 func (dst *ICSMachineTemplate) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*infrav1beta1.ICSMachineTemplate) //nolint:forcetypeassert
-	if err := Convert_v1beta1_ICSMachineTemplate_To_v1alpha4_ICSMachineTemplate(src, dst, nil); err != nil {
+	src := srcRaw.(*infrav1beta2.ICSMachineTemplate) //nolint:forcetypeassert
+	if err := Convert_v1beta2_ICSMachineTemplate_To_v1alpha4_ICSMachineTemplate(src, dst, nil); err != nil {
 		return err
 	}
 
@@ -58,13 +61,13 @@ func (dst *ICSMachineTemplate) ConvertFrom(srcRaw conversion.Hub) error {
 }
 
 func (src *ICSMachineTemplateList) ConvertTo(dstRaw conversion.Hub) error {
-	dst := dstRaw.(*infrav1beta1.ICSMachineTemplateList) //nolint:forcetypeassert
-	return Convert_v1alpha4_ICSMachineTemplateList_To_v1beta1_ICSMachineTemplateList(src, dst, nil)
+	dst := dstRaw.(*infrav1beta2.ICSMachineTemplateList) //nolint:forcetypeassert
+	return Convert_v1alpha4_ICSMachineTemplateList_To_v1beta2_ICSMachineTemplateList(src, dst, nil)
 }
 
 func (dst *ICSMachineTemplateList) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*infrav1beta1.ICSMachineTemplateList) //nolint:forcetypeassert
-	return Convert_v1beta1_ICSMachineTemplateList_To_v1alpha4_ICSMachineTemplateList(src, dst, nil)
+	src := srcRaw.(*infrav1beta2.ICSMachineTemplateList) //nolint:forcetypeassert
+	return Convert_v1beta2_ICSMachineTemplateList_To_v1alpha4_ICSMachineTemplateList(src, dst, nil)
 }
 
 //nolint
@@ -20,29 +20,34 @@ import (
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-	infrav1beta1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
 )
 
-// ConvertTo converts this ICSVM to the Hub version (v1beta1).
+// ConvertTo converts this ICSVM to the Hub version (v1beta2).
 func (src *ICSVM) ConvertTo(dstRaw conversion.Hub) error {
-	dst := dstRaw.(*infrav1beta1.ICSVM)
-	if err := Convert_v1alpha4_ICSVM_To_v1beta1_ICSVM(src, dst, nil); err != nil {
+	dst := dstRaw.(*infrav1beta2.ICSVM)
+	if err := Convert_v1alpha4_ICSVM_To_v1beta2_ICSVM(src, dst, nil); err != nil {
 		return err
 	}
 
 	// Manually restore data.
-	restored := &infrav1beta1.ICSVM{}
+	restored := &infrav1beta2.ICSVM{}
 	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
 		return err
 	}
 
+	restoreVirtualMachineCloneSpec(&restored.Spec.VirtualMachineCloneSpec, &dst.Spec.VirtualMachineCloneSpec)
+	dst.Status.RetainedVolumes = restored.Status.RetainedVolumes
+	dst.Status.Disks = restored.Status.Disks
+	restoreICSVMAddresses(restored.Status.Addresses, &dst.Status)
+
 	return nil
 }
 
-// ConvertFrom converts from the Hub version (v1beta1) to this ICSVM.
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSVM.
 func (dst *ICSVM) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*infrav1beta1.ICSVM)
-	if err := Convert_v1beta1_ICSVM_To_v1alpha4_ICSVM(src, dst, nil); err != nil {
+	src := srcRaw.(*infrav1beta2.ICSVM)
+	if err := Convert_v1beta2_ICSVM_To_v1alpha4_ICSVM(src, dst, nil); err != nil {
 		return err
 	}
 
@@ -54,14 +59,14 @@ func (dst *ICSVM) ConvertFrom(srcRaw conversion.Hub) error {
 	return nil
 }
 
-// ConvertTo converts this ICSVMList to the Hub version (v1beta1).
+// ConvertTo converts this ICSVMList to the Hub version (v1beta2).
 func (src *ICSVMList) ConvertTo(dstRaw conversion.Hub) error {
-	dst := dstRaw.(*infrav1beta1.ICSVMList)
-	return Convert_v1alpha4_ICSVMList_To_v1beta1_ICSVMList(src, dst, nil)
+	dst := dstRaw.(*infrav1beta2.ICSVMList)
+	return Convert_v1alpha4_ICSVMList_To_v1beta2_ICSVMList(src, dst, nil)
 }
 
-// ConvertFrom converts this ICSVM to the Hub version (v1beta1).
+// ConvertFrom converts this ICSVM to the Hub version (v1beta2).
 func (dst *ICSVMList) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*infrav1beta1.ICSVMList)
-	return Convert_v1beta1_ICSVMList_To_v1alpha4_ICSVMList(src, dst, nil)
+	src := srcRaw.(*infrav1beta2.ICSVMList)
+	return Convert_v1beta2_ICSVMList_To_v1alpha4_ICSVMList(src, dst, nil)
 }
@@ -20,18 +20,18 @@ import (
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-	infrav1beta1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
 )
 
-// ConvertTo converts this ICSVM to the Hub version (v1beta1).
+// ConvertTo converts this ICSVM to the Hub version (v1beta2).
 func (src *IPAddress) ConvertTo(dstRaw conversion.Hub) error {
-	dst := dstRaw.(*infrav1beta1.IPAddress)
-	if err := Convert_v1alpha4_IPAddress_To_v1beta1_IPAddress(src, dst, nil); err != nil {
+	dst := dstRaw.(*infrav1beta2.IPAddress)
+	if err := Convert_v1alpha4_IPAddress_To_v1beta2_IPAddress(src, dst, nil); err != nil {
 		return err
 	}
 
 	// Manually restore data.
-	restored := &infrav1beta1.IPAddress{}
+	restored := &infrav1beta2.IPAddress{}
 	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
 		return err
 	}
@@ -39,10 +39,10 @@ func (src *IPAddress) ConvertTo(dstRaw conversion.Hub) error {
 	return nil
 }
 
-// ConvertFrom converts from the Hub version (v1beta1) to this IPAddress.
+// ConvertFrom converts from the Hub version (v1beta2) to this IPAddress.
 func (dst *IPAddress) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*infrav1beta1.IPAddress)
-	if err := Convert_v1beta1_IPAddress_To_v1alpha4_IPAddress(src, dst, nil); err != nil {
+	src := srcRaw.(*infrav1beta2.IPAddress)
+	if err := Convert_v1beta2_IPAddress_To_v1alpha4_IPAddress(src, dst, nil); err != nil {
 		return err
 	}
 
@@ -54,14 +54,14 @@ func (dst *IPAddress) ConvertFrom(srcRaw conversion.Hub) error {
 	return nil
 }
 
-// ConvertTo converts this IPAddressList to the Hub version (v1beta1).
+// ConvertTo converts this IPAddressList to the Hub version (v1beta2).
 func (src *IPAddressList) ConvertTo(dstRaw conversion.Hub) error {
-	var dst = dstRaw.(*infrav1beta1.IPAddressList)
-	return Convert_v1alpha4_IPAddressList_To_v1beta1_IPAddressList(src, dst, nil)
+	var dst = dstRaw.(*infrav1beta2.IPAddressList)
+	return Convert_v1alpha4_IPAddressList_To_v1beta2_IPAddressList(src, dst, nil)
 }
 
-// ConvertFrom converts this IPAddress to the Hub version (v1beta1).
+// ConvertFrom converts this IPAddress to the Hub version (v1beta2).
 func (dst *IPAddressList) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*infrav1beta1.IPAddressList)
-	return Convert_v1beta1_IPAddressList_To_v1alpha4_IPAddressList(src, dst, nil)
+	src := srcRaw.(*infrav1beta2.IPAddressList)
+	return Convert_v1beta2_IPAddressList_To_v1alpha4_IPAddressList(src, dst, nil)
 }
@@ -170,6 +170,96 @@ func (in *ICSClusterStatus) DeepCopy() *ICSClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSClusterTemplate) DeepCopyInto(out *ICSClusterTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSClusterTemplate.
+func (in *ICSClusterTemplate) DeepCopy() *ICSClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ICSClusterTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSClusterTemplateList) DeepCopyInto(out *ICSClusterTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSClusterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSClusterTemplateList.
+func (in *ICSClusterTemplateList) DeepCopy() *ICSClusterTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSClusterTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ICSClusterTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSClusterTemplateResource) DeepCopyInto(out *ICSClusterTemplateResource) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSClusterTemplateResource.
+func (in *ICSClusterTemplateResource) DeepCopy() *ICSClusterTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSClusterTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSClusterTemplateSpec) DeepCopyInto(out *ICSClusterTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSClusterTemplateSpec.
+func (in *ICSClusterTemplateSpec) DeepCopy() *ICSClusterTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSClusterTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ICSIdentityReference) DeepCopyInto(out *ICSIdentityReference) {
 	*out = *in
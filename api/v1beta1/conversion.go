@@ -0,0 +1,161 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
+)
+
+// The functions below handle the fields that were reshaped in v1beta2. The
+// values that cannot be represented in v1beta1 are preserved in the
+// conversion-data annotation and restored by the ConvertTo functions of the
+// individual types.
+
+//nolint
+func Convert_v1beta1_VirtualMachineCloneSpec_To_v1beta2_VirtualMachineCloneSpec(in *VirtualMachineCloneSpec, out *infrav1beta2.VirtualMachineCloneSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_VirtualMachineCloneSpec_To_v1beta2_VirtualMachineCloneSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Placement.Datacenter = in.Datacenter
+	out.Placement.Cluster = in.Cluster
+	out.Placement.Datastores = nil
+	if in.Datastore != "" {
+		out.Placement.Datastores = []string{in.Datastore}
+	}
+	out.Placement.DatastoreSelector = nil
+	if in.DatastoreSelector != nil {
+		out.Placement.DatastoreSelector = &infrav1beta2.DatastoreSelector{}
+		if err := Convert_v1beta1_DatastoreSelector_To_v1beta2_DatastoreSelector(in.DatastoreSelector, out.Placement.DatastoreSelector, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//nolint
+func Convert_v1beta2_VirtualMachineCloneSpec_To_v1beta1_VirtualMachineCloneSpec(in *infrav1beta2.VirtualMachineCloneSpec, out *VirtualMachineCloneSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta2_VirtualMachineCloneSpec_To_v1beta1_VirtualMachineCloneSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Datacenter = in.Placement.Datacenter
+	out.Cluster = in.Placement.Cluster
+	out.Datastore = ""
+	if len(in.Placement.Datastores) > 0 {
+		out.Datastore = in.Placement.Datastores[0]
+	}
+	out.DatastoreSelector = nil
+	if in.Placement.DatastoreSelector != nil {
+		out.DatastoreSelector = &DatastoreSelector{}
+		if err := Convert_v1beta2_DatastoreSelector_To_v1beta1_DatastoreSelector(in.Placement.DatastoreSelector, out.DatastoreSelector, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta1_NetworkSpec_To_v1beta2_NetworkSpec drops
+// PreferredAPIServerCIDR, which is deprecated and rejected by the webhooks.
+//nolint
+func Convert_v1beta1_NetworkSpec_To_v1beta2_NetworkSpec(in *NetworkSpec, out *infrav1beta2.NetworkSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_NetworkSpec_To_v1beta2_NetworkSpec(in, out, s)
+}
+
+// Convert_v1beta1_ICSClusterSpec_To_v1beta2_ICSClusterSpec maps
+// EnabledLoadBalancer onto CreateLoadBalancer.
+//nolint
+func Convert_v1beta1_ICSClusterSpec_To_v1beta2_ICSClusterSpec(in *ICSClusterSpec, out *infrav1beta2.ICSClusterSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_ICSClusterSpec_To_v1beta2_ICSClusterSpec(in, out, s); err != nil {
+		return err
+	}
+	out.CreateLoadBalancer = in.EnabledLoadBalancer
+	return nil
+}
+
+//nolint
+func Convert_v1beta2_ICSClusterSpec_To_v1beta1_ICSClusterSpec(in *infrav1beta2.ICSClusterSpec, out *ICSClusterSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta2_ICSClusterSpec_To_v1beta1_ICSClusterSpec(in, out, s); err != nil {
+		return err
+	}
+	out.EnabledLoadBalancer = in.CreateLoadBalancer
+	return nil
+}
+
+//nolint
+func Convert_v1beta2_ICSClusterStatus_To_v1beta1_ICSClusterStatus(in *infrav1beta2.ICSClusterStatus, out *ICSClusterStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_ICSClusterStatus_To_v1beta1_ICSClusterStatus(in, out, s)
+}
+
+// Convert_v1beta1_ICSVMStatus_To_v1beta2_ICSVMStatus reports the addresses of
+// the VM as internal IPs.
+//nolint
+func Convert_v1beta1_ICSVMStatus_To_v1beta2_ICSVMStatus(in *ICSVMStatus, out *infrav1beta2.ICSVMStatus, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_ICSVMStatus_To_v1beta2_ICSVMStatus(in, out, s); err != nil {
+		return err
+	}
+	out.Addresses = nil
+	for _, addr := range in.Addresses {
+		out.Addresses = append(out.Addresses, clusterv1.MachineAddress{
+			Type:    clusterv1.MachineInternalIP,
+			Address: addr,
+		})
+	}
+	return nil
+}
+
+//nolint
+func Convert_v1beta1_MachineAddress_To_string(in *clusterv1.MachineAddress, out *string, s apiconversion.Scope) error {
+	*out = in.Address
+	return nil
+}
+
+// restoreVirtualMachineCloneSpec copies the v1beta2-only clone spec fields
+// from the restored hub object onto dst.
+func restoreVirtualMachineCloneSpec(restored, dst *infrav1beta2.VirtualMachineCloneSpec) {
+	restorePlacementDatastores(&restored.Placement, &dst.Placement)
+}
+
+// restorePlacementDatastores restores the additional datastores of the
+// placement as long as the first one was not changed in the spoke version.
+func restorePlacementDatastores(restored, dst *infrav1beta2.PlacementSpec) {
+	if len(restored.Datastores) == 0 {
+		return
+	}
+	first := ""
+	if len(dst.Datastores) > 0 {
+		first = dst.Datastores[0]
+	}
+	if restored.Datastores[0] == first {
+		dst.Datastores = restored.Datastores
+	}
+}
+
+// restoreICSVMAddresses restores the address types of the VM as long as the
+// addresses were not changed in the spoke version.
+func restoreICSVMAddresses(restored []clusterv1.MachineAddress, dst *infrav1beta2.ICSVMStatus) {
+	if len(restored) != len(dst.Addresses) {
+		return
+	}
+	for i := range restored {
+		if restored[i].Address != dst.Addresses[i].Address {
+			return
+		}
+	}
+	dst.Addresses = restored
+}
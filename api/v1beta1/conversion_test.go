@@ -0,0 +1,114 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
+)
+
+func TestFuzzyConversion(t *testing.T) {
+	g := NewWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1beta2.AddToScheme(scheme)).To(Succeed())
+
+	t.Run("for ICSCluster", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme:      scheme,
+		Hub:         &infrav1beta2.ICSCluster{},
+		Spoke:       &ICSCluster{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
+	}))
+	t.Run("for ICSClusterTemplate", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme:      scheme,
+		Hub:         &infrav1beta2.ICSClusterTemplate{},
+		Spoke:       &ICSClusterTemplate{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
+	}))
+	t.Run("for ICSMachine", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme:      scheme,
+		Hub:         &infrav1beta2.ICSMachine{},
+		Spoke:       &ICSMachine{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
+	}))
+	t.Run("for ICSMachineTemplate", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme:      scheme,
+		Hub:         &infrav1beta2.ICSMachineTemplate{},
+		Spoke:       &ICSMachineTemplate{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
+	}))
+	t.Run("for ICSMachinePool", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme:      scheme,
+		Hub:         &infrav1beta2.ICSMachinePool{},
+		Spoke:       &ICSMachinePool{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
+	}))
+	t.Run("for ICSVM", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme:      scheme,
+		Hub:         &infrav1beta2.ICSVM{},
+		Spoke:       &ICSVM{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
+	}))
+	t.Run("for IPAddress", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme:      scheme,
+		Hub:         &infrav1beta2.IPAddress{},
+		Spoke:       &IPAddress{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
+	}))
+	t.Run("for ICSHAProxyLoadBalancer", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme:      scheme,
+		Hub:         &infrav1beta2.ICSHAProxyLoadBalancer{},
+		Spoke:       &ICSHAProxyLoadBalancer{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
+	}))
+	t.Run("for ICSVMImage", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme:      scheme,
+		Hub:         &infrav1beta2.ICSVMImage{},
+		Spoke:       &ICSVMImage{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
+	}))
+}
+
+func fuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		networkSpecFuzzer,
+		ipAddressSpecFuzzer,
+	}
+}
+
+func networkSpecFuzzer(in *NetworkSpec, c fuzz.Continue) {
+	c.FuzzNoCustom(in)
+
+	// PreferredAPIServerCIDR has been removed in v1beta2, data is going to be
+	// lost, so we're forcing zero values to avoid round trip errors.
+	in.PreferredAPIServerCIDR = ""
+}
+
+func ipAddressSpecFuzzer(in *IPAddressSpec, c fuzz.Continue) {
+	c.FuzzNoCustom(in)
+
+	// Prefix is an int32 in v1beta2 and validated to be at most 128.
+	in.Prefix = c.Intn(129)
+}
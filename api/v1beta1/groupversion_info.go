@@ -41,4 +41,7 @@ var (
 
 	// AddToScheme adds the types in this group-version to the given scheme.
 	AddToScheme = SchemeBuilder.AddToScheme
+
+	// localSchemeBuilder is used for type conversions.
+	localSchemeBuilder = SchemeBuilder.SchemeBuilder
 )
@@ -16,8 +16,54 @@ limitations under the License.
 
 package v1beta1
 
-// Hub marks ICSCluster as a conversion hub.
-func (*ICSCluster) Hub() {}
+import (
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-// Hub marks ICSClusterList as a conversion hub.
-func (*ICSClusterList) Hub() {}
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
+)
+
+// ConvertTo converts this ICSCluster to the Hub version (v1beta2).
+func (src *ICSCluster) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSCluster)
+	if err := Convert_v1beta1_ICSCluster_To_v1beta2_ICSCluster(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1beta2.ICSCluster{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Status.LoadBalancerEnabled = restored.Status.LoadBalancerEnabled
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSCluster.
+func (dst *ICSCluster) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSCluster)
+	if err := Convert_v1beta2_ICSCluster_To_v1beta1_ICSCluster(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertTo converts this ICSClusterList to the Hub version (v1beta2).
+func (src *ICSClusterList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSClusterList)
+	return Convert_v1beta1_ICSClusterList_To_v1beta2_ICSClusterList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSClusterList.
+func (dst *ICSClusterList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSClusterList)
+	return Convert_v1beta2_ICSClusterList_To_v1beta1_ICSClusterList(src, dst, nil)
+}
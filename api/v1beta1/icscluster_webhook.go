@@ -18,6 +18,8 @@ package v1beta1
 
 import (
 	"fmt"
+	"net"
+	"reflect"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -52,13 +54,72 @@ func (r *ICSCluster) Default() {
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *ICSCluster) ValidateCreate() error {
+	allErrs := validateICSClusterSpec(field.NewPath("spec"), r.Spec)
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+}
+
+// validateICSClusterSpec validates the spec of an ICSCluster, or of the
+// ICSCluster created from a template, at the path.
+func validateICSClusterSpec(path *field.Path, spec ICSClusterSpec) field.ErrorList {
 	var allErrs field.ErrorList
 
-	if r.Spec.IdentityRef != nil && r.Spec.IdentityRef.Kind != defaultIdentityRefKind {
-		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "identityRef", "kind"), "must be a Secret"))
+	if spec.IdentityRef != nil && spec.IdentityRef.Kind != defaultIdentityRefKind {
+		allErrs = append(allErrs, field.Forbidden(path.Child("identityRef", "kind"), "must be a Secret"))
 	}
 
-	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+	if spec.Network != nil {
+		if _, _, err := net.ParseCIDR(spec.Network.CIDR); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("network", "cidr"), spec.Network.CIDR, "must be a valid CIDR"))
+		}
+		if spec.Network.Gateway != "" && net.ParseIP(spec.Network.Gateway) == nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("network", "gateway"), spec.Network.Gateway, "must be a valid IP address"))
+		}
+	}
+	allErrs = append(allErrs, validateManagedSecurityGroups(path, spec.ManagedSecurityGroups)...)
+	if defaults := spec.MachineDefaults; defaults != nil {
+		devicesPath := path.Child("machineDefaults", "networkDevices")
+		if len(defaults.NetworkDevices) > 0 && spec.Network != nil {
+			allErrs = append(allErrs, field.Forbidden(devicesPath, "cannot be set together with network"))
+		}
+		allErrs = append(allErrs, validateNetworkDevices(defaults.NetworkDevices, devicesPath, false)...)
+	}
+	if spec.LoadBalancer != nil && spec.LoadBalancer.VIPAddress != "" && net.ParseIP(spec.LoadBalancer.VIPAddress) == nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("loadBalancer", "vipAddress"), spec.LoadBalancer.VIPAddress, "must be a valid IP address"))
+	}
+	if vip := spec.ControlPlaneVIP; vip != nil {
+		vipPath := path.Child("controlPlaneVIP")
+		if vip.Address == "" && len(vip.AddressPool) == 0 {
+			allErrs = append(allErrs, field.Required(vipPath.Child("address"), "address or addressPool must be set"))
+		}
+		if vip.Address != "" && net.ParseIP(vip.Address) == nil {
+			allErrs = append(allErrs, field.Invalid(vipPath.Child("address"), vip.Address, "must be a valid IP address"))
+		}
+		for i, entry := range vip.AddressPool {
+			if _, _, err := net.ParseCIDR(entry); err != nil && net.ParseIP(entry) == nil {
+				allErrs = append(allErrs, field.Invalid(vipPath.Child("addressPool").Index(i), entry, "must be a valid IP address or CIDR"))
+			}
+		}
+		if spec.EnabledLoadBalancer {
+			allErrs = append(allErrs, field.Forbidden(vipPath, "cannot be set together with enabledLoadBalancer"))
+		}
+	}
+	if ref := spec.LoadBalancerRef; ref != nil {
+		refPath := path.Child("loadBalancerRef")
+		if ref.Kind == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("kind"), ""))
+		}
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("name"), ""))
+		}
+		if spec.EnabledLoadBalancer {
+			allErrs = append(allErrs, field.Forbidden(refPath, "cannot be set together with enabledLoadBalancer"))
+		}
+		if spec.ControlPlaneVIP != nil {
+			allErrs = append(allErrs, field.Forbidden(refPath, "cannot be set together with controlPlaneVIP"))
+		}
+	}
+
+	return allErrs
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
@@ -94,10 +155,74 @@ func (r *ICSCluster) ValidateUpdate(oldRaw runtime.Object) error {
 				r.Spec.IdentityRef, "field cannot be set to nil"),
 		)
 	}
+
+	// The cluster network is created once, so it can neither be added to nor
+	// removed from a cluster, nor be changed.
+	if !reflect.DeepEqual(old.Spec.Network, r.Spec.Network) {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec", "network"), "cannot be modified"),
+		)
+	}
+
+	// The control plane endpoint is set to the virtual IP up front.
+	if !reflect.DeepEqual(old.Spec.ControlPlaneVIP, r.Spec.ControlPlaneVIP) {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec", "controlPlaneVIP"), "cannot be modified"),
+		)
+	}
+
+	// The control plane endpoint is taken from the load balancer.
+	if !reflect.DeepEqual(old.Spec.LoadBalancerRef, r.Spec.LoadBalancerRef) {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec", "loadBalancerRef"), "cannot be modified"),
+		)
+	}
+
+	// The managed security groups are attached to the machines when they are
+	// created, so only their rules may change.
+	if (old.Spec.ManagedSecurityGroups == nil) != (r.Spec.ManagedSecurityGroups == nil) {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec", "managedSecurityGroups"), "cannot be added or removed"),
+		)
+	}
+	allErrs = append(allErrs, validateManagedSecurityGroups(field.NewPath("spec"), r.Spec.ManagedSecurityGroups)...)
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
 func (r *ICSCluster) ValidateDelete() error {
 	return nil
-}
\ No newline at end of file
+}
+
+func validateManagedSecurityGroups(specPath *field.Path, groups *ManagedSecurityGroups) field.ErrorList {
+	var allErrs field.ErrorList
+	if groups == nil {
+		return allErrs
+	}
+	path := specPath.Child("managedSecurityGroups")
+	allErrs = append(allErrs, validateSecurityGroupRules(path.Child("controlPlaneRules"), groups.ControlPlaneRules)...)
+	allErrs = append(allErrs, validateSecurityGroupRules(path.Child("workerRules"), groups.WorkerRules)...)
+	return allErrs
+}
+
+func validateSecurityGroupRules(path *field.Path, rules []SecurityGroupRule) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, rule := range rules {
+		rulePath := path.Index(i)
+		if rule.PortRangeMin < 0 || rule.PortRangeMin > 65535 {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("portRangeMin"), rule.PortRangeMin, "must be a port number"))
+		}
+		if rule.PortRangeMax < 0 || rule.PortRangeMax > 65535 {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("portRangeMax"), rule.PortRangeMax, "must be a port number"))
+		}
+		if rule.PortRangeMax != 0 && rule.PortRangeMax < rule.PortRangeMin {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("portRangeMax"), rule.PortRangeMax, "must not be lower than portRangeMin"))
+		}
+		if rule.RemoteIPPrefix != "" {
+			if _, _, err := net.ParseCIDR(rule.RemoteIPPrefix); err != nil {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("remoteIPPrefix"), rule.RemoteIPPrefix, "must be a valid CIDR"))
+			}
+		}
+	}
+	return allErrs
+}
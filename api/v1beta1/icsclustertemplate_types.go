@@ -0,0 +1,56 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ICSClusterTemplateSpec defines the desired state of ICSClusterTemplate
+type ICSClusterTemplateSpec struct {
+	Template ICSClusterTemplateResource `json:"template"`
+}
+
+// ICSClusterTemplateResource describes the data needed to create a ICSCluster from a template
+type ICSClusterTemplateResource struct {
+	// Spec is the specification of the desired behavior of the cluster.
+	Spec ICSClusterSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=icsclustertemplates,scope=Namespaced,categories=cluster-api
+
+// ICSClusterTemplate is the Schema for the icsclustertemplates API
+type ICSClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ICSClusterTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ICSClusterTemplateList contains a list of ICSClusterTemplate
+type ICSClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ICSClusterTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ICSClusterTemplate{}, &ICSClusterTemplateList{})
+}
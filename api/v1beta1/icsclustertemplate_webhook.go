@@ -0,0 +1,79 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *ICSClusterTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta1-icsclustertemplate,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsclustertemplates,versions=v1beta1,name=default.icsclustertemplate.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-icsclustertemplate,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsclustertemplates,versions=v1beta1,name=validation.icsclustertemplate.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+var (
+	_ webhook.Defaulter = &ICSClusterTemplate{}
+	_ webhook.Validator = &ICSClusterTemplate{}
+)
+
+// Default satisfies the defaulting webhook interface. Templates are only
+// defaulted when they are created, as they cannot be modified afterwards.
+func (r *ICSClusterTemplate) Default() {
+	if r.Spec.Template.Spec.IdentityRef != nil && r.Spec.Template.Spec.IdentityRef.Kind == "" {
+		r.Spec.Template.Spec.IdentityRef.Kind = defaultIdentityRefKind
+	}
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *ICSClusterTemplate) ValidateCreate() error {
+	allErrs := validateICSClusterSpec(field.NewPath("spec", "template", "spec"), r.Spec.Template.Spec)
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *ICSClusterTemplate) ValidateUpdate(oldRaw runtime.Object) error {
+	var allErrs field.ErrorList
+	old, ok := oldRaw.(*ICSClusterTemplate)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected an ICSClusterTemplate but got a %T", oldRaw))
+	}
+
+	// Clusters created from a ClusterClass are rolled out by referencing a
+	// new template, so templates are never changed in place.
+	if !reflect.DeepEqual(r.Spec.Template.Spec, old.Spec.Template.Spec) {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec", "template", "spec"), "cannot be modified, create a new template instead"),
+		)
+	}
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *ICSClusterTemplate) ValidateDelete() error {
+	return nil
+}
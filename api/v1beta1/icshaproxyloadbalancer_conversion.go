@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
+)
+
+// ConvertTo converts this ICSHAProxyLoadBalancer to the Hub version (v1beta2).
+func (src *ICSHAProxyLoadBalancer) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSHAProxyLoadBalancer)
+	if err := Convert_v1beta1_ICSHAProxyLoadBalancer_To_v1beta2_ICSHAProxyLoadBalancer(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1beta2.ICSHAProxyLoadBalancer{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restoreVirtualMachineCloneSpec(&restored.Spec.VirtualMachineConfiguration, &dst.Spec.VirtualMachineConfiguration)
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSHAProxyLoadBalancer.
+func (dst *ICSHAProxyLoadBalancer) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSHAProxyLoadBalancer)
+	if err := Convert_v1beta2_ICSHAProxyLoadBalancer_To_v1beta1_ICSHAProxyLoadBalancer(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertTo converts this ICSHAProxyLoadBalancerList to the Hub version (v1beta2).
+func (src *ICSHAProxyLoadBalancerList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSHAProxyLoadBalancerList)
+	return Convert_v1beta1_ICSHAProxyLoadBalancerList_To_v1beta2_ICSHAProxyLoadBalancerList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSHAProxyLoadBalancerList.
+func (dst *ICSHAProxyLoadBalancerList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSHAProxyLoadBalancerList)
+	return Convert_v1beta2_ICSHAProxyLoadBalancerList_To_v1beta1_ICSHAProxyLoadBalancerList(src, dst, nil)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// HAProxyLoadBalancerFinalizer allows the reconciler to clean up
+	// resources associated with an ICSHAProxyLoadBalancer before removing it
+	// from the API server.
+	HAProxyLoadBalancerFinalizer = "icshaproxyloadbalancer.infrastructure.cluster.x-k8s.io"
+)
+
+// ICSHAProxyLoadBalancerSpec defines the desired state of ICSHAProxyLoadBalancer.
+type ICSHAProxyLoadBalancerSpec struct {
+	// VirtualMachineConfiguration is the configuration of the VM running
+	// HAProxy. The template must provide HAProxy and its data plane API.
+	VirtualMachineConfiguration VirtualMachineCloneSpec `json:"virtualMachineConfiguration"`
+
+	// Port is the port the load balancer listens on and forwards to on the
+	// control plane machines.
+	// Defaults to 6443.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=6443
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// DataPlaneAPIPort is the port of the HAProxy data plane API used to
+	// configure the backends of the load balancer.
+	// Defaults to 5556.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=5556
+	// +optional
+	DataPlaneAPIPort int32 `json:"dataPlaneAPIPort,omitempty"`
+}
+
+// ICSHAProxyLoadBalancerStatus defines the observed state of ICSHAProxyLoadBalancer.
+type ICSHAProxyLoadBalancerStatus struct {
+	// Ready is true when the load balancer forwards to the control plane
+	// machines.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// Address is the IP address of the load balancer.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// Backends is the list of addresses the load balancer forwards to.
+	// +optional
+	Backends []string `json:"backends,omitempty"`
+
+	// Conditions defines current service state of the ICSHAProxyLoadBalancer.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=icshaproxyloadbalancers,scope=Namespaced,categories=cluster-api
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Load balancer is ready"
+// +kubebuilder:printcolumn:name="Address",type="string",JSONPath=".status.address",description="Address of the load balancer"
+
+// ICSHAProxyLoadBalancer is the Schema for the icshaproxyloadbalancers API
+type ICSHAProxyLoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ICSHAProxyLoadBalancerSpec   `json:"spec,omitempty"`
+	Status ICSHAProxyLoadBalancerStatus `json:"status,omitempty"`
+}
+
+func (r *ICSHAProxyLoadBalancer) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+func (r *ICSHAProxyLoadBalancer) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// ICSHAProxyLoadBalancerList contains a list of ICSHAProxyLoadBalancer
+type ICSHAProxyLoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ICSHAProxyLoadBalancer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ICSHAProxyLoadBalancer{}, &ICSHAProxyLoadBalancerList{})
+}
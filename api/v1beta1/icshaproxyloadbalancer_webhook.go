@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (r *ICSHAProxyLoadBalancer) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-icshaproxyloadbalancer,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icshaproxyloadbalancers,versions=v1beta1,name=validation.icshaproxyloadbalancer.infrastructure.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *ICSHAProxyLoadBalancer) ValidateCreate() error {
+	var allErrs field.ErrorList
+
+	if r.Spec.VirtualMachineConfiguration.Template == "" && r.Spec.VirtualMachineConfiguration.ImageRef == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "virtualMachineConfiguration", "template"), ""))
+	}
+
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+//nolint:forcetypeassert
+func (r *ICSHAProxyLoadBalancer) ValidateUpdate(oldRaw runtime.Object) error {
+	var allErrs field.ErrorList
+	old := oldRaw.(*ICSHAProxyLoadBalancer)
+
+	// The VM and the HAProxy configuration are only created once, the ports
+	// are written to the bootstrap data of the VM.
+	specPath := field.NewPath("spec")
+	if old.Spec.Port != r.Spec.Port {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("port"), "cannot be modified"))
+	}
+	if old.Spec.DataPlaneAPIPort != r.Spec.DataPlaneAPIPort {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("dataPlaneAPIPort"), "cannot be modified"))
+	}
+	if !reflect.DeepEqual(old.Spec.VirtualMachineConfiguration, r.Spec.VirtualMachineConfiguration) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("virtualMachineConfiguration"), "cannot be modified"))
+	}
+
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *ICSHAProxyLoadBalancer) ValidateDelete() error {
+	return nil
+}
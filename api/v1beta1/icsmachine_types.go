@@ -99,7 +99,6 @@ type ICSMachineStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=icsmachines,scope=Namespaced,categories=cluster-api
 // +kubebuilder:subresource:status
-// +kubebuilder:storageversion
 
 // ICSMachine is the Schema for the icsmachines API
 type ICSMachine struct {
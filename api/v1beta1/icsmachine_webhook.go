@@ -17,10 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
-	"fmt"
-	"net"
 	"reflect"
-	"strings"
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -40,6 +37,7 @@ func (r *ICSMachine) SetupWebhookWithManager(mgr ctrl.Manager) error {
 		Complete()
 }
 
+// +kubebuilder:webhook:verbs=create,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta1-icsmachine,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsmachines,versions=v1beta1,name=default.icsmachine.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
 // +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-icsmachine,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsmachines,versions=v1beta1,name=validation.icsmachine.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
 
 var (
@@ -52,6 +50,7 @@ func (r *ICSMachine) Default() {
 	if r.Spec.IdentityRef != nil && r.Spec.IdentityRef.Kind == "" {
 		r.Spec.IdentityRef.Kind = defaultIdentityRefKind
 	}
+	defaultNetworkDevices(r.Spec.Network.Devices)
 }
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
@@ -62,6 +61,8 @@ func (r *ICSMachine) ValidateCreate() error {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "identityRef", "kind"), "must be a Secret"))
 	}
 
+	allErrs = append(allErrs, validateVirtualMachineCloneSpec(&r.Spec.VirtualMachineCloneSpec, field.NewPath("spec"), false)...)
+
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
@@ -98,21 +99,44 @@ func (r *ICSMachine) ValidateUpdate(old runtime.Object) error {
 	newICSMachineNetwork := newICSMachineSpec["network"].(map[string]interface{})
 	oldICSMachineNetwork := oldICSMachineSpec["network"].(map[string]interface{})
 
+	// allow changes to the deletion policy.
+	delete(oldICSMachineSpec, "deletionPolicy")
+	delete(newICSMachineSpec, "deletionPolicy")
+
+	// allow changes to the drift policy.
+	delete(oldICSMachineSpec, "driftPolicy")
+	delete(newICSMachineSpec, "driftPolicy")
+
+	// allow changes to the resize policy, and to the CPU and memory when
+	// they are resized in place.
+	if r.Spec.ResizePolicy == ResizePolicyInPlace {
+		for _, key := range []string{"numCPUs", "memoryMiB"} {
+			delete(oldICSMachineSpec, key)
+			delete(newICSMachineSpec, key)
+		}
+	}
+	delete(oldICSMachineSpec, "resizePolicy")
+	delete(newICSMachineSpec, "resizePolicy")
+
+	// allow disks to grow and new disks to be appended, they are
+	// reconciled on the existing VM.
+	if oldMachine, ok := old.(*ICSMachine); ok {
+		allErrs = append(allErrs, validateDisksUpdate(oldMachine.Spec.Disks, r.Spec.Disks)...)
+		delete(oldICSMachineSpec, "disks")
+		delete(newICSMachineSpec, "disks")
+	}
+
+	// allow changes to the tags, they are reconciled on the existing VM.
+	delete(oldICSMachineSpec, "tags")
+	delete(newICSMachineSpec, "tags")
+
 	// allow changes to the devices..
 	delete(oldICSMachineNetwork, "devices")
 	delete(newICSMachineNetwork, "devices")
 
-	// validate that IPAddrs in updaterequest are valid.
-	spec := r.Spec
-	for i, device := range spec.Network.Devices {
-		for j, ip := range device.IPAddrs {
-			if !strings.Contains(ip, "/") {
-				continue
-			}
-			if _, _, err := net.ParseCIDR(ip); err != nil {
-				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", fmt.Sprintf("devices[%d]", i), fmt.Sprintf("ipAddrs[%d]", j)), ip, "ip addresses should be in the CIDR format"))
-			}
-		}
+	// validate the devices when they are changed in the update request.
+	if oldMachine, ok := old.(*ICSMachine); !ok || !reflect.DeepEqual(oldMachine.Spec.Network.Devices, r.Spec.Network.Devices) {
+		allErrs = append(allErrs, validateNetworkSpec(&r.Spec.Network, field.NewPath("spec", "network"), false)...)
 	}
 
 	if !reflect.DeepEqual(oldICSMachineSpec, newICSMachineSpec) {
@@ -122,6 +146,28 @@ func (r *ICSMachine) ValidateUpdate(old runtime.Object) error {
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
+// validateDisksUpdate only accepts disks that grow and new disks appended to
+// the list.
+func validateDisksUpdate(oldDisks, newDisks []DiskSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	disksPath := field.NewPath("spec", "disks")
+	if len(newDisks) < len(oldDisks) {
+		allErrs = append(allErrs, field.Forbidden(disksPath, "disks cannot be removed"))
+		return allErrs
+	}
+	for i, oldDisk := range oldDisks {
+		newDisk := newDisks[i]
+		if newDisk.DiskSize < oldDisk.DiskSize {
+			allErrs = append(allErrs, field.Invalid(disksPath.Index(i).Child("diskSize"), newDisk.DiskSize, "disks can only grow"))
+		}
+		newDisk.DiskSize = oldDisk.DiskSize
+		if !reflect.DeepEqual(oldDisk, newDisk) {
+			allErrs = append(allErrs, field.Forbidden(disksPath.Index(i), "only diskSize can be modified"))
+		}
+	}
+	return allErrs
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
 func (r *ICSMachine) ValidateDelete() error {
 	return nil
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (r *ICSMachinePool) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-icsmachinepool,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsmachinepools,versions=v1beta1,name=validation.icsmachinepool.infrastructure.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *ICSMachinePool) ValidateCreate() error {
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+// The template may change, which rolls out new VMs.
+func (r *ICSMachinePool) ValidateUpdate(old runtime.Object) error {
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *ICSMachinePool) ValidateDelete() error {
+	return nil
+}
+
+func (r *ICSMachinePool) validateSpec() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.Template.Template == "" && r.Spec.Template.ImageRef == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "template", "template"), ""))
+	}
+
+	// A rollout could neither create nor delete a VM. Fields that are not
+	// set get their defaults of 1 and 0.
+	if update := r.Spec.RollingUpdate; update != nil {
+		maxSurge, maxUnavailable := int32(1), int32(0)
+		if update.MaxSurge != nil {
+			maxSurge = *update.MaxSurge
+		}
+		if update.MaxUnavailable != nil {
+			maxUnavailable = *update.MaxUnavailable
+		}
+		if maxSurge == 0 && maxUnavailable == 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "rollingUpdate", "maxSurge"), maxSurge, "cannot be 0 when maxUnavailable is 0"))
+		}
+	}
+
+	return allErrs
+}
@@ -16,8 +16,54 @@ limitations under the License.
 
 package v1beta1
 
-// Hub marks ICSMachineTemplate as a conversion hub.
-func (*ICSMachineTemplate) Hub() {}
+import (
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-// Hub marks ICSMachineTemplateList as a conversion hub.
-func (*ICSMachineTemplateList) Hub() {}
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
+)
+
+// ConvertTo converts this ICSMachineTemplate to the Hub version (v1beta2).
+func (src *ICSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSMachineTemplate)
+	if err := Convert_v1beta1_ICSMachineTemplate_To_v1beta2_ICSMachineTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1beta2.ICSMachineTemplate{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restoreVirtualMachineCloneSpec(&restored.Spec.Template.Spec.VirtualMachineCloneSpec, &dst.Spec.Template.Spec.VirtualMachineCloneSpec)
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSMachineTemplate.
+func (dst *ICSMachineTemplate) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSMachineTemplate)
+	if err := Convert_v1beta2_ICSMachineTemplate_To_v1beta1_ICSMachineTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertTo converts this ICSMachineTemplateList to the Hub version (v1beta2).
+func (src *ICSMachineTemplateList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSMachineTemplateList)
+	return Convert_v1beta1_ICSMachineTemplateList_To_v1beta2_ICSMachineTemplateList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSMachineTemplateList.
+func (dst *ICSMachineTemplateList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSMachineTemplateList)
+	return Convert_v1beta2_ICSMachineTemplateList_To_v1beta1_ICSMachineTemplateList(src, dst, nil)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -25,17 +26,44 @@ type ICSMachineTemplateSpec struct {
 	Template ICSMachineTemplateResource `json:"template"`
 }
 
+// ICSMachineTemplateStatus defines the observed state of ICSMachineTemplate
+type ICSMachineTemplateStatus struct {
+	// Capacity defines the resource capacity of the machines created from
+	// the template. It is used by the cluster autoscaler to scale node
+	// groups up from zero.
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// NodeInfo describes the nodes of the machines created from the
+	// template.
+	// +optional
+	NodeInfo *NodeInfo `json:"nodeInfo,omitempty"`
+}
+
+// NodeInfo describes the nodes of the machines created from a template.
+type NodeInfo struct {
+	// Architecture is the CPU architecture of the node, in the format of
+	// the kubernetes.io/arch label.
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+
+	// OperatingSystem is the operating system of the node, in the format of
+	// the kubernetes.io/os label.
+	// +optional
+	OperatingSystem string `json:"operatingSystem,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=icsmachinetemplates,scope=Namespaced,categories=cluster-api
 // +kubebuilder:subresource:status
-// +kubebuilder:storageversion
 
 // ICSMachineTemplate is the Schema for the icsmachinetemplates API
 type ICSMachineTemplate struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec ICSMachineTemplateSpec `json:"spec,omitempty"`
+	Spec   ICSMachineTemplateSpec   `json:"spec,omitempty"`
+	Status ICSMachineTemplateStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
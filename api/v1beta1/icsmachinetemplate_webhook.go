@@ -17,6 +17,10 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,9 +37,19 @@ func (r *ICSMachineTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
 		Complete()
 }
 
+// +kubebuilder:webhook:verbs=create,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta1-icsmachinetemplate,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsmachinetemplates,versions=v1beta1,name=default.icsmachinetemplate.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
 // +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-icsmachinetemplate,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsmachinetemplates,versions=v1beta1,name=validation.icsmachinetemplate.infrastructure.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
 
-var _ webhook.Validator = &ICSMachineTemplate{}
+var (
+	_ webhook.Defaulter = &ICSMachineTemplate{}
+	_ webhook.Validator = &ICSMachineTemplate{}
+)
+
+// Default satisfies the defaulting webhook interface. Templates are only
+// defaulted when they are created, as they cannot be modified afterwards.
+func (r *ICSMachineTemplate) Default() {
+	defaultNetworkDevices(r.Spec.Template.Spec.Network.Devices)
+}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *ICSMachineTemplate) ValidateCreate() error {
@@ -50,12 +64,27 @@ func (r *ICSMachineTemplate) ValidateCreate() error {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "providerID"), "cannot be set in templates"))
 	}
 
+	allErrs = append(allErrs, validateVirtualMachineCloneSpec(&spec.VirtualMachineCloneSpec, field.NewPath("spec", "template", "spec"), false)...)
+
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *ICSMachineTemplate) ValidateUpdate(old runtime.Object) error {
-	return nil
+func (r *ICSMachineTemplate) ValidateUpdate(oldRaw runtime.Object) error {
+	var allErrs field.ErrorList
+	old, ok := oldRaw.(*ICSMachineTemplate)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected an ICSMachineTemplate but got a %T", oldRaw))
+	}
+
+	// Machines are rolled out by referencing a new template, so templates
+	// are never changed in place.
+	if !reflect.DeepEqual(r.Spec.Template.Spec, old.Spec.Template.Spec) {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec", "template", "spec"), "cannot be modified, create a new template instead"),
+		)
+	}
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
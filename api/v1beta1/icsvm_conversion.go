@@ -16,8 +16,55 @@ limitations under the License.
 
 package v1beta1
 
-// Hub marks ICSVM as a conversion hub.
-func (*ICSVM) Hub() {}
+import (
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-// Hub marks ICSVMList as a conversion hub.
-func (*ICSVMList) Hub() {}
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
+)
+
+// ConvertTo converts this ICSVM to the Hub version (v1beta2).
+func (src *ICSVM) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSVM)
+	if err := Convert_v1beta1_ICSVM_To_v1beta2_ICSVM(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1beta2.ICSVM{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restoreVirtualMachineCloneSpec(&restored.Spec.VirtualMachineCloneSpec, &dst.Spec.VirtualMachineCloneSpec)
+	restoreICSVMAddresses(restored.Status.Addresses, &dst.Status)
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSVM.
+func (dst *ICSVM) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSVM)
+	if err := Convert_v1beta2_ICSVM_To_v1beta1_ICSVM(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertTo converts this ICSVMList to the Hub version (v1beta2).
+func (src *ICSVMList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSVMList)
+	return Convert_v1beta1_ICSVMList_To_v1beta2_ICSVMList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSVMList.
+func (dst *ICSVMList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSVMList)
+	return Convert_v1beta2_ICSVMList_To_v1beta1_ICSVMList(src, dst, nil)
+}
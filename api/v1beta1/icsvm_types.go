@@ -27,6 +27,11 @@ const (
 	// VMFinalizer allows the reconciler to clean up resources associated
 	// with a ICSVM before removing it from the API Server.
 	VMFinalizer = "icsvm.infrastructure.cluster.x-k8s.io"
+
+	// AdoptVMAnnotation asks the controller to adopt a pre-existing VM
+	// instead of cloning a new one. The value is the ID of the VM to adopt;
+	// when it is empty the VM is looked up by the name of the ICSVM.
+	AdoptVMAnnotation = "icsvm.infrastructure.cluster.x-k8s.io/adopt"
 )
 
 // ICSVMSpec defines the desired state of ICSVM
@@ -72,6 +77,7 @@ type ICSVMStatus struct {
 	// This field is required at runtime for other controllers that read
 	// this CRD as unstructured data.
 	// +optional
+	// +k8s:conversion-gen=false
 	Addresses []string `json:"addresses,omitempty"`
 
 	// CloneMode is the type of clone operation used to clone this VM. Since
@@ -137,12 +143,21 @@ type ICSVMStatus struct {
 	// the VMs on separate hosts.
 	// +optional
 	ModuleUUID *string `json:"moduleUUID,omitempty"`
+
+	// RetainedVolumes is the list of volume IDs that were detached from the
+	// VM and kept when it was deleted with the RetainDataDisks deletion
+	// policy.
+	// +optional
+	RetainedVolumes []string `json:"retainedVolumes,omitempty"`
+
+	// Disks is the list of disks of the VM, in the order of Spec.Disks.
+	// +optional
+	Disks []DiskStatus `json:"disks,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=icsvms,scope=Namespaced,categories=cluster-api
 // +kubebuilder:subresource:status
-// +kubebuilder:storageversion
 
 // ICSVM is the Schema for the icsvms API
 type ICSVM struct {
@@ -18,15 +18,13 @@ package v1beta1
 
 import (
 	"fmt"
-	"net"
-	"strings"
 
-	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
 
 // log is for logging in this package.
@@ -38,10 +36,26 @@ func (r *ICSVM) SetupWebhookWithManager(mgr ctrl.Manager) error {
 		Complete()
 }
 
+// +kubebuilder:webhook:verbs=create,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta1-icsvm,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsvms,versions=v1beta1,name=default.icsvm.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
 // +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-icsvm,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsvms,versions=v1beta1,name=validation.icsvm.infrastructure.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
 
+var (
+	_ webhook.Defaulter = &ICSVM{}
+	_ webhook.Validator = &ICSVM{}
+)
+
+// Default satisfies the defaulting webhook interface.
+func (r *ICSVM) Default() {
+	defaultNetworkDevices(r.Spec.Network.Devices)
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *ICSVM) ValidateCreate() error {
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, r.validateSpec())
+}
+
+// validateSpec runs the static checks of the spec.
+func (r *ICSVM) validateSpec() field.ErrorList {
 	var allErrs field.ErrorList
 	spec := r.Spec
 
@@ -49,33 +63,26 @@ func (r *ICSVM) ValidateCreate() error {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "PreferredAPIServerCIDR"), spec.Network.PreferredAPIServerCIDR, "cannot be set, as it will be removed and is no longer used"))
 	}
 
-	for i, device := range spec.Network.Devices {
-		for j, ip := range device.IPAddrs {
-			if !strings.Contains(ip, "/") {
-				continue
-			}
-			if _, _, err := net.ParseCIDR(ip); err != nil {
-				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", fmt.Sprintf("devices[%d]", i), fmt.Sprintf("ipAddrs[%d]", j)), ip, "ip addresses should be in the CIDR format"))
-			}
-		}
+	if spec.Template == "" && spec.ImageRef == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "template"), "either template or imageRef must be set"))
 	}
 
-	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+	allErrs = append(allErrs, validateVirtualMachineCloneSpec(&spec.VirtualMachineCloneSpec, field.NewPath("spec"), true)...)
+
+	return allErrs
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-//nolint:forcetypeassert
-func (r *ICSVM) ValidateUpdate(old runtime.Object) error {
-	_, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
-	if err != nil {
-		return apierrors.NewInternalError(errors.Wrap(err, "failed to convert new ICSVM to unstructured object"))
-	}
-	_, err = runtime.DefaultUnstructuredConverter.ToUnstructured(old)
-	if err != nil {
-		return apierrors.NewInternalError(errors.Wrap(err, "failed to convert old ICSVM to unstructured object"))
+func (r *ICSVM) ValidateUpdate(oldRaw runtime.Object) error {
+	old, ok := oldRaw.(*ICSVM)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected an ICSVM but got a %T", oldRaw))
 	}
 
-	return nil
+	// Errors the ICSVM already had are not reported, so that the controllers
+	// can still update the ICSVMs admitted by older releases.
+	allErrs := newValidationErrors(r.validateSpec(), old.validateSpec())
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
+)
+
+// ConvertTo converts this ICSVMImage to the Hub version (v1beta2).
+func (src *ICSVMImage) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSVMImage)
+	return Convert_v1beta1_ICSVMImage_To_v1beta2_ICSVMImage(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSVMImage.
+func (dst *ICSVMImage) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSVMImage)
+	return Convert_v1beta2_ICSVMImage_To_v1beta1_ICSVMImage(src, dst, nil)
+}
+
+// ConvertTo converts this ICSVMImageList to the Hub version (v1beta2).
+func (src *ICSVMImageList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.ICSVMImageList)
+	return Convert_v1beta1_ICSVMImageList_To_v1beta2_ICSVMImageList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this ICSVMImageList.
+func (dst *ICSVMImageList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.ICSVMImageList)
+	return Convert_v1beta2_ICSVMImageList_To_v1beta1_ICSVMImageList(src, dst, nil)
+}
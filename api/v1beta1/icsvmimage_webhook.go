@@ -0,0 +1,104 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/hex"
+	"net/url"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (r *ICSVMImage) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-icsvmimage,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsvmimages,versions=v1beta1,name=validation.icsvmimage.infrastructure.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *ICSVMImage) ValidateCreate() error {
+	var allErrs field.ErrorList
+	spec := r.Spec
+	specPath := field.NewPath("spec")
+
+	if spec.CloudName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("cloudName"), ""))
+	}
+
+	sourcePath := specPath.Child("source")
+	sources := 0
+	if spec.Source.URL != "" {
+		sources++
+		if u, err := url.Parse(spec.Source.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(sourcePath.Child("url"), spec.Source.URL, "must be an HTTP or HTTPS URL"))
+		}
+	}
+	if spec.Source.OCI != nil {
+		sources++
+		if spec.Source.OCI.Reference == "" {
+			allErrs = append(allErrs, field.Required(sourcePath.Child("oci", "reference"), ""))
+		}
+	}
+	if spec.Source.Template != "" {
+		sources++
+		if spec.Template != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("template"), "cannot be set for a template source"))
+		}
+	}
+	if sources != 1 {
+		allErrs = append(allErrs, field.Invalid(sourcePath, spec.Source, "exactly one of url, oci or template must be set"))
+	}
+
+	if spec.Checksum != "" {
+		if b, err := hex.DecodeString(spec.Checksum); err != nil || len(b) != 16 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("checksum"), spec.Checksum, "must be an MD5 checksum in hexadecimal"))
+		}
+	}
+
+	if spec.Template != nil && spec.Template.Datastore == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("template", "datastore"), ""))
+	}
+
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+//nolint:forcetypeassert
+func (r *ICSVMImage) ValidateUpdate(oldRaw runtime.Object) error {
+	var allErrs field.ErrorList
+	old := oldRaw.(*ICSVMImage)
+
+	// The image may be uploaded to more datastores, everything else is
+	// only done once.
+	oldSpec, newSpec := old.Spec.DeepCopy(), r.Spec.DeepCopy()
+	oldSpec.Datastores, newSpec.Datastores = nil, nil
+	if !reflect.DeepEqual(oldSpec, newSpec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "cannot be modified except for datastores"))
+	}
+
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *ICSVMImage) ValidateDelete() error {
+	return nil
+}
@@ -16,8 +16,51 @@ limitations under the License.
 
 package v1beta1
 
-// Hub marks IPAddress as a conversion hub.
-func (*IPAddress) Hub() {}
+import (
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-// Hub marks IPAddressList as a conversion hub.
-func (*IPAddressList) Hub() {}
+	infrav1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
+)
+
+// ConvertTo converts this IPAddress to the Hub version (v1beta2).
+func (src *IPAddress) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.IPAddress)
+	if err := Convert_v1beta1_IPAddress_To_v1beta2_IPAddress(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Drop the conversion data, nothing needs to be restored.
+	if _, err := utilconversion.UnmarshalData(src, &infrav1beta2.IPAddress{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this IPAddress.
+func (dst *IPAddress) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.IPAddress)
+	if err := Convert_v1beta2_IPAddress_To_v1beta1_IPAddress(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertTo converts this IPAddressList to the Hub version (v1beta2).
+func (src *IPAddressList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1beta2.IPAddressList)
+	return Convert_v1beta1_IPAddressList_To_v1beta2_IPAddressList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta2) to this IPAddressList.
+func (dst *IPAddressList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1beta2.IPAddressList)
+	return Convert_v1beta2_IPAddressList_To_v1beta1_IPAddressList(src, dst, nil)
+}
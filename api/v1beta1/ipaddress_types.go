@@ -60,7 +60,6 @@ type IPAddressSpec struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=ipaddresses,scope=Namespaced,categories=cluster-api
-// +kubebuilder:storageversion
 
 // IPAddress is the Schema for the ipaddresses API
 type IPAddress struct {
@@ -16,6 +16,10 @@ limitations under the License.
 
 package v1beta1
 
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
 // CloneMode is the type of clone operation used to clone a VM from a template.
 type CloneMode string
 
@@ -42,6 +46,104 @@ const (
 	ImportVM CloneMode = "importVM"
 )
 
+// DriftPolicy describes how the controller reacts when the configuration of
+// a virtual machine drifted away from its spec, for example after it was
+// edited in iCenter.
+type DriftPolicy string
+
+const (
+	// DriftPolicyReport only reports the drift in the VMConfigurationDrifted
+	// condition. This is the default behavior.
+	DriftPolicyReport DriftPolicy = "Report"
+
+	// DriftPolicyCorrect applies the CPU and memory of the spec back to the
+	// virtual machine while it is powered off, or while it runs if the change
+	// can be hot-plugged. Drift that cannot be corrected in place is reported.
+	DriftPolicyCorrect DriftPolicy = "Correct"
+
+	// DriftPolicyRemediate marks the machine as failed so that a
+	// MachineHealthCheck replaces it.
+	DriftPolicyRemediate DriftPolicy = "Remediate"
+)
+
+// DeletionPolicy describes what happens to a virtual machine and its disks
+// when the owning ICSVM is deleted.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete removes the virtual machine together with all of
+	// its disks. This is the default behavior.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyRetainDataDisks detaches every disk but the system disk
+	// from the virtual machine before it is removed. Each detached volume is
+	// marked as retained in its description in iCenter, which records the
+	// cluster, namespace and name of the machine it belonged to, so the data
+	// can be inspected or re-attached later.
+	DeletionPolicyRetainDataDisks DeletionPolicy = "RetainDataDisks"
+
+	// DeletionPolicyRetainPoweredOff powers off the virtual machine and
+	// leaves it, and all of its disks, in place for later analysis. The
+	// virtual machine is marked as retained in its description so that the
+	// orphan garbage collector does not delete it.
+	DeletionPolicyRetainPoweredOff DeletionPolicy = "RetainPoweredOff"
+)
+
+// DatastoreSelectionStrategy describes how a datastore is chosen among the
+// datastores matched by a DatastoreSelector.
+type DatastoreSelectionStrategy string
+
+const (
+	// DatastoreSelectionMostFreeSpace chooses the datastore with the most
+	// available capacity. This is the default strategy.
+	DatastoreSelectionMostFreeSpace DatastoreSelectionStrategy = "MostFreeSpace"
+
+	// DatastoreSelectionRoundRobin spreads virtual machines evenly across
+	// the matched datastores.
+	DatastoreSelectionRoundRobin DatastoreSelectionStrategy = "RoundRobin"
+
+	// DatastoreSelectionHostLocal prefers datastores that are local to the
+	// host the virtual machine is placed on.
+	DatastoreSelectionHostLocal DatastoreSelectionStrategy = "HostLocal"
+)
+
+// DatastoreSelector selects the datastore of a virtual machine. A datastore
+// has to match all the criteria that are set.
+type DatastoreSelector struct {
+	// Tag is the name of an ICS tag the datastore has to be tagged with.
+	// +optional
+	Tag string `json:"tag,omitempty"`
+
+	// Type is the type of the datastore, for example LOCAL or NFS.
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Candidates is a list of names of datastores to choose from.
+	// +optional
+	Candidates []string `json:"candidates,omitempty"`
+
+	// Strategy is how a datastore is chosen among the matched datastores.
+	// Defaults to MostFreeSpace.
+	// +kubebuilder:validation:Enum=MostFreeSpace;RoundRobin;HostLocal
+	// +optional
+	Strategy DatastoreSelectionStrategy `json:"strategy,omitempty"`
+}
+
+// ResizePolicy describes how changes to the CPU and memory of a machine are
+// rolled out.
+type ResizePolicy string
+
+const (
+	// ResizePolicyReplace rolls out CPU and memory changes by replacing the
+	// machine. This is the default behavior.
+	ResizePolicyReplace ResizePolicy = "Replace"
+
+	// ResizePolicyInPlace applies CPU and memory changes to the existing
+	// virtual machine. The change is hot-plugged when the VM and its guest
+	// OS support it, otherwise the node is drained and the VM is rebooted.
+	ResizePolicyInPlace ResizePolicy = "InPlace"
+)
+
 type ICSIdentityReference struct {
 	// Kind of the identity. Can either be Secret
 	// +kubebuilder:validation:Enum=Secret
@@ -69,8 +171,16 @@ type VirtualMachineCloneSpec struct {
 
 	// Template is the name or inventory path of the template used to clone
 	// the virtual machine.
+	// Defaults to the template of the MachineDefaults of the ICSCluster.
+	// +optional
 	Template string `json:"template"`
 
+	// ImageRef is a reference to an ICSVMImage in the same namespace that
+	// the virtual machine is created from when Template is empty. The
+	// virtual machine is not created before the image is ready.
+	// +optional
+	ImageRef *corev1.LocalObjectReference `json:"imageRef,omitempty"`
+
 	// CloneMode specifies the type of clone operation.
 	// The LinkedClone mode is only support for templates that have at least
 	// one snapshot. If the template has no snapshots, then CloneMode defaults
@@ -103,6 +213,11 @@ type VirtualMachineCloneSpec struct {
 	// +optional
 	Datastore string `json:"datastore,omitempty"`
 
+	// DatastoreSelector selects the datastore of the virtual machine from a
+	// set of datastores. It cannot be set together with Datastore.
+	// +optional
+	DatastoreSelector *DatastoreSelector `json:"datastoreSelector,omitempty"`
+
 	// Network is the network configuration for this machine's VM.
 	Network NetworkSpec `json:"network"`
 
@@ -133,6 +248,33 @@ type VirtualMachineCloneSpec struct {
 	// deployed VM.
 	// +optional
 	User *SSHUser `json:"user,omitempty"`
+
+	// DeletionPolicy specifies what happens to the virtual machine and its
+	// disks when the machine is deleted.
+	// Defaults to Delete.
+	// +kubebuilder:validation:Enum=Delete;RetainDataDisks;RetainPoweredOff
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy specifies how the controller reacts when the configuration
+	// of the virtual machine drifted away from this spec.
+	// Defaults to Report.
+	// +kubebuilder:validation:Enum=Report;Correct;Remediate
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// ResizePolicy specifies how changes to NumCPUs and MemoryMiB are
+	// rolled out. With InPlace, NumCPUs and MemoryMiB may be changed on an
+	// existing machine.
+	// Defaults to Replace.
+	// +kubebuilder:validation:Enum=Replace;InPlace
+	// +optional
+	ResizePolicy ResizePolicy `json:"resizePolicy,omitempty"`
+
+	// Tags is a list of names of ICS tags to attach to the virtual machine.
+	// Tags that do not exist yet are created.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
 }
 
 // AuthorizedMode describes the Authorized Type of the user.
@@ -168,12 +310,61 @@ type DiskSpec struct {
 	BusModel string `json:"busModel,omitempty"`
 
 	// Default RAW, RAW\QCOW2
+	// +kubebuilder:validation:Enum=RAW;QCOW2
 	// +optional
 	VolumeFormat string `json:"volumeFormat,omitempty"`
 
 	// Default THIN, THIN\THICK
 	// +optional
 	VolumePolicy string `json:"volumePolicy,omitempty"`
+
+	// Datastore is the name of the datastore the disk is created on.
+	// Defaults to the datastore of the virtual machine.
+	// +optional
+	Datastore string `json:"datastore,omitempty"`
+
+	// ReadIOPS limits the read operations per second of the disk.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ReadIOPS int32 `json:"readIOPS,omitempty"`
+
+	// WriteIOPS limits the write operations per second of the disk.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	WriteIOPS int32 `json:"writeIOPS,omitempty"`
+
+	// ReadBPS limits the bytes read per second from the disk.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ReadBPS int32 `json:"readBPS,omitempty"`
+
+	// WriteBPS limits the bytes written per second to the disk.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	WriteBPS int32 `json:"writeBPS,omitempty"`
+
+	// QueueCount is the number of IO queues of the disk.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	QueueCount int32 `json:"queueCount,omitempty"`
+
+	// NativeIO enables native asynchronous IO for the disk.
+	// +optional
+	NativeIO bool `json:"nativeIO,omitempty"`
+
+	// KernelIO enables kernel IO for the disk.
+	// +optional
+	KernelIO bool `json:"kernelIO,omitempty"`
+
+	// CacheMode is the read/write cache mode of the disk.
+	// Default NONE, NONE\DIRECTSYNC\WRITETHROUGH\WRITEBACK
+	// +optional
+	CacheMode string `json:"cacheMode,omitempty"`
 }
 
 // NetworkSpec defines the virtual machine's network configuration.
@@ -194,11 +385,26 @@ type NetworkSpec struct {
 	PreferredAPIServerCIDR string `json:"preferredAPIServerCidr,omitempty"`
 }
 
+// SwitchType is the type of the ICS switch a network device is connected to.
+type SwitchType string
+
+const (
+	// NormalSwitch is a virtual switch of a host.
+	NormalSwitch SwitchType = "NORMALSWITCH"
+
+	// SDNSwitch is a switch of the SDN of ICS.
+	SDNSwitch SwitchType = "SDNSWITCH"
+
+	// ExtSDNSwitch is a switch of an external OpenStack VXLAN SDN.
+	// Devices connected to it must set DeviceID.
+	ExtSDNSwitch SwitchType = "VXLANOPENSTACKSWITCH"
+)
+
 // NetworkDeviceSpec defines the network configuration for a virtual machine's
 // network device.
 type NetworkDeviceSpec struct {
 	// SwitchType the type of the ics switch network to which the device will be connected.
-	SwitchType string `json:"switchType"`
+	SwitchType SwitchType `json:"switchType"`
 
 	// NetworkID is the ID of the ics network to which the device
 	// will be connected.
@@ -266,6 +472,28 @@ type NetworkDeviceSpec struct {
 	// +optional
 	MACAddr string `json:"macAddr,omitempty"`
 
+	// MACAddrPool is a list of MAC addresses, or ranges of MAC addresses in
+	// the form first-last, to allocate the MAC address of the device from
+	// when MACAddr is not set. The lowest free address is used, so that a
+	// replacement machine gets the address of the machine it replaces.
+	// Multicast addresses cannot be allocated, so the first octet of a range
+	// must be the same even number for all its addresses.
+	// +optional
+	MACAddrPool []string `json:"macAddrPool,omitempty"`
+
+	// MACAddrPrefix is a prefix of one to five octets, for example
+	// 52:54:00:10, to allocate the MAC address of the device from when
+	// MACAddr is not set. It is used after the addresses of MACAddrPool.
+	// The first octet must be even, multicast prefixes are rejected.
+	// +optional
+	MACAddrPrefix string `json:"macAddrPrefix,omitempty"`
+
+	// SecurityGroups is a list of IDs of the security groups attached to the
+	// device. It only applies to devices on SDN networks, and defaults to the
+	// managed security group of the machine role of the ICSCluster.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
 	// Nameservers is a list of IPv4 and/or IPv6 addresses used as DNS
 	// nameservers.
 	// Please note that Linux allows only three nameservers (https://linux.die.net/man/5/resolv.conf).
@@ -280,6 +508,58 @@ type NetworkDeviceSpec struct {
 	// addresses with DNS.
 	// +optional
 	SearchDomains []string `json:"searchDomains,omitempty"`
+
+	// Model is the model of the virtual NIC.
+	// Default VIRTIO, VIRTIO\E1000\RTL8139
+	// +kubebuilder:validation:Enum=VIRTIO;E1000;RTL8139
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// Queues is the number of queues of a multiqueue virtio NIC.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Queues int32 `json:"queues,omitempty"`
+
+	// SendQueueLength is the length of the send queue of the NIC.
+	// Defaults to 256.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SendQueueLength int32 `json:"sendQueueLength,omitempty"`
+
+	// ReceiveQueueLength is the length of the receive queue of the NIC.
+	// Defaults to 256.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ReceiveQueueLength int32 `json:"receiveQueueLength,omitempty"`
+
+	// UplinkRate limits the outbound traffic of the NIC.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	UplinkRate int32 `json:"uplinkRate,omitempty"`
+
+	// UplinkBurst is the outbound burst allowed above UplinkRate.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	UplinkBurst int32 `json:"uplinkBurst,omitempty"`
+
+	// DownlinkRate limits the inbound traffic of the NIC.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DownlinkRate int32 `json:"downlinkRate,omitempty"`
+
+	// DownlinkBurst is the inbound burst allowed above DownlinkRate.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DownlinkBurst int32 `json:"downlinkBurst,omitempty"`
+
+	// Priority is the network priority of the NIC.
+	// HIGH\MEDIUM\LOW, no priority is set by default.
+	// +kubebuilder:validation:Enum=HIGH;MEDIUM;LOW
+	// +optional
+	Priority string `json:"priority,omitempty"`
 }
 
 // NetworkRouteSpec defines a static network route.
@@ -310,6 +590,20 @@ type NetworkStatus struct {
 	NetworkName string `json:"networkName,omitempty"`
 }
 
+// DiskStatus provides information about one of a VM's disks.
+type DiskStatus struct {
+	// VolumeID is the ID of the ICS volume backing the disk.
+	VolumeID string `json:"volumeID"`
+
+	// DiskSize is the size of the disk, in GiB.
+	// +optional
+	DiskSize int32 `json:"diskSize,omitempty"`
+
+	// Datastore is the name of the datastore the volume is located on.
+	// +optional
+	Datastore string `json:"datastore,omitempty"`
+}
+
 // ICSMachineTemplateResource describes the data needed to create a ICSMachine from a template
 type ICSMachineTemplateResource struct {
 	// Spec is the specification of the desired behavior of the machine.
@@ -0,0 +1,196 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"net"
+	"strings"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var supportedSwitchTypes = []string{string(NormalSwitch), string(SDNSwitch), string(ExtSDNSwitch)}
+
+// validateVirtualMachineCloneSpec validates the network, disks, sizes and
+// datastore of a clone spec. It does not look anything up in iCenter. The network devices
+// of machines may be left empty to connect them to the network of their
+// cluster, while VMs must have at least one.
+func validateVirtualMachineCloneSpec(spec *VirtualMachineCloneSpec, fldPath *field.Path, requireDevices bool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateNetworkSpec(&spec.Network, fldPath.Child("network"), requireDevices)...)
+	allErrs = append(allErrs, validateDiskSpecs(spec.Disks, fldPath.Child("disks"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(spec.NumCPUs), fldPath.Child("numCPUs"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(spec.NumCoresPerSocket), fldPath.Child("numCoresPerSocket"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(spec.MemoryMiB, fldPath.Child("memoryMiB"))...)
+	if spec.Datastore != "" && spec.DatastoreSelector != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("datastoreSelector"), "cannot be set together with datastore"))
+	}
+
+	return allErrs
+}
+
+// newValidationErrors returns the errors that are not in the old errors.
+func newValidationErrors(allErrs, oldErrs field.ErrorList) field.ErrorList {
+	old := make(map[string]bool, len(oldErrs))
+	for _, err := range oldErrs {
+		old[err.Error()] = true
+	}
+	var newErrs field.ErrorList
+	for _, err := range allErrs {
+		if !old[err.Error()] {
+			newErrs = append(newErrs, err)
+		}
+	}
+	return newErrs
+}
+
+// defaultNetworkDevices connects the network devices without a switch type to
+// a normal switch, which is what the devices of older releases were.
+func defaultNetworkDevices(devices []NetworkDeviceSpec) {
+	for i := range devices {
+		if devices[i].SwitchType == "" {
+			devices[i].SwitchType = NormalSwitch
+		}
+	}
+}
+
+// validateNetworkSpec validates the devices of a network spec.
+func validateNetworkSpec(spec *NetworkSpec, fldPath *field.Path, requireDevices bool) field.ErrorList {
+	return validateNetworkDevices(spec.Devices, fldPath.Child("devices"), requireDevices)
+}
+
+// validateNetworkDevices validates the switch types, addresses and gateways
+// of network devices, and that no static address is assigned twice.
+func validateNetworkDevices(devices []NetworkDeviceSpec, devicesPath *field.Path, requireDevices bool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if requireDevices && len(devices) == 0 {
+		allErrs = append(allErrs, field.Required(devicesPath, "at least one network device is required"))
+	}
+
+	staticIPs := map[string]bool{}
+	for i, device := range devices {
+		devicePath := devicesPath.Index(i)
+
+		switch device.SwitchType {
+		case NormalSwitch, SDNSwitch:
+		case ExtSDNSwitch:
+			if device.DeviceID == "" {
+				allErrs = append(allErrs, field.Required(devicePath.Child("deviceID"), "is required for devices of "+string(ExtSDNSwitch)+" switches"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(devicePath.Child("switchType"), device.SwitchType, supportedSwitchTypes))
+		}
+
+		var subnets []*net.IPNet
+		for j, addr := range device.IPAddrs {
+			addrPath := devicePath.Child("ipAddrs").Index(j)
+			if strings.Contains(addr, "/") {
+				// A CIDR is the range the address of the device is
+				// allocated from.
+				_, subnet, err := net.ParseCIDR(addr)
+				if err != nil {
+					allErrs = append(allErrs, field.Invalid(addrPath, addr, "ip addresses should be in the CIDR format"))
+					continue
+				}
+				subnets = append(subnets, subnet)
+				continue
+			}
+
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				allErrs = append(allErrs, field.Invalid(addrPath, addr, "must be an IP address or a CIDR"))
+				continue
+			}
+			if staticIPs[ip.String()] {
+				allErrs = append(allErrs, field.Duplicate(addrPath, addr))
+			}
+			staticIPs[ip.String()] = true
+			if ip.To4() != nil && device.NetMask != "" {
+				mask := net.ParseIP(device.NetMask).To4()
+				if mask == nil {
+					continue
+				}
+				subnets = append(subnets, &net.IPNet{IP: ip.Mask(net.IPMask(mask)), Mask: net.IPMask(mask)})
+			}
+		}
+		if device.NetMask != "" && net.ParseIP(device.NetMask).To4() == nil {
+			allErrs = append(allErrs, field.Invalid(devicePath.Child("netMask"), device.NetMask, "must be an IPv4 netmask"))
+		}
+
+		allErrs = append(allErrs, validateGateway(device.Gateway4, true, subnets, devicePath.Child("gateway4"))...)
+		allErrs = append(allErrs, validateGateway(device.Gateway6, false, subnets, devicePath.Child("gateway6"))...)
+	}
+
+	return allErrs
+}
+
+// validateGateway validates that a gateway is an address of its IP family
+// within one of the subnets of the same family of its device. Devices
+// without such subnets accept any gateway.
+func validateGateway(gateway string, ipv4 bool, subnets []*net.IPNet, fldPath *field.Path) field.ErrorList {
+	if gateway == "" {
+		return nil
+	}
+	family := "IPv6"
+	if ipv4 {
+		family = "IPv4"
+	}
+	ip := net.ParseIP(gateway)
+	if ip == nil || (ip.To4() != nil) != ipv4 {
+		return field.ErrorList{field.Invalid(fldPath, gateway, "must be an "+family+" address")}
+	}
+
+	var familySubnets []string
+	for _, subnet := range subnets {
+		if (subnet.IP.To4() != nil) != ipv4 {
+			continue
+		}
+		if subnet.Contains(ip) {
+			return nil
+		}
+		familySubnets = append(familySubnets, subnet.String())
+	}
+	if len(familySubnets) == 0 {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, gateway, "must be within the subnet of the device: "+strings.Join(familySubnets, ", "))}
+}
+
+// validateDiskSpecs validates that there is a disk for the system disk of the
+// virtual machine, and that no disk has a negative size or limit.
+func validateDiskSpecs(disks []DiskSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(disks) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one disk is required for the system disk"))
+	}
+
+	for i, disk := range disks {
+		diskPath := fldPath.Index(i)
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(disk.DiskSize), diskPath.Child("diskSize"))...)
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(disk.ReadIOPS), diskPath.Child("readIOPS"))...)
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(disk.WriteIOPS), diskPath.Child("writeIOPS"))...)
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(disk.ReadBPS), diskPath.Child("readBPS"))...)
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(disk.WriteBPS), diskPath.Child("writeBPS"))...)
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(disk.QueueCount), diskPath.Child("queueCount"))...)
+	}
+
+	return allErrs
+}
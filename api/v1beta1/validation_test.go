@@ -0,0 +1,738 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+func validCloneSpec() VirtualMachineCloneSpec {
+	return VirtualMachineCloneSpec{
+		Template: "ubuntu-2004",
+		Network: NetworkSpec{
+			Devices: []NetworkDeviceSpec{
+				{
+					SwitchType: NormalSwitch,
+					NetworkID:  "network-1",
+					IPAddrs:    []string{"192.168.1.10"},
+					NetMask:    "255.255.255.0",
+					Gateway4:   "192.168.1.1",
+				},
+				{
+					SwitchType: ExtSDNSwitch,
+					NetworkID:  "network-2",
+					DeviceID:   "port-1",
+					IPAddrs:    []string{"10.0.0.0/24", "fd00::/64"},
+					Gateway4:   "10.0.0.1",
+					Gateway6:   "fd00::1",
+				},
+			},
+		},
+		Disks: []DiskSpec{
+			{DiskSize: 40},
+			{DiskSize: 100, Datastore: "data"},
+		},
+	}
+}
+
+func TestValidateVirtualMachineCloneSpec(t *testing.T) {
+	tests := []struct {
+		name           string
+		modify         func(spec *VirtualMachineCloneSpec)
+		requireDevices bool
+		wantFields     []string
+	}{
+		{
+			name:   "valid spec",
+			modify: func(spec *VirtualMachineCloneSpec) {},
+		},
+		{
+			name: "local SDN device without device ID",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[0].SwitchType = SDNSwitch
+			},
+		},
+		{
+			name: "gateway of a device without netmask",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[0].NetMask = ""
+				spec.Network.Devices[0].Gateway4 = "172.16.0.1"
+			},
+		},
+		{
+			name: "empty devices of a machine",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices = nil
+			},
+		},
+		{
+			name: "empty devices of a VM",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices = nil
+			},
+			requireDevices: true,
+			wantFields:     []string{"spec.network.devices"},
+		},
+		{
+			name: "IPv4 gateway outside the subnet of the netmask",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[0].Gateway4 = "192.168.2.1"
+			},
+			wantFields: []string{"spec.network.devices[0].gateway4"},
+		},
+		{
+			name: "IPv4 gateway outside the CIDR",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[1].Gateway4 = "10.0.1.1"
+			},
+			wantFields: []string{"spec.network.devices[1].gateway4"},
+		},
+		{
+			name: "IPv6 gateway outside the CIDR",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[1].Gateway6 = "fd01::1"
+			},
+			wantFields: []string{"spec.network.devices[1].gateway6"},
+		},
+		{
+			name: "gateway of the wrong IP family",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[1].Gateway4 = "fd00::1"
+			},
+			wantFields: []string{"spec.network.devices[1].gateway4"},
+		},
+		{
+			name: "duplicate IP across devices",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[1].IPAddrs = []string{"192.168.1.10"}
+				spec.Network.Devices[1].Gateway4 = ""
+				spec.Network.Devices[1].Gateway6 = ""
+			},
+			wantFields: []string{"spec.network.devices[1].ipAddrs[0]"},
+		},
+		{
+			name: "invalid IP",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[0].IPAddrs = []string{"192.168.1"}
+			},
+			wantFields: []string{"spec.network.devices[0].ipAddrs[0]"},
+		},
+		{
+			name: "invalid CIDR",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[1].IPAddrs[0] = "10.0.0.0/33"
+			},
+			wantFields: []string{"spec.network.devices[1].ipAddrs[0]"},
+		},
+		{
+			name: "invalid switch type",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[0].SwitchType = "DVSWITCH"
+			},
+			wantFields: []string{"spec.network.devices[0].switchType"},
+		},
+		{
+			name: "empty switch type",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[0].SwitchType = ""
+			},
+			wantFields: []string{"spec.network.devices[0].switchType"},
+		},
+		{
+			name: "external SDN device without device ID",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[1].DeviceID = ""
+			},
+			wantFields: []string{"spec.network.devices[1].deviceID"},
+		},
+		{
+			name: "empty disks",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Disks = nil
+			},
+			wantFields: []string{"spec.disks"},
+		},
+		{
+			name: "negative disk size",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Disks[1].DiskSize = -1
+			},
+			wantFields: []string{"spec.disks[1].diskSize"},
+		},
+		{
+			name: "negative disk limits",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Disks[0].ReadIOPS = -1
+				spec.Disks[0].WriteBPS = -1
+			},
+			wantFields: []string{"spec.disks[0].readIOPS", "spec.disks[0].writeBPS"},
+		},
+		{
+			name: "negative memory and CPUs",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.NumCPUs = -2
+				spec.MemoryMiB = -1024
+			},
+			wantFields: []string{"spec.numCPUs", "spec.memoryMiB"},
+		},
+		{
+			name: "datastore selector",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.DatastoreSelector = &DatastoreSelector{Strategy: DatastoreSelectionRoundRobin}
+			},
+		},
+		{
+			name: "datastore and datastore selector",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Datastore = "ds-1"
+				spec.DatastoreSelector = &DatastoreSelector{Strategy: DatastoreSelectionRoundRobin}
+			},
+			wantFields: []string{"spec.datastoreSelector"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := validCloneSpec()
+			tt.modify(&spec)
+
+			allErrs := validateVirtualMachineCloneSpec(&spec, field.NewPath("spec"), tt.requireDevices)
+			fields := []string{}
+			for _, err := range allErrs {
+				fields = append(fields, err.Field)
+			}
+			if len(tt.wantFields) == 0 {
+				g.Expect(fields).To(BeEmpty())
+			} else {
+				g.Expect(fields).To(ConsistOf(tt.wantFields))
+			}
+		})
+	}
+}
+
+func TestCloneSpecWebhooks(t *testing.T) {
+	invalidSpec := validCloneSpec()
+	invalidSpec.Network.Devices[0].SwitchType = "DVSWITCH"
+	invalidSpec.Disks = nil
+
+	tests := []struct {
+		name      string
+		validate  func(spec VirtualMachineCloneSpec) error
+		wantField string
+	}{
+		{
+			name: "ICSMachine",
+			validate: func(spec VirtualMachineCloneSpec) error {
+				return (&ICSMachine{Spec: ICSMachineSpec{VirtualMachineCloneSpec: spec}}).ValidateCreate()
+			},
+			wantField: "spec.disks",
+		},
+		{
+			name: "ICSMachineTemplate",
+			validate: func(spec VirtualMachineCloneSpec) error {
+				machineTemplate := &ICSMachineTemplate{}
+				machineTemplate.Spec.Template.Spec.VirtualMachineCloneSpec = spec
+				return machineTemplate.ValidateCreate()
+			},
+			wantField: "spec.template.spec.disks",
+		},
+		{
+			name: "ICSVM",
+			validate: func(spec VirtualMachineCloneSpec) error {
+				return (&ICSVM{Spec: ICSVMSpec{VirtualMachineCloneSpec: spec}}).ValidateCreate()
+			},
+			wantField: "spec.disks",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.validate(validCloneSpec())).To(Succeed())
+
+			err := tt.validate(invalidSpec)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(tt.wantField))
+			g.Expect(err.Error()).To(ContainSubstring("switchType"))
+		})
+	}
+}
+
+func TestICSMachineValidateUpdateNetwork(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(spec *VirtualMachineCloneSpec)
+		wantErr bool
+	}{
+		{
+			name:   "unchanged devices",
+			modify: func(spec *VirtualMachineCloneSpec) {},
+		},
+		{
+			name: "changed gateway within the subnet",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[0].Gateway4 = "192.168.1.254"
+			},
+		},
+		{
+			name: "changed gateway outside the subnet",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[0].Gateway4 = "192.168.2.1"
+			},
+			wantErr: true,
+		},
+		{
+			name: "changed switch type",
+			modify: func(spec *VirtualMachineCloneSpec) {
+				spec.Network.Devices[1].SwitchType = "DVSWITCH"
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldMachine := &ICSMachine{Spec: ICSMachineSpec{VirtualMachineCloneSpec: validCloneSpec()}}
+			newMachine := oldMachine.DeepCopy()
+			tt.modify(&newMachine.Spec.VirtualMachineCloneSpec)
+
+			err := newMachine.ValidateUpdate(oldMachine)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestNetworkDeviceDefaults(t *testing.T) {
+	unsetSpec := func() VirtualMachineCloneSpec {
+		spec := validCloneSpec()
+		spec.Network.Devices[0].SwitchType = ""
+		return spec
+	}
+
+	tests := []struct {
+		name     string
+		defaults func(spec VirtualMachineCloneSpec) VirtualMachineCloneSpec
+	}{
+		{
+			name: "ICSMachine",
+			defaults: func(spec VirtualMachineCloneSpec) VirtualMachineCloneSpec {
+				machine := &ICSMachine{Spec: ICSMachineSpec{VirtualMachineCloneSpec: spec}}
+				machine.Default()
+				return machine.Spec.VirtualMachineCloneSpec
+			},
+		},
+		{
+			name: "ICSMachineTemplate",
+			defaults: func(spec VirtualMachineCloneSpec) VirtualMachineCloneSpec {
+				machineTemplate := &ICSMachineTemplate{}
+				machineTemplate.Spec.Template.Spec.VirtualMachineCloneSpec = spec
+				machineTemplate.Default()
+				return machineTemplate.Spec.Template.Spec.VirtualMachineCloneSpec
+			},
+		},
+		{
+			name: "ICSVM",
+			defaults: func(spec VirtualMachineCloneSpec) VirtualMachineCloneSpec {
+				vm := &ICSVM{Spec: ICSVMSpec{VirtualMachineCloneSpec: spec}}
+				vm.Default()
+				return vm.Spec.VirtualMachineCloneSpec
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := tt.defaults(unsetSpec())
+			g.Expect(spec.Network.Devices[0].SwitchType).To(Equal(NormalSwitch))
+			g.Expect(spec.Network.Devices[1].SwitchType).To(Equal(ExtSDNSwitch))
+		})
+	}
+}
+
+func TestICSVMValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     func(spec *VirtualMachineCloneSpec)
+		modify  func(spec *VirtualMachineCloneSpec)
+		wantErr bool
+	}{
+		{
+			name:   "unchanged spec",
+			old:    func(spec *VirtualMachineCloneSpec) {},
+			modify: func(spec *VirtualMachineCloneSpec) {},
+		},
+		{
+			name:    "invalid gateway",
+			old:     func(spec *VirtualMachineCloneSpec) {},
+			modify:  func(spec *VirtualMachineCloneSpec) { spec.Network.Devices[0].Gateway4 = "192.168.2.1" },
+			wantErr: true,
+		},
+		{
+			name:    "removed template",
+			old:     func(spec *VirtualMachineCloneSpec) {},
+			modify:  func(spec *VirtualMachineCloneSpec) { spec.Template = "" },
+			wantErr: true,
+		},
+		{
+			name:   "error admitted by an older release",
+			old:    func(spec *VirtualMachineCloneSpec) { spec.Network.Devices[0].SwitchType = "" },
+			modify: func(spec *VirtualMachineCloneSpec) { spec.Template = "ubuntu-2204" },
+		},
+		{
+			name:    "new error next to an error admitted by an older release",
+			old:     func(spec *VirtualMachineCloneSpec) { spec.Network.Devices[0].SwitchType = "" },
+			modify:  func(spec *VirtualMachineCloneSpec) { spec.Network.Devices[1].DeviceID = "" },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldVM := &ICSVM{Spec: ICSVMSpec{VirtualMachineCloneSpec: validCloneSpec()}}
+			tt.old(&oldVM.Spec.VirtualMachineCloneSpec)
+			newVM := oldVM.DeepCopy()
+			tt.modify(&newVM.Spec.VirtualMachineCloneSpec)
+
+			err := newVM.ValidateUpdate(oldVM)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestICSMachinePoolValidateRollingUpdate(t *testing.T) {
+	tests := []struct {
+		name          string
+		rollingUpdate *MachinePoolRollingUpdate
+		wantErr       bool
+	}{
+		{
+			name: "default rolling update",
+		},
+		{
+			name:          "surge only",
+			rollingUpdate: &MachinePoolRollingUpdate{MaxSurge: pointer.Int32(2), MaxUnavailable: pointer.Int32(0)},
+		},
+		{
+			name:          "unavailable only",
+			rollingUpdate: &MachinePoolRollingUpdate{MaxSurge: pointer.Int32(0), MaxUnavailable: pointer.Int32(1)},
+		},
+		{
+			name:          "neither surge nor unavailable",
+			rollingUpdate: &MachinePoolRollingUpdate{MaxSurge: pointer.Int32(0), MaxUnavailable: pointer.Int32(0)},
+			wantErr:       true,
+		},
+		{
+			name:          "no surge with the default max unavailable",
+			rollingUpdate: &MachinePoolRollingUpdate{MaxSurge: pointer.Int32(0)},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			pool := &ICSMachinePool{Spec: ICSMachinePoolSpec{
+				Template:      validCloneSpec(),
+				RollingUpdate: tt.rollingUpdate,
+			}}
+
+			for _, err := range []error{pool.ValidateCreate(), pool.ValidateUpdate(pool.DeepCopy())} {
+				if tt.wantErr {
+					g.Expect(err).To(HaveOccurred())
+				} else {
+					g.Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+	}
+}
+
+func TestValidateDisksUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		modify     func(disks []DiskSpec) []DiskSpec
+		wantFields []string
+	}{
+		{
+			name:   "unchanged disks",
+			modify: func(disks []DiskSpec) []DiskSpec { return disks },
+		},
+		{
+			name: "grown disk",
+			modify: func(disks []DiskSpec) []DiskSpec {
+				disks[1].DiskSize = 200
+				return disks
+			},
+		},
+		{
+			name: "appended disk",
+			modify: func(disks []DiskSpec) []DiskSpec {
+				return append(disks, DiskSpec{DiskSize: 10, Datastore: "fast"})
+			},
+		},
+		{
+			name: "shrunk disk",
+			modify: func(disks []DiskSpec) []DiskSpec {
+				disks[0].DiskSize = 20
+				return disks
+			},
+			wantFields: []string{"spec.disks[0].diskSize"},
+		},
+		{
+			name: "removed disk",
+			modify: func(disks []DiskSpec) []DiskSpec {
+				return disks[:1]
+			},
+			wantFields: []string{"spec.disks"},
+		},
+		{
+			name: "moved disk",
+			modify: func(disks []DiskSpec) []DiskSpec {
+				disks[1].Datastore = "other"
+				disks[1].DiskSize = 200
+				return disks
+			},
+			wantFields: []string{"spec.disks[1]"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldDisks := validCloneSpec().Disks
+			newDisks := tt.modify(validCloneSpec().Disks)
+
+			fields := []string{}
+			for _, err := range validateDisksUpdate(oldDisks, newDisks) {
+				fields = append(fields, err.Field)
+			}
+			if len(tt.wantFields) == 0 {
+				g.Expect(fields).To(BeEmpty())
+			} else {
+				g.Expect(fields).To(ConsistOf(tt.wantFields))
+			}
+		})
+	}
+}
+
+func TestICSMachineTemplateValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(template *ICSMachineTemplate)
+		wantErr bool
+	}{
+		{
+			name:   "unchanged spec",
+			modify: func(template *ICSMachineTemplate) {},
+		},
+		{
+			name:   "changed labels",
+			modify: func(template *ICSMachineTemplate) { template.Labels = map[string]string{"tier": "gold"} },
+		},
+		{
+			name:    "changed template",
+			modify:  func(template *ICSMachineTemplate) { template.Spec.Template.Spec.Template = "ubuntu-2204" },
+			wantErr: true,
+		},
+		{
+			name: "changed network device",
+			modify: func(template *ICSMachineTemplate) {
+				template.Spec.Template.Spec.Network.Devices[0].Gateway4 = "192.168.1.254"
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldTemplate := &ICSMachineTemplate{}
+			oldTemplate.Spec.Template.Spec.VirtualMachineCloneSpec = validCloneSpec()
+			newTemplate := oldTemplate.DeepCopy()
+			tt.modify(newTemplate)
+
+			err := newTemplate.ValidateUpdate(oldTemplate)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring("spec.template.spec"))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestICSClusterTemplateDefault(t *testing.T) {
+	g := NewWithT(t)
+	clusterTemplate := &ICSClusterTemplate{}
+	clusterTemplate.Spec.Template.Spec.IdentityRef = &ICSIdentityReference{Name: "credentials"}
+	clusterTemplate.Default()
+	g.Expect(clusterTemplate.Spec.Template.Spec.IdentityRef.Kind).To(BeEquivalentTo(defaultIdentityRefKind))
+
+	clusterTemplate = &ICSClusterTemplate{}
+	clusterTemplate.Default()
+	g.Expect(clusterTemplate.Spec.Template.Spec.IdentityRef).To(BeNil())
+}
+
+func TestICSClusterTemplateValidateCreate(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      ICSClusterSpec
+		wantField string
+	}{
+		{
+			name: "valid spec",
+			spec: ICSClusterSpec{Network: &ClusterNetworkSpec{CIDR: "10.6.0.0/24"}},
+		},
+		{
+			name:      "invalid network CIDR",
+			spec:      ICSClusterSpec{Network: &ClusterNetworkSpec{CIDR: "10.6.0.0"}},
+			wantField: "spec.template.spec.network.cidr",
+		},
+		{
+			name:      "identity that is not a secret",
+			spec:      ICSClusterSpec{IdentityRef: &ICSIdentityReference{Kind: "ICSClusterIdentity", Name: "credentials"}},
+			wantField: "spec.template.spec.identityRef.kind",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterTemplate := &ICSClusterTemplate{}
+			clusterTemplate.Spec.Template.Spec = tt.spec
+
+			err := clusterTemplate.ValidateCreate()
+			if tt.wantField == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(tt.wantField))
+		})
+	}
+}
+
+func TestICSClusterTemplateValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(template *ICSClusterTemplate)
+		wantErr bool
+	}{
+		{
+			name:   "unchanged spec",
+			modify: func(template *ICSClusterTemplate) {},
+		},
+		{
+			name:   "changed labels",
+			modify: func(template *ICSClusterTemplate) { template.Labels = map[string]string{"tier": "gold"} },
+		},
+		{
+			name:    "changed network",
+			modify:  func(template *ICSClusterTemplate) { template.Spec.Template.Spec.Network.CIDR = "10.7.0.0/24" },
+			wantErr: true,
+		},
+		{
+			name:    "changed server",
+			modify:  func(template *ICSClusterTemplate) { template.Spec.Template.Spec.CloudName = "other" },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldTemplate := &ICSClusterTemplate{}
+			oldTemplate.Spec.Template.Spec.Network = &ClusterNetworkSpec{CIDR: "10.6.0.0/24"}
+			newTemplate := oldTemplate.DeepCopy()
+			tt.modify(newTemplate)
+
+			err := newTemplate.ValidateUpdate(oldTemplate)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring("spec.template.spec"))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestICSHAProxyLoadBalancerValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		modify    func(spec *ICSHAProxyLoadBalancerSpec)
+		wantField string
+	}{
+		{
+			name:   "unchanged spec",
+			modify: func(spec *ICSHAProxyLoadBalancerSpec) {},
+		},
+		{
+			name:      "changed port",
+			modify:    func(spec *ICSHAProxyLoadBalancerSpec) { spec.Port = 8443 },
+			wantField: "spec.port",
+		},
+		{
+			name:      "changed data plane API port",
+			modify:    func(spec *ICSHAProxyLoadBalancerSpec) { spec.DataPlaneAPIPort = 5555 },
+			wantField: "spec.dataPlaneAPIPort",
+		},
+		{
+			name:      "changed template",
+			modify:    func(spec *ICSHAProxyLoadBalancerSpec) { spec.VirtualMachineConfiguration.Template = "haproxy-2" },
+			wantField: "spec.virtualMachineConfiguration",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldLB := &ICSHAProxyLoadBalancer{Spec: ICSHAProxyLoadBalancerSpec{
+				VirtualMachineConfiguration: validCloneSpec(),
+				Port:                        6443,
+				DataPlaneAPIPort:            5556,
+			}}
+			newLB := oldLB.DeepCopy()
+			tt.modify(&newLB.Spec)
+
+			err := newLB.ValidateUpdate(oldLB)
+			if tt.wantField == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(tt.wantField))
+		})
+	}
+}
@@ -0,0 +1,2634 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	unsafe "unsafe"
+
+	v1beta2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
+	v1 "k8s.io/api/core/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	apiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	errors "sigs.k8s.io/cluster-api/errors"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*ClusterModule)(nil), (*v1beta2.ClusterModule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterModule_To_v1beta2_ClusterModule(a.(*ClusterModule), b.(*v1beta2.ClusterModule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ClusterModule)(nil), (*ClusterModule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterModule_To_v1beta1_ClusterModule(a.(*v1beta2.ClusterModule), b.(*ClusterModule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterNetworkSpec)(nil), (*v1beta2.ClusterNetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterNetworkSpec_To_v1beta2_ClusterNetworkSpec(a.(*ClusterNetworkSpec), b.(*v1beta2.ClusterNetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ClusterNetworkSpec)(nil), (*ClusterNetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterNetworkSpec_To_v1beta1_ClusterNetworkSpec(a.(*v1beta2.ClusterNetworkSpec), b.(*ClusterNetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterNetworkStatus)(nil), (*v1beta2.ClusterNetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterNetworkStatus_To_v1beta2_ClusterNetworkStatus(a.(*ClusterNetworkStatus), b.(*v1beta2.ClusterNetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ClusterNetworkStatus)(nil), (*ClusterNetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterNetworkStatus_To_v1beta1_ClusterNetworkStatus(a.(*v1beta2.ClusterNetworkStatus), b.(*ClusterNetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterSecurityGroupsStatus)(nil), (*v1beta2.ClusterSecurityGroupsStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterSecurityGroupsStatus_To_v1beta2_ClusterSecurityGroupsStatus(a.(*ClusterSecurityGroupsStatus), b.(*v1beta2.ClusterSecurityGroupsStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ClusterSecurityGroupsStatus)(nil), (*ClusterSecurityGroupsStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterSecurityGroupsStatus_To_v1beta1_ClusterSecurityGroupsStatus(a.(*v1beta2.ClusterSecurityGroupsStatus), b.(*ClusterSecurityGroupsStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneVIPSpec)(nil), (*v1beta2.ControlPlaneVIPSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneVIPSpec_To_v1beta2_ControlPlaneVIPSpec(a.(*ControlPlaneVIPSpec), b.(*v1beta2.ControlPlaneVIPSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ControlPlaneVIPSpec)(nil), (*ControlPlaneVIPSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ControlPlaneVIPSpec_To_v1beta1_ControlPlaneVIPSpec(a.(*v1beta2.ControlPlaneVIPSpec), b.(*ControlPlaneVIPSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DatastoreSelector)(nil), (*v1beta2.DatastoreSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DatastoreSelector_To_v1beta2_DatastoreSelector(a.(*DatastoreSelector), b.(*v1beta2.DatastoreSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.DatastoreSelector)(nil), (*DatastoreSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DatastoreSelector_To_v1beta1_DatastoreSelector(a.(*v1beta2.DatastoreSelector), b.(*DatastoreSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskSpec)(nil), (*v1beta2.DiskSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DiskSpec_To_v1beta2_DiskSpec(a.(*DiskSpec), b.(*v1beta2.DiskSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.DiskSpec)(nil), (*DiskSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DiskSpec_To_v1beta1_DiskSpec(a.(*v1beta2.DiskSpec), b.(*DiskSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskStatus)(nil), (*v1beta2.DiskStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DiskStatus_To_v1beta2_DiskStatus(a.(*DiskStatus), b.(*v1beta2.DiskStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.DiskStatus)(nil), (*DiskStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DiskStatus_To_v1beta1_DiskStatus(a.(*v1beta2.DiskStatus), b.(*DiskStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSCluster)(nil), (*v1beta2.ICSCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSCluster_To_v1beta2_ICSCluster(a.(*ICSCluster), b.(*v1beta2.ICSCluster), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSCluster)(nil), (*ICSCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSCluster_To_v1beta1_ICSCluster(a.(*v1beta2.ICSCluster), b.(*ICSCluster), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSClusterList)(nil), (*v1beta2.ICSClusterList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSClusterList_To_v1beta2_ICSClusterList(a.(*ICSClusterList), b.(*v1beta2.ICSClusterList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSClusterList)(nil), (*ICSClusterList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSClusterList_To_v1beta1_ICSClusterList(a.(*v1beta2.ICSClusterList), b.(*ICSClusterList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSClusterStatus)(nil), (*v1beta2.ICSClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSClusterStatus_To_v1beta2_ICSClusterStatus(a.(*ICSClusterStatus), b.(*v1beta2.ICSClusterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSClusterTemplate)(nil), (*v1beta2.ICSClusterTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSClusterTemplate_To_v1beta2_ICSClusterTemplate(a.(*ICSClusterTemplate), b.(*v1beta2.ICSClusterTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSClusterTemplate)(nil), (*ICSClusterTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSClusterTemplate_To_v1beta1_ICSClusterTemplate(a.(*v1beta2.ICSClusterTemplate), b.(*ICSClusterTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSClusterTemplateList)(nil), (*v1beta2.ICSClusterTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSClusterTemplateList_To_v1beta2_ICSClusterTemplateList(a.(*ICSClusterTemplateList), b.(*v1beta2.ICSClusterTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSClusterTemplateList)(nil), (*ICSClusterTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSClusterTemplateList_To_v1beta1_ICSClusterTemplateList(a.(*v1beta2.ICSClusterTemplateList), b.(*ICSClusterTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSClusterTemplateResource)(nil), (*v1beta2.ICSClusterTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSClusterTemplateResource_To_v1beta2_ICSClusterTemplateResource(a.(*ICSClusterTemplateResource), b.(*v1beta2.ICSClusterTemplateResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSClusterTemplateResource)(nil), (*ICSClusterTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSClusterTemplateResource_To_v1beta1_ICSClusterTemplateResource(a.(*v1beta2.ICSClusterTemplateResource), b.(*ICSClusterTemplateResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSClusterTemplateSpec)(nil), (*v1beta2.ICSClusterTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSClusterTemplateSpec_To_v1beta2_ICSClusterTemplateSpec(a.(*ICSClusterTemplateSpec), b.(*v1beta2.ICSClusterTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSClusterTemplateSpec)(nil), (*ICSClusterTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSClusterTemplateSpec_To_v1beta1_ICSClusterTemplateSpec(a.(*v1beta2.ICSClusterTemplateSpec), b.(*ICSClusterTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSHAProxyLoadBalancer)(nil), (*v1beta2.ICSHAProxyLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSHAProxyLoadBalancer_To_v1beta2_ICSHAProxyLoadBalancer(a.(*ICSHAProxyLoadBalancer), b.(*v1beta2.ICSHAProxyLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSHAProxyLoadBalancer)(nil), (*ICSHAProxyLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSHAProxyLoadBalancer_To_v1beta1_ICSHAProxyLoadBalancer(a.(*v1beta2.ICSHAProxyLoadBalancer), b.(*ICSHAProxyLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSHAProxyLoadBalancerList)(nil), (*v1beta2.ICSHAProxyLoadBalancerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSHAProxyLoadBalancerList_To_v1beta2_ICSHAProxyLoadBalancerList(a.(*ICSHAProxyLoadBalancerList), b.(*v1beta2.ICSHAProxyLoadBalancerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSHAProxyLoadBalancerList)(nil), (*ICSHAProxyLoadBalancerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSHAProxyLoadBalancerList_To_v1beta1_ICSHAProxyLoadBalancerList(a.(*v1beta2.ICSHAProxyLoadBalancerList), b.(*ICSHAProxyLoadBalancerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSHAProxyLoadBalancerSpec)(nil), (*v1beta2.ICSHAProxyLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSHAProxyLoadBalancerSpec_To_v1beta2_ICSHAProxyLoadBalancerSpec(a.(*ICSHAProxyLoadBalancerSpec), b.(*v1beta2.ICSHAProxyLoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSHAProxyLoadBalancerSpec)(nil), (*ICSHAProxyLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSHAProxyLoadBalancerSpec_To_v1beta1_ICSHAProxyLoadBalancerSpec(a.(*v1beta2.ICSHAProxyLoadBalancerSpec), b.(*ICSHAProxyLoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSHAProxyLoadBalancerStatus)(nil), (*v1beta2.ICSHAProxyLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSHAProxyLoadBalancerStatus_To_v1beta2_ICSHAProxyLoadBalancerStatus(a.(*ICSHAProxyLoadBalancerStatus), b.(*v1beta2.ICSHAProxyLoadBalancerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSHAProxyLoadBalancerStatus)(nil), (*ICSHAProxyLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSHAProxyLoadBalancerStatus_To_v1beta1_ICSHAProxyLoadBalancerStatus(a.(*v1beta2.ICSHAProxyLoadBalancerStatus), b.(*ICSHAProxyLoadBalancerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSIdentityReference)(nil), (*v1beta2.ICSIdentityReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSIdentityReference_To_v1beta2_ICSIdentityReference(a.(*ICSIdentityReference), b.(*v1beta2.ICSIdentityReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSIdentityReference)(nil), (*ICSIdentityReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSIdentityReference_To_v1beta1_ICSIdentityReference(a.(*v1beta2.ICSIdentityReference), b.(*ICSIdentityReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachine)(nil), (*v1beta2.ICSMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachine_To_v1beta2_ICSMachine(a.(*ICSMachine), b.(*v1beta2.ICSMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachine)(nil), (*ICSMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachine_To_v1beta1_ICSMachine(a.(*v1beta2.ICSMachine), b.(*ICSMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachineList)(nil), (*v1beta2.ICSMachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachineList_To_v1beta2_ICSMachineList(a.(*ICSMachineList), b.(*v1beta2.ICSMachineList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachineList)(nil), (*ICSMachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachineList_To_v1beta1_ICSMachineList(a.(*v1beta2.ICSMachineList), b.(*ICSMachineList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachinePool)(nil), (*v1beta2.ICSMachinePool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachinePool_To_v1beta2_ICSMachinePool(a.(*ICSMachinePool), b.(*v1beta2.ICSMachinePool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachinePool)(nil), (*ICSMachinePool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachinePool_To_v1beta1_ICSMachinePool(a.(*v1beta2.ICSMachinePool), b.(*ICSMachinePool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachinePoolInstanceStatus)(nil), (*v1beta2.ICSMachinePoolInstanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachinePoolInstanceStatus_To_v1beta2_ICSMachinePoolInstanceStatus(a.(*ICSMachinePoolInstanceStatus), b.(*v1beta2.ICSMachinePoolInstanceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachinePoolInstanceStatus)(nil), (*ICSMachinePoolInstanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachinePoolInstanceStatus_To_v1beta1_ICSMachinePoolInstanceStatus(a.(*v1beta2.ICSMachinePoolInstanceStatus), b.(*ICSMachinePoolInstanceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachinePoolList)(nil), (*v1beta2.ICSMachinePoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachinePoolList_To_v1beta2_ICSMachinePoolList(a.(*ICSMachinePoolList), b.(*v1beta2.ICSMachinePoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachinePoolList)(nil), (*ICSMachinePoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachinePoolList_To_v1beta1_ICSMachinePoolList(a.(*v1beta2.ICSMachinePoolList), b.(*ICSMachinePoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachinePoolSpec)(nil), (*v1beta2.ICSMachinePoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachinePoolSpec_To_v1beta2_ICSMachinePoolSpec(a.(*ICSMachinePoolSpec), b.(*v1beta2.ICSMachinePoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachinePoolSpec)(nil), (*ICSMachinePoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachinePoolSpec_To_v1beta1_ICSMachinePoolSpec(a.(*v1beta2.ICSMachinePoolSpec), b.(*ICSMachinePoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachinePoolStatus)(nil), (*v1beta2.ICSMachinePoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachinePoolStatus_To_v1beta2_ICSMachinePoolStatus(a.(*ICSMachinePoolStatus), b.(*v1beta2.ICSMachinePoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachinePoolStatus)(nil), (*ICSMachinePoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachinePoolStatus_To_v1beta1_ICSMachinePoolStatus(a.(*v1beta2.ICSMachinePoolStatus), b.(*ICSMachinePoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachineSpec)(nil), (*v1beta2.ICSMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachineSpec_To_v1beta2_ICSMachineSpec(a.(*ICSMachineSpec), b.(*v1beta2.ICSMachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachineSpec)(nil), (*ICSMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachineSpec_To_v1beta1_ICSMachineSpec(a.(*v1beta2.ICSMachineSpec), b.(*ICSMachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachineStatus)(nil), (*v1beta2.ICSMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachineStatus_To_v1beta2_ICSMachineStatus(a.(*ICSMachineStatus), b.(*v1beta2.ICSMachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachineStatus)(nil), (*ICSMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachineStatus_To_v1beta1_ICSMachineStatus(a.(*v1beta2.ICSMachineStatus), b.(*ICSMachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachineTemplate)(nil), (*v1beta2.ICSMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachineTemplate_To_v1beta2_ICSMachineTemplate(a.(*ICSMachineTemplate), b.(*v1beta2.ICSMachineTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachineTemplate)(nil), (*ICSMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachineTemplate_To_v1beta1_ICSMachineTemplate(a.(*v1beta2.ICSMachineTemplate), b.(*ICSMachineTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachineTemplateList)(nil), (*v1beta2.ICSMachineTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachineTemplateList_To_v1beta2_ICSMachineTemplateList(a.(*ICSMachineTemplateList), b.(*v1beta2.ICSMachineTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachineTemplateList)(nil), (*ICSMachineTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachineTemplateList_To_v1beta1_ICSMachineTemplateList(a.(*v1beta2.ICSMachineTemplateList), b.(*ICSMachineTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachineTemplateResource)(nil), (*v1beta2.ICSMachineTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachineTemplateResource_To_v1beta2_ICSMachineTemplateResource(a.(*ICSMachineTemplateResource), b.(*v1beta2.ICSMachineTemplateResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachineTemplateResource)(nil), (*ICSMachineTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachineTemplateResource_To_v1beta1_ICSMachineTemplateResource(a.(*v1beta2.ICSMachineTemplateResource), b.(*ICSMachineTemplateResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachineTemplateSpec)(nil), (*v1beta2.ICSMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachineTemplateSpec_To_v1beta2_ICSMachineTemplateSpec(a.(*ICSMachineTemplateSpec), b.(*v1beta2.ICSMachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachineTemplateSpec)(nil), (*ICSMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachineTemplateSpec_To_v1beta1_ICSMachineTemplateSpec(a.(*v1beta2.ICSMachineTemplateSpec), b.(*ICSMachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSMachineTemplateStatus)(nil), (*v1beta2.ICSMachineTemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSMachineTemplateStatus_To_v1beta2_ICSMachineTemplateStatus(a.(*ICSMachineTemplateStatus), b.(*v1beta2.ICSMachineTemplateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSMachineTemplateStatus)(nil), (*ICSMachineTemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSMachineTemplateStatus_To_v1beta1_ICSMachineTemplateStatus(a.(*v1beta2.ICSMachineTemplateStatus), b.(*ICSMachineTemplateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSVM)(nil), (*v1beta2.ICSVM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSVM_To_v1beta2_ICSVM(a.(*ICSVM), b.(*v1beta2.ICSVM), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSVM)(nil), (*ICSVM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSVM_To_v1beta1_ICSVM(a.(*v1beta2.ICSVM), b.(*ICSVM), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSVMImage)(nil), (*v1beta2.ICSVMImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSVMImage_To_v1beta2_ICSVMImage(a.(*ICSVMImage), b.(*v1beta2.ICSVMImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSVMImage)(nil), (*ICSVMImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSVMImage_To_v1beta1_ICSVMImage(a.(*v1beta2.ICSVMImage), b.(*ICSVMImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSVMImageList)(nil), (*v1beta2.ICSVMImageList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSVMImageList_To_v1beta2_ICSVMImageList(a.(*ICSVMImageList), b.(*v1beta2.ICSVMImageList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSVMImageList)(nil), (*ICSVMImageList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSVMImageList_To_v1beta1_ICSVMImageList(a.(*v1beta2.ICSVMImageList), b.(*ICSVMImageList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSVMImageSource)(nil), (*v1beta2.ICSVMImageSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSVMImageSource_To_v1beta2_ICSVMImageSource(a.(*ICSVMImageSource), b.(*v1beta2.ICSVMImageSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSVMImageSource)(nil), (*ICSVMImageSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSVMImageSource_To_v1beta1_ICSVMImageSource(a.(*v1beta2.ICSVMImageSource), b.(*ICSVMImageSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSVMImageSpec)(nil), (*v1beta2.ICSVMImageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSVMImageSpec_To_v1beta2_ICSVMImageSpec(a.(*ICSVMImageSpec), b.(*v1beta2.ICSVMImageSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSVMImageSpec)(nil), (*ICSVMImageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSVMImageSpec_To_v1beta1_ICSVMImageSpec(a.(*v1beta2.ICSVMImageSpec), b.(*ICSVMImageSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSVMImageStatus)(nil), (*v1beta2.ICSVMImageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSVMImageStatus_To_v1beta2_ICSVMImageStatus(a.(*ICSVMImageStatus), b.(*v1beta2.ICSVMImageStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSVMImageStatus)(nil), (*ICSVMImageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSVMImageStatus_To_v1beta1_ICSVMImageStatus(a.(*v1beta2.ICSVMImageStatus), b.(*ICSVMImageStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSVMImageTemplateSpec)(nil), (*v1beta2.ICSVMImageTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSVMImageTemplateSpec_To_v1beta2_ICSVMImageTemplateSpec(a.(*ICSVMImageTemplateSpec), b.(*v1beta2.ICSVMImageTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSVMImageTemplateSpec)(nil), (*ICSVMImageTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSVMImageTemplateSpec_To_v1beta1_ICSVMImageTemplateSpec(a.(*v1beta2.ICSVMImageTemplateSpec), b.(*ICSVMImageTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSVMList)(nil), (*v1beta2.ICSVMList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSVMList_To_v1beta2_ICSVMList(a.(*ICSVMList), b.(*v1beta2.ICSVMList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSVMList)(nil), (*ICSVMList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSVMList_To_v1beta1_ICSVMList(a.(*v1beta2.ICSVMList), b.(*ICSVMList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ICSVMSpec)(nil), (*v1beta2.ICSVMSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSVMSpec_To_v1beta2_ICSVMSpec(a.(*ICSVMSpec), b.(*v1beta2.ICSVMSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSVMSpec)(nil), (*ICSVMSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSVMSpec_To_v1beta1_ICSVMSpec(a.(*v1beta2.ICSVMSpec), b.(*ICSVMSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ICSVMStatus)(nil), (*ICSVMStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSVMStatus_To_v1beta1_ICSVMStatus(a.(*v1beta2.ICSVMStatus), b.(*ICSVMStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPAddress)(nil), (*v1beta2.IPAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IPAddress_To_v1beta2_IPAddress(a.(*IPAddress), b.(*v1beta2.IPAddress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.IPAddress)(nil), (*IPAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_IPAddress_To_v1beta1_IPAddress(a.(*v1beta2.IPAddress), b.(*IPAddress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPAddressList)(nil), (*v1beta2.IPAddressList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IPAddressList_To_v1beta2_IPAddressList(a.(*IPAddressList), b.(*v1beta2.IPAddressList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.IPAddressList)(nil), (*IPAddressList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_IPAddressList_To_v1beta1_IPAddressList(a.(*v1beta2.IPAddressList), b.(*IPAddressList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPAddressSpec)(nil), (*v1beta2.IPAddressSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IPAddressSpec_To_v1beta2_IPAddressSpec(a.(*IPAddressSpec), b.(*v1beta2.IPAddressSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.IPAddressSpec)(nil), (*IPAddressSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_IPAddressSpec_To_v1beta1_IPAddressSpec(a.(*v1beta2.IPAddressSpec), b.(*IPAddressSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerSpec)(nil), (*v1beta2.LoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoadBalancerSpec_To_v1beta2_LoadBalancerSpec(a.(*LoadBalancerSpec), b.(*v1beta2.LoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.LoadBalancerSpec)(nil), (*LoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(a.(*v1beta2.LoadBalancerSpec), b.(*LoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerStatus)(nil), (*v1beta2.LoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoadBalancerStatus_To_v1beta2_LoadBalancerStatus(a.(*LoadBalancerStatus), b.(*v1beta2.LoadBalancerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.LoadBalancerStatus)(nil), (*LoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_LoadBalancerStatus_To_v1beta1_LoadBalancerStatus(a.(*v1beta2.LoadBalancerStatus), b.(*LoadBalancerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDefaults)(nil), (*v1beta2.MachineDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDefaults_To_v1beta2_MachineDefaults(a.(*MachineDefaults), b.(*v1beta2.MachineDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.MachineDefaults)(nil), (*MachineDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MachineDefaults_To_v1beta1_MachineDefaults(a.(*v1beta2.MachineDefaults), b.(*MachineDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachinePoolRollingUpdate)(nil), (*v1beta2.MachinePoolRollingUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachinePoolRollingUpdate_To_v1beta2_MachinePoolRollingUpdate(a.(*MachinePoolRollingUpdate), b.(*v1beta2.MachinePoolRollingUpdate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.MachinePoolRollingUpdate)(nil), (*MachinePoolRollingUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MachinePoolRollingUpdate_To_v1beta1_MachinePoolRollingUpdate(a.(*v1beta2.MachinePoolRollingUpdate), b.(*MachinePoolRollingUpdate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagedSecurityGroups)(nil), (*v1beta2.ManagedSecurityGroups)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedSecurityGroups_To_v1beta2_ManagedSecurityGroups(a.(*ManagedSecurityGroups), b.(*v1beta2.ManagedSecurityGroups), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.ManagedSecurityGroups)(nil), (*ManagedSecurityGroups)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ManagedSecurityGroups_To_v1beta1_ManagedSecurityGroups(a.(*v1beta2.ManagedSecurityGroups), b.(*ManagedSecurityGroups), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkDeviceSpec)(nil), (*v1beta2.NetworkDeviceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkDeviceSpec_To_v1beta2_NetworkDeviceSpec(a.(*NetworkDeviceSpec), b.(*v1beta2.NetworkDeviceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.NetworkDeviceSpec)(nil), (*NetworkDeviceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NetworkDeviceSpec_To_v1beta1_NetworkDeviceSpec(a.(*v1beta2.NetworkDeviceSpec), b.(*NetworkDeviceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkRouteSpec)(nil), (*v1beta2.NetworkRouteSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkRouteSpec_To_v1beta2_NetworkRouteSpec(a.(*NetworkRouteSpec), b.(*v1beta2.NetworkRouteSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.NetworkRouteSpec)(nil), (*NetworkRouteSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NetworkRouteSpec_To_v1beta1_NetworkRouteSpec(a.(*v1beta2.NetworkRouteSpec), b.(*NetworkRouteSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.NetworkSpec)(nil), (*NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(a.(*v1beta2.NetworkSpec), b.(*NetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkStatus)(nil), (*v1beta2.NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkStatus_To_v1beta2_NetworkStatus(a.(*NetworkStatus), b.(*v1beta2.NetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.NetworkStatus)(nil), (*NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NetworkStatus_To_v1beta1_NetworkStatus(a.(*v1beta2.NetworkStatus), b.(*NetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeInfo)(nil), (*v1beta2.NodeInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NodeInfo_To_v1beta2_NodeInfo(a.(*NodeInfo), b.(*v1beta2.NodeInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.NodeInfo)(nil), (*NodeInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NodeInfo_To_v1beta1_NodeInfo(a.(*v1beta2.NodeInfo), b.(*NodeInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OCIImageSource)(nil), (*v1beta2.OCIImageSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OCIImageSource_To_v1beta2_OCIImageSource(a.(*OCIImageSource), b.(*v1beta2.OCIImageSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.OCIImageSource)(nil), (*OCIImageSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OCIImageSource_To_v1beta1_OCIImageSource(a.(*v1beta2.OCIImageSource), b.(*OCIImageSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHUser)(nil), (*v1beta2.SSHUser)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SSHUser_To_v1beta2_SSHUser(a.(*SSHUser), b.(*v1beta2.SSHUser), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.SSHUser)(nil), (*SSHUser)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_SSHUser_To_v1beta1_SSHUser(a.(*v1beta2.SSHUser), b.(*SSHUser), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityGroupRule)(nil), (*v1beta2.SecurityGroupRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecurityGroupRule_To_v1beta2_SecurityGroupRule(a.(*SecurityGroupRule), b.(*v1beta2.SecurityGroupRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.SecurityGroupRule)(nil), (*SecurityGroupRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_SecurityGroupRule_To_v1beta1_SecurityGroupRule(a.(*v1beta2.SecurityGroupRule), b.(*SecurityGroupRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityGroupStatus)(nil), (*v1beta2.SecurityGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecurityGroupStatus_To_v1beta2_SecurityGroupStatus(a.(*SecurityGroupStatus), b.(*v1beta2.SecurityGroupStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.SecurityGroupStatus)(nil), (*SecurityGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_SecurityGroupStatus_To_v1beta1_SecurityGroupStatus(a.(*v1beta2.SecurityGroupStatus), b.(*SecurityGroupStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualMachine)(nil), (*v1beta2.VirtualMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachine_To_v1beta2_VirtualMachine(a.(*VirtualMachine), b.(*v1beta2.VirtualMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.VirtualMachine)(nil), (*VirtualMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_VirtualMachine_To_v1beta1_VirtualMachine(a.(*v1beta2.VirtualMachine), b.(*VirtualMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*ICSClusterSpec)(nil), (*v1beta2.ICSClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSClusterSpec_To_v1beta2_ICSClusterSpec(a.(*ICSClusterSpec), b.(*v1beta2.ICSClusterSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*ICSVMStatus)(nil), (*v1beta2.ICSVMStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ICSVMStatus_To_v1beta2_ICSVMStatus(a.(*ICSVMStatus), b.(*v1beta2.ICSVMStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*apiv1beta1.MachineAddress)(nil), (*string)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineAddress_To_string(a.(*apiv1beta1.MachineAddress), b.(*string), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*NetworkSpec)(nil), (*v1beta2.NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkSpec_To_v1beta2_NetworkSpec(a.(*NetworkSpec), b.(*v1beta2.NetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*VirtualMachineCloneSpec)(nil), (*v1beta2.VirtualMachineCloneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualMachineCloneSpec_To_v1beta2_VirtualMachineCloneSpec(a.(*VirtualMachineCloneSpec), b.(*v1beta2.VirtualMachineCloneSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.ICSClusterSpec)(nil), (*ICSClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSClusterSpec_To_v1beta1_ICSClusterSpec(a.(*v1beta2.ICSClusterSpec), b.(*ICSClusterSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.ICSClusterStatus)(nil), (*ICSClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ICSClusterStatus_To_v1beta1_ICSClusterStatus(a.(*v1beta2.ICSClusterStatus), b.(*ICSClusterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.VirtualMachineCloneSpec)(nil), (*VirtualMachineCloneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_VirtualMachineCloneSpec_To_v1beta1_VirtualMachineCloneSpec(a.(*v1beta2.VirtualMachineCloneSpec), b.(*VirtualMachineCloneSpec), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_ClusterModule_To_v1beta2_ClusterModule(in *ClusterModule, out *v1beta2.ClusterModule, s conversion.Scope) error {
+	out.ControlPlane = in.ControlPlane
+	out.TargetObjectName = in.TargetObjectName
+	out.ModuleUUID = in.ModuleUUID
+	return nil
+}
+
+// Convert_v1beta1_ClusterModule_To_v1beta2_ClusterModule is an autogenerated conversion function.
+func Convert_v1beta1_ClusterModule_To_v1beta2_ClusterModule(in *ClusterModule, out *v1beta2.ClusterModule, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterModule_To_v1beta2_ClusterModule(in, out, s)
+}
+
+func autoConvert_v1beta2_ClusterModule_To_v1beta1_ClusterModule(in *v1beta2.ClusterModule, out *ClusterModule, s conversion.Scope) error {
+	out.ControlPlane = in.ControlPlane
+	out.TargetObjectName = in.TargetObjectName
+	out.ModuleUUID = in.ModuleUUID
+	return nil
+}
+
+// Convert_v1beta2_ClusterModule_To_v1beta1_ClusterModule is an autogenerated conversion function.
+func Convert_v1beta2_ClusterModule_To_v1beta1_ClusterModule(in *v1beta2.ClusterModule, out *ClusterModule, s conversion.Scope) error {
+	return autoConvert_v1beta2_ClusterModule_To_v1beta1_ClusterModule(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterNetworkSpec_To_v1beta2_ClusterNetworkSpec(in *ClusterNetworkSpec, out *v1beta2.ClusterNetworkSpec, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.Gateway = in.Gateway
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	out.ExternalNetworkID = in.ExternalNetworkID
+	out.NetworkType = in.NetworkType
+	return nil
+}
+
+// Convert_v1beta1_ClusterNetworkSpec_To_v1beta2_ClusterNetworkSpec is an autogenerated conversion function.
+func Convert_v1beta1_ClusterNetworkSpec_To_v1beta2_ClusterNetworkSpec(in *ClusterNetworkSpec, out *v1beta2.ClusterNetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterNetworkSpec_To_v1beta2_ClusterNetworkSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_ClusterNetworkSpec_To_v1beta1_ClusterNetworkSpec(in *v1beta2.ClusterNetworkSpec, out *ClusterNetworkSpec, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.Gateway = in.Gateway
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	out.ExternalNetworkID = in.ExternalNetworkID
+	out.NetworkType = in.NetworkType
+	return nil
+}
+
+// Convert_v1beta2_ClusterNetworkSpec_To_v1beta1_ClusterNetworkSpec is an autogenerated conversion function.
+func Convert_v1beta2_ClusterNetworkSpec_To_v1beta1_ClusterNetworkSpec(in *v1beta2.ClusterNetworkSpec, out *ClusterNetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_ClusterNetworkSpec_To_v1beta1_ClusterNetworkSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterNetworkStatus_To_v1beta2_ClusterNetworkStatus(in *ClusterNetworkStatus, out *v1beta2.ClusterNetworkStatus, s conversion.Scope) error {
+	out.NetworkID = in.NetworkID
+	out.NetworkName = in.NetworkName
+	out.SubnetID = in.SubnetID
+	out.SubnetName = in.SubnetName
+	out.RouterID = in.RouterID
+	return nil
+}
+
+// Convert_v1beta1_ClusterNetworkStatus_To_v1beta2_ClusterNetworkStatus is an autogenerated conversion function.
+func Convert_v1beta1_ClusterNetworkStatus_To_v1beta2_ClusterNetworkStatus(in *ClusterNetworkStatus, out *v1beta2.ClusterNetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterNetworkStatus_To_v1beta2_ClusterNetworkStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_ClusterNetworkStatus_To_v1beta1_ClusterNetworkStatus(in *v1beta2.ClusterNetworkStatus, out *ClusterNetworkStatus, s conversion.Scope) error {
+	out.NetworkID = in.NetworkID
+	out.NetworkName = in.NetworkName
+	out.SubnetID = in.SubnetID
+	out.SubnetName = in.SubnetName
+	out.RouterID = in.RouterID
+	return nil
+}
+
+// Convert_v1beta2_ClusterNetworkStatus_To_v1beta1_ClusterNetworkStatus is an autogenerated conversion function.
+func Convert_v1beta2_ClusterNetworkStatus_To_v1beta1_ClusterNetworkStatus(in *v1beta2.ClusterNetworkStatus, out *ClusterNetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_ClusterNetworkStatus_To_v1beta1_ClusterNetworkStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterSecurityGroupsStatus_To_v1beta2_ClusterSecurityGroupsStatus(in *ClusterSecurityGroupsStatus, out *v1beta2.ClusterSecurityGroupsStatus, s conversion.Scope) error {
+	out.ControlPlane = (*v1beta2.SecurityGroupStatus)(unsafe.Pointer(in.ControlPlane))
+	out.Worker = (*v1beta2.SecurityGroupStatus)(unsafe.Pointer(in.Worker))
+	return nil
+}
+
+// Convert_v1beta1_ClusterSecurityGroupsStatus_To_v1beta2_ClusterSecurityGroupsStatus is an autogenerated conversion function.
+func Convert_v1beta1_ClusterSecurityGroupsStatus_To_v1beta2_ClusterSecurityGroupsStatus(in *ClusterSecurityGroupsStatus, out *v1beta2.ClusterSecurityGroupsStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterSecurityGroupsStatus_To_v1beta2_ClusterSecurityGroupsStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_ClusterSecurityGroupsStatus_To_v1beta1_ClusterSecurityGroupsStatus(in *v1beta2.ClusterSecurityGroupsStatus, out *ClusterSecurityGroupsStatus, s conversion.Scope) error {
+	out.ControlPlane = (*SecurityGroupStatus)(unsafe.Pointer(in.ControlPlane))
+	out.Worker = (*SecurityGroupStatus)(unsafe.Pointer(in.Worker))
+	return nil
+}
+
+// Convert_v1beta2_ClusterSecurityGroupsStatus_To_v1beta1_ClusterSecurityGroupsStatus is an autogenerated conversion function.
+func Convert_v1beta2_ClusterSecurityGroupsStatus_To_v1beta1_ClusterSecurityGroupsStatus(in *v1beta2.ClusterSecurityGroupsStatus, out *ClusterSecurityGroupsStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_ClusterSecurityGroupsStatus_To_v1beta1_ClusterSecurityGroupsStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ControlPlaneVIPSpec_To_v1beta2_ControlPlaneVIPSpec(in *ControlPlaneVIPSpec, out *v1beta2.ControlPlaneVIPSpec, s conversion.Scope) error {
+	out.Address = in.Address
+	out.AddressPool = *(*[]string)(unsafe.Pointer(&in.AddressPool))
+	out.Interface = in.Interface
+	out.Image = in.Image
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneVIPSpec_To_v1beta2_ControlPlaneVIPSpec is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneVIPSpec_To_v1beta2_ControlPlaneVIPSpec(in *ControlPlaneVIPSpec, out *v1beta2.ControlPlaneVIPSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneVIPSpec_To_v1beta2_ControlPlaneVIPSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_ControlPlaneVIPSpec_To_v1beta1_ControlPlaneVIPSpec(in *v1beta2.ControlPlaneVIPSpec, out *ControlPlaneVIPSpec, s conversion.Scope) error {
+	out.Address = in.Address
+	out.AddressPool = *(*[]string)(unsafe.Pointer(&in.AddressPool))
+	out.Interface = in.Interface
+	out.Image = in.Image
+	return nil
+}
+
+// Convert_v1beta2_ControlPlaneVIPSpec_To_v1beta1_ControlPlaneVIPSpec is an autogenerated conversion function.
+func Convert_v1beta2_ControlPlaneVIPSpec_To_v1beta1_ControlPlaneVIPSpec(in *v1beta2.ControlPlaneVIPSpec, out *ControlPlaneVIPSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_ControlPlaneVIPSpec_To_v1beta1_ControlPlaneVIPSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_DatastoreSelector_To_v1beta2_DatastoreSelector(in *DatastoreSelector, out *v1beta2.DatastoreSelector, s conversion.Scope) error {
+	out.Tag = in.Tag
+	out.Type = in.Type
+	out.Candidates = *(*[]string)(unsafe.Pointer(&in.Candidates))
+	out.Strategy = v1beta2.DatastoreSelectionStrategy(in.Strategy)
+	return nil
+}
+
+// Convert_v1beta1_DatastoreSelector_To_v1beta2_DatastoreSelector is an autogenerated conversion function.
+func Convert_v1beta1_DatastoreSelector_To_v1beta2_DatastoreSelector(in *DatastoreSelector, out *v1beta2.DatastoreSelector, s conversion.Scope) error {
+	return autoConvert_v1beta1_DatastoreSelector_To_v1beta2_DatastoreSelector(in, out, s)
+}
+
+func autoConvert_v1beta2_DatastoreSelector_To_v1beta1_DatastoreSelector(in *v1beta2.DatastoreSelector, out *DatastoreSelector, s conversion.Scope) error {
+	out.Tag = in.Tag
+	out.Type = in.Type
+	out.Candidates = *(*[]string)(unsafe.Pointer(&in.Candidates))
+	out.Strategy = DatastoreSelectionStrategy(in.Strategy)
+	return nil
+}
+
+// Convert_v1beta2_DatastoreSelector_To_v1beta1_DatastoreSelector is an autogenerated conversion function.
+func Convert_v1beta2_DatastoreSelector_To_v1beta1_DatastoreSelector(in *v1beta2.DatastoreSelector, out *DatastoreSelector, s conversion.Scope) error {
+	return autoConvert_v1beta2_DatastoreSelector_To_v1beta1_DatastoreSelector(in, out, s)
+}
+
+func autoConvert_v1beta1_DiskSpec_To_v1beta2_DiskSpec(in *DiskSpec, out *v1beta2.DiskSpec, s conversion.Scope) error {
+	out.DiskSize = in.DiskSize
+	out.BusModel = in.BusModel
+	out.VolumeFormat = v1beta2.VolumeFormat(in.VolumeFormat)
+	out.VolumePolicy = v1beta2.VolumePolicy(in.VolumePolicy)
+	out.Datastore = in.Datastore
+	out.ReadIOPS = in.ReadIOPS
+	out.WriteIOPS = in.WriteIOPS
+	out.ReadBPS = in.ReadBPS
+	out.WriteBPS = in.WriteBPS
+	out.QueueCount = in.QueueCount
+	out.NativeIO = in.NativeIO
+	out.KernelIO = in.KernelIO
+	out.CacheMode = v1beta2.DiskCacheMode(in.CacheMode)
+	return nil
+}
+
+// Convert_v1beta1_DiskSpec_To_v1beta2_DiskSpec is an autogenerated conversion function.
+func Convert_v1beta1_DiskSpec_To_v1beta2_DiskSpec(in *DiskSpec, out *v1beta2.DiskSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_DiskSpec_To_v1beta2_DiskSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_DiskSpec_To_v1beta1_DiskSpec(in *v1beta2.DiskSpec, out *DiskSpec, s conversion.Scope) error {
+	out.DiskSize = in.DiskSize
+	out.BusModel = in.BusModel
+	out.VolumeFormat = string(in.VolumeFormat)
+	out.VolumePolicy = string(in.VolumePolicy)
+	out.Datastore = in.Datastore
+	out.ReadIOPS = in.ReadIOPS
+	out.WriteIOPS = in.WriteIOPS
+	out.ReadBPS = in.ReadBPS
+	out.WriteBPS = in.WriteBPS
+	out.QueueCount = in.QueueCount
+	out.NativeIO = in.NativeIO
+	out.KernelIO = in.KernelIO
+	out.CacheMode = string(in.CacheMode)
+	return nil
+}
+
+// Convert_v1beta2_DiskSpec_To_v1beta1_DiskSpec is an autogenerated conversion function.
+func Convert_v1beta2_DiskSpec_To_v1beta1_DiskSpec(in *v1beta2.DiskSpec, out *DiskSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_DiskSpec_To_v1beta1_DiskSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_DiskStatus_To_v1beta2_DiskStatus(in *DiskStatus, out *v1beta2.DiskStatus, s conversion.Scope) error {
+	out.VolumeID = in.VolumeID
+	out.DiskSize = in.DiskSize
+	out.Datastore = in.Datastore
+	return nil
+}
+
+// Convert_v1beta1_DiskStatus_To_v1beta2_DiskStatus is an autogenerated conversion function.
+func Convert_v1beta1_DiskStatus_To_v1beta2_DiskStatus(in *DiskStatus, out *v1beta2.DiskStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_DiskStatus_To_v1beta2_DiskStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_DiskStatus_To_v1beta1_DiskStatus(in *v1beta2.DiskStatus, out *DiskStatus, s conversion.Scope) error {
+	out.VolumeID = in.VolumeID
+	out.DiskSize = in.DiskSize
+	out.Datastore = in.Datastore
+	return nil
+}
+
+// Convert_v1beta2_DiskStatus_To_v1beta1_DiskStatus is an autogenerated conversion function.
+func Convert_v1beta2_DiskStatus_To_v1beta1_DiskStatus(in *v1beta2.DiskStatus, out *DiskStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_DiskStatus_To_v1beta1_DiskStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSCluster_To_v1beta2_ICSCluster(in *ICSCluster, out *v1beta2.ICSCluster, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ICSClusterSpec_To_v1beta2_ICSClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ICSClusterStatus_To_v1beta2_ICSClusterStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSCluster_To_v1beta2_ICSCluster is an autogenerated conversion function.
+func Convert_v1beta1_ICSCluster_To_v1beta2_ICSCluster(in *ICSCluster, out *v1beta2.ICSCluster, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSCluster_To_v1beta2_ICSCluster(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSCluster_To_v1beta1_ICSCluster(in *v1beta2.ICSCluster, out *ICSCluster, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta2_ICSClusterSpec_To_v1beta1_ICSClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_ICSClusterStatus_To_v1beta1_ICSClusterStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSCluster_To_v1beta1_ICSCluster is an autogenerated conversion function.
+func Convert_v1beta2_ICSCluster_To_v1beta1_ICSCluster(in *v1beta2.ICSCluster, out *ICSCluster, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSCluster_To_v1beta1_ICSCluster(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSClusterList_To_v1beta2_ICSClusterList(in *ICSClusterList, out *v1beta2.ICSClusterList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.ICSCluster, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ICSCluster_To_v1beta2_ICSCluster(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSClusterList_To_v1beta2_ICSClusterList is an autogenerated conversion function.
+func Convert_v1beta1_ICSClusterList_To_v1beta2_ICSClusterList(in *ICSClusterList, out *v1beta2.ICSClusterList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSClusterList_To_v1beta2_ICSClusterList(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSClusterList_To_v1beta1_ICSClusterList(in *v1beta2.ICSClusterList, out *ICSClusterList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSCluster, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ICSCluster_To_v1beta1_ICSCluster(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSClusterList_To_v1beta1_ICSClusterList is an autogenerated conversion function.
+func Convert_v1beta2_ICSClusterList_To_v1beta1_ICSClusterList(in *v1beta2.ICSClusterList, out *ICSClusterList, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSClusterList_To_v1beta1_ICSClusterList(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSClusterSpec_To_v1beta2_ICSClusterSpec(in *ICSClusterSpec, out *v1beta2.ICSClusterSpec, s conversion.Scope) error {
+	out.CloudName = in.CloudName
+	out.IdentityRef = (*v1beta2.ICSIdentityReference)(unsafe.Pointer(in.IdentityRef))
+	out.Insecure = (*bool)(unsafe.Pointer(in.Insecure))
+	// WARNING: in.EnabledLoadBalancer requires manual conversion: does not exist in peer-type
+	out.LoadBalancer = (*v1beta2.LoadBalancerSpec)(unsafe.Pointer(in.LoadBalancer))
+	out.ControlPlaneVIP = (*v1beta2.ControlPlaneVIPSpec)(unsafe.Pointer(in.ControlPlaneVIP))
+	out.LoadBalancerRef = (*v1.ObjectReference)(unsafe.Pointer(in.LoadBalancerRef))
+	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
+	out.ClusterModules = *(*[]v1beta2.ClusterModule)(unsafe.Pointer(&in.ClusterModules))
+	out.Network = (*v1beta2.ClusterNetworkSpec)(unsafe.Pointer(in.Network))
+	out.ManagedSecurityGroups = (*v1beta2.ManagedSecurityGroups)(unsafe.Pointer(in.ManagedSecurityGroups))
+	out.MachineDefaults = (*v1beta2.MachineDefaults)(unsafe.Pointer(in.MachineDefaults))
+	return nil
+}
+
+func autoConvert_v1beta2_ICSClusterSpec_To_v1beta1_ICSClusterSpec(in *v1beta2.ICSClusterSpec, out *ICSClusterSpec, s conversion.Scope) error {
+	out.CloudName = in.CloudName
+	out.IdentityRef = (*ICSIdentityReference)(unsafe.Pointer(in.IdentityRef))
+	out.Insecure = (*bool)(unsafe.Pointer(in.Insecure))
+	// WARNING: in.CreateLoadBalancer requires manual conversion: does not exist in peer-type
+	out.LoadBalancer = (*LoadBalancerSpec)(unsafe.Pointer(in.LoadBalancer))
+	out.ControlPlaneVIP = (*ControlPlaneVIPSpec)(unsafe.Pointer(in.ControlPlaneVIP))
+	out.LoadBalancerRef = (*v1.ObjectReference)(unsafe.Pointer(in.LoadBalancerRef))
+	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
+	out.ClusterModules = *(*[]ClusterModule)(unsafe.Pointer(&in.ClusterModules))
+	out.Network = (*ClusterNetworkSpec)(unsafe.Pointer(in.Network))
+	out.ManagedSecurityGroups = (*ManagedSecurityGroups)(unsafe.Pointer(in.ManagedSecurityGroups))
+	out.MachineDefaults = (*MachineDefaults)(unsafe.Pointer(in.MachineDefaults))
+	return nil
+}
+
+func autoConvert_v1beta1_ICSClusterStatus_To_v1beta2_ICSClusterStatus(in *ICSClusterStatus, out *v1beta2.ICSClusterStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	out.ICenterVersion = v1beta2.ICenterVersion(in.ICenterVersion)
+	out.Network = (*v1beta2.ClusterNetworkStatus)(unsafe.Pointer(in.Network))
+	out.SecurityGroups = (*v1beta2.ClusterSecurityGroupsStatus)(unsafe.Pointer(in.SecurityGroups))
+	out.LoadBalancer = (*v1beta2.LoadBalancerStatus)(unsafe.Pointer(in.LoadBalancer))
+	return nil
+}
+
+// Convert_v1beta1_ICSClusterStatus_To_v1beta2_ICSClusterStatus is an autogenerated conversion function.
+func Convert_v1beta1_ICSClusterStatus_To_v1beta2_ICSClusterStatus(in *ICSClusterStatus, out *v1beta2.ICSClusterStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSClusterStatus_To_v1beta2_ICSClusterStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSClusterStatus_To_v1beta1_ICSClusterStatus(in *v1beta2.ICSClusterStatus, out *ICSClusterStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	out.ICenterVersion = ICenterVersion(in.ICenterVersion)
+	out.Network = (*ClusterNetworkStatus)(unsafe.Pointer(in.Network))
+	out.SecurityGroups = (*ClusterSecurityGroupsStatus)(unsafe.Pointer(in.SecurityGroups))
+	// WARNING: in.LoadBalancerEnabled requires manual conversion: does not exist in peer-type
+	out.LoadBalancer = (*LoadBalancerStatus)(unsafe.Pointer(in.LoadBalancer))
+	return nil
+}
+
+func autoConvert_v1beta1_ICSClusterTemplate_To_v1beta2_ICSClusterTemplate(in *ICSClusterTemplate, out *v1beta2.ICSClusterTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ICSClusterTemplateSpec_To_v1beta2_ICSClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSClusterTemplate_To_v1beta2_ICSClusterTemplate is an autogenerated conversion function.
+func Convert_v1beta1_ICSClusterTemplate_To_v1beta2_ICSClusterTemplate(in *ICSClusterTemplate, out *v1beta2.ICSClusterTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSClusterTemplate_To_v1beta2_ICSClusterTemplate(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSClusterTemplate_To_v1beta1_ICSClusterTemplate(in *v1beta2.ICSClusterTemplate, out *ICSClusterTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta2_ICSClusterTemplateSpec_To_v1beta1_ICSClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSClusterTemplate_To_v1beta1_ICSClusterTemplate is an autogenerated conversion function.
+func Convert_v1beta2_ICSClusterTemplate_To_v1beta1_ICSClusterTemplate(in *v1beta2.ICSClusterTemplate, out *ICSClusterTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSClusterTemplate_To_v1beta1_ICSClusterTemplate(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSClusterTemplateList_To_v1beta2_ICSClusterTemplateList(in *ICSClusterTemplateList, out *v1beta2.ICSClusterTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.ICSClusterTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ICSClusterTemplate_To_v1beta2_ICSClusterTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSClusterTemplateList_To_v1beta2_ICSClusterTemplateList is an autogenerated conversion function.
+func Convert_v1beta1_ICSClusterTemplateList_To_v1beta2_ICSClusterTemplateList(in *ICSClusterTemplateList, out *v1beta2.ICSClusterTemplateList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSClusterTemplateList_To_v1beta2_ICSClusterTemplateList(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSClusterTemplateList_To_v1beta1_ICSClusterTemplateList(in *v1beta2.ICSClusterTemplateList, out *ICSClusterTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSClusterTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ICSClusterTemplate_To_v1beta1_ICSClusterTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSClusterTemplateList_To_v1beta1_ICSClusterTemplateList is an autogenerated conversion function.
+func Convert_v1beta2_ICSClusterTemplateList_To_v1beta1_ICSClusterTemplateList(in *v1beta2.ICSClusterTemplateList, out *ICSClusterTemplateList, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSClusterTemplateList_To_v1beta1_ICSClusterTemplateList(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSClusterTemplateResource_To_v1beta2_ICSClusterTemplateResource(in *ICSClusterTemplateResource, out *v1beta2.ICSClusterTemplateResource, s conversion.Scope) error {
+	if err := Convert_v1beta1_ICSClusterSpec_To_v1beta2_ICSClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSClusterTemplateResource_To_v1beta2_ICSClusterTemplateResource is an autogenerated conversion function.
+func Convert_v1beta1_ICSClusterTemplateResource_To_v1beta2_ICSClusterTemplateResource(in *ICSClusterTemplateResource, out *v1beta2.ICSClusterTemplateResource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSClusterTemplateResource_To_v1beta2_ICSClusterTemplateResource(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSClusterTemplateResource_To_v1beta1_ICSClusterTemplateResource(in *v1beta2.ICSClusterTemplateResource, out *ICSClusterTemplateResource, s conversion.Scope) error {
+	if err := Convert_v1beta2_ICSClusterSpec_To_v1beta1_ICSClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSClusterTemplateResource_To_v1beta1_ICSClusterTemplateResource is an autogenerated conversion function.
+func Convert_v1beta2_ICSClusterTemplateResource_To_v1beta1_ICSClusterTemplateResource(in *v1beta2.ICSClusterTemplateResource, out *ICSClusterTemplateResource, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSClusterTemplateResource_To_v1beta1_ICSClusterTemplateResource(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSClusterTemplateSpec_To_v1beta2_ICSClusterTemplateSpec(in *ICSClusterTemplateSpec, out *v1beta2.ICSClusterTemplateSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_ICSClusterTemplateResource_To_v1beta2_ICSClusterTemplateResource(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSClusterTemplateSpec_To_v1beta2_ICSClusterTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_ICSClusterTemplateSpec_To_v1beta2_ICSClusterTemplateSpec(in *ICSClusterTemplateSpec, out *v1beta2.ICSClusterTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSClusterTemplateSpec_To_v1beta2_ICSClusterTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSClusterTemplateSpec_To_v1beta1_ICSClusterTemplateSpec(in *v1beta2.ICSClusterTemplateSpec, out *ICSClusterTemplateSpec, s conversion.Scope) error {
+	if err := Convert_v1beta2_ICSClusterTemplateResource_To_v1beta1_ICSClusterTemplateResource(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSClusterTemplateSpec_To_v1beta1_ICSClusterTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta2_ICSClusterTemplateSpec_To_v1beta1_ICSClusterTemplateSpec(in *v1beta2.ICSClusterTemplateSpec, out *ICSClusterTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSClusterTemplateSpec_To_v1beta1_ICSClusterTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSHAProxyLoadBalancer_To_v1beta2_ICSHAProxyLoadBalancer(in *ICSHAProxyLoadBalancer, out *v1beta2.ICSHAProxyLoadBalancer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ICSHAProxyLoadBalancerSpec_To_v1beta2_ICSHAProxyLoadBalancerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ICSHAProxyLoadBalancerStatus_To_v1beta2_ICSHAProxyLoadBalancerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSHAProxyLoadBalancer_To_v1beta2_ICSHAProxyLoadBalancer is an autogenerated conversion function.
+func Convert_v1beta1_ICSHAProxyLoadBalancer_To_v1beta2_ICSHAProxyLoadBalancer(in *ICSHAProxyLoadBalancer, out *v1beta2.ICSHAProxyLoadBalancer, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSHAProxyLoadBalancer_To_v1beta2_ICSHAProxyLoadBalancer(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSHAProxyLoadBalancer_To_v1beta1_ICSHAProxyLoadBalancer(in *v1beta2.ICSHAProxyLoadBalancer, out *ICSHAProxyLoadBalancer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta2_ICSHAProxyLoadBalancerSpec_To_v1beta1_ICSHAProxyLoadBalancerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_ICSHAProxyLoadBalancerStatus_To_v1beta1_ICSHAProxyLoadBalancerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSHAProxyLoadBalancer_To_v1beta1_ICSHAProxyLoadBalancer is an autogenerated conversion function.
+func Convert_v1beta2_ICSHAProxyLoadBalancer_To_v1beta1_ICSHAProxyLoadBalancer(in *v1beta2.ICSHAProxyLoadBalancer, out *ICSHAProxyLoadBalancer, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSHAProxyLoadBalancer_To_v1beta1_ICSHAProxyLoadBalancer(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSHAProxyLoadBalancerList_To_v1beta2_ICSHAProxyLoadBalancerList(in *ICSHAProxyLoadBalancerList, out *v1beta2.ICSHAProxyLoadBalancerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.ICSHAProxyLoadBalancer, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ICSHAProxyLoadBalancer_To_v1beta2_ICSHAProxyLoadBalancer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSHAProxyLoadBalancerList_To_v1beta2_ICSHAProxyLoadBalancerList is an autogenerated conversion function.
+func Convert_v1beta1_ICSHAProxyLoadBalancerList_To_v1beta2_ICSHAProxyLoadBalancerList(in *ICSHAProxyLoadBalancerList, out *v1beta2.ICSHAProxyLoadBalancerList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSHAProxyLoadBalancerList_To_v1beta2_ICSHAProxyLoadBalancerList(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSHAProxyLoadBalancerList_To_v1beta1_ICSHAProxyLoadBalancerList(in *v1beta2.ICSHAProxyLoadBalancerList, out *ICSHAProxyLoadBalancerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSHAProxyLoadBalancer, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ICSHAProxyLoadBalancer_To_v1beta1_ICSHAProxyLoadBalancer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSHAProxyLoadBalancerList_To_v1beta1_ICSHAProxyLoadBalancerList is an autogenerated conversion function.
+func Convert_v1beta2_ICSHAProxyLoadBalancerList_To_v1beta1_ICSHAProxyLoadBalancerList(in *v1beta2.ICSHAProxyLoadBalancerList, out *ICSHAProxyLoadBalancerList, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSHAProxyLoadBalancerList_To_v1beta1_ICSHAProxyLoadBalancerList(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSHAProxyLoadBalancerSpec_To_v1beta2_ICSHAProxyLoadBalancerSpec(in *ICSHAProxyLoadBalancerSpec, out *v1beta2.ICSHAProxyLoadBalancerSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_VirtualMachineCloneSpec_To_v1beta2_VirtualMachineCloneSpec(&in.VirtualMachineConfiguration, &out.VirtualMachineConfiguration, s); err != nil {
+		return err
+	}
+	out.Port = in.Port
+	out.DataPlaneAPIPort = in.DataPlaneAPIPort
+	return nil
+}
+
+// Convert_v1beta1_ICSHAProxyLoadBalancerSpec_To_v1beta2_ICSHAProxyLoadBalancerSpec is an autogenerated conversion function.
+func Convert_v1beta1_ICSHAProxyLoadBalancerSpec_To_v1beta2_ICSHAProxyLoadBalancerSpec(in *ICSHAProxyLoadBalancerSpec, out *v1beta2.ICSHAProxyLoadBalancerSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSHAProxyLoadBalancerSpec_To_v1beta2_ICSHAProxyLoadBalancerSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSHAProxyLoadBalancerSpec_To_v1beta1_ICSHAProxyLoadBalancerSpec(in *v1beta2.ICSHAProxyLoadBalancerSpec, out *ICSHAProxyLoadBalancerSpec, s conversion.Scope) error {
+	if err := Convert_v1beta2_VirtualMachineCloneSpec_To_v1beta1_VirtualMachineCloneSpec(&in.VirtualMachineConfiguration, &out.VirtualMachineConfiguration, s); err != nil {
+		return err
+	}
+	out.Port = in.Port
+	out.DataPlaneAPIPort = in.DataPlaneAPIPort
+	return nil
+}
+
+// Convert_v1beta2_ICSHAProxyLoadBalancerSpec_To_v1beta1_ICSHAProxyLoadBalancerSpec is an autogenerated conversion function.
+func Convert_v1beta2_ICSHAProxyLoadBalancerSpec_To_v1beta1_ICSHAProxyLoadBalancerSpec(in *v1beta2.ICSHAProxyLoadBalancerSpec, out *ICSHAProxyLoadBalancerSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSHAProxyLoadBalancerSpec_To_v1beta1_ICSHAProxyLoadBalancerSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSHAProxyLoadBalancerStatus_To_v1beta2_ICSHAProxyLoadBalancerStatus(in *ICSHAProxyLoadBalancerStatus, out *v1beta2.ICSHAProxyLoadBalancerStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.Address = in.Address
+	out.Backends = *(*[]string)(unsafe.Pointer(&in.Backends))
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_ICSHAProxyLoadBalancerStatus_To_v1beta2_ICSHAProxyLoadBalancerStatus is an autogenerated conversion function.
+func Convert_v1beta1_ICSHAProxyLoadBalancerStatus_To_v1beta2_ICSHAProxyLoadBalancerStatus(in *ICSHAProxyLoadBalancerStatus, out *v1beta2.ICSHAProxyLoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSHAProxyLoadBalancerStatus_To_v1beta2_ICSHAProxyLoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSHAProxyLoadBalancerStatus_To_v1beta1_ICSHAProxyLoadBalancerStatus(in *v1beta2.ICSHAProxyLoadBalancerStatus, out *ICSHAProxyLoadBalancerStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.Address = in.Address
+	out.Backends = *(*[]string)(unsafe.Pointer(&in.Backends))
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta2_ICSHAProxyLoadBalancerStatus_To_v1beta1_ICSHAProxyLoadBalancerStatus is an autogenerated conversion function.
+func Convert_v1beta2_ICSHAProxyLoadBalancerStatus_To_v1beta1_ICSHAProxyLoadBalancerStatus(in *v1beta2.ICSHAProxyLoadBalancerStatus, out *ICSHAProxyLoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSHAProxyLoadBalancerStatus_To_v1beta1_ICSHAProxyLoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSIdentityReference_To_v1beta2_ICSIdentityReference(in *ICSIdentityReference, out *v1beta2.ICSIdentityReference, s conversion.Scope) error {
+	out.Kind = v1beta2.ICSIdentityKind(in.Kind)
+	out.Name = in.Name
+	out.IdentityKey = (*string)(unsafe.Pointer(in.IdentityKey))
+	return nil
+}
+
+// Convert_v1beta1_ICSIdentityReference_To_v1beta2_ICSIdentityReference is an autogenerated conversion function.
+func Convert_v1beta1_ICSIdentityReference_To_v1beta2_ICSIdentityReference(in *ICSIdentityReference, out *v1beta2.ICSIdentityReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSIdentityReference_To_v1beta2_ICSIdentityReference(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSIdentityReference_To_v1beta1_ICSIdentityReference(in *v1beta2.ICSIdentityReference, out *ICSIdentityReference, s conversion.Scope) error {
+	out.Kind = ICSIdentityKind(in.Kind)
+	out.Name = in.Name
+	out.IdentityKey = (*string)(unsafe.Pointer(in.IdentityKey))
+	return nil
+}
+
+// Convert_v1beta2_ICSIdentityReference_To_v1beta1_ICSIdentityReference is an autogenerated conversion function.
+func Convert_v1beta2_ICSIdentityReference_To_v1beta1_ICSIdentityReference(in *v1beta2.ICSIdentityReference, out *ICSIdentityReference, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSIdentityReference_To_v1beta1_ICSIdentityReference(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachine_To_v1beta2_ICSMachine(in *ICSMachine, out *v1beta2.ICSMachine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ICSMachineSpec_To_v1beta2_ICSMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ICSMachineStatus_To_v1beta2_ICSMachineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSMachine_To_v1beta2_ICSMachine is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachine_To_v1beta2_ICSMachine(in *ICSMachine, out *v1beta2.ICSMachine, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachine_To_v1beta2_ICSMachine(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachine_To_v1beta1_ICSMachine(in *v1beta2.ICSMachine, out *ICSMachine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta2_ICSMachineSpec_To_v1beta1_ICSMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_ICSMachineStatus_To_v1beta1_ICSMachineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSMachine_To_v1beta1_ICSMachine is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachine_To_v1beta1_ICSMachine(in *v1beta2.ICSMachine, out *ICSMachine, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachine_To_v1beta1_ICSMachine(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachineList_To_v1beta2_ICSMachineList(in *ICSMachineList, out *v1beta2.ICSMachineList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.ICSMachine, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ICSMachine_To_v1beta2_ICSMachine(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSMachineList_To_v1beta2_ICSMachineList is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachineList_To_v1beta2_ICSMachineList(in *ICSMachineList, out *v1beta2.ICSMachineList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachineList_To_v1beta2_ICSMachineList(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachineList_To_v1beta1_ICSMachineList(in *v1beta2.ICSMachineList, out *ICSMachineList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSMachine, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ICSMachine_To_v1beta1_ICSMachine(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSMachineList_To_v1beta1_ICSMachineList is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachineList_To_v1beta1_ICSMachineList(in *v1beta2.ICSMachineList, out *ICSMachineList, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachineList_To_v1beta1_ICSMachineList(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachinePool_To_v1beta2_ICSMachinePool(in *ICSMachinePool, out *v1beta2.ICSMachinePool, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ICSMachinePoolSpec_To_v1beta2_ICSMachinePoolSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ICSMachinePoolStatus_To_v1beta2_ICSMachinePoolStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSMachinePool_To_v1beta2_ICSMachinePool is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachinePool_To_v1beta2_ICSMachinePool(in *ICSMachinePool, out *v1beta2.ICSMachinePool, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachinePool_To_v1beta2_ICSMachinePool(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachinePool_To_v1beta1_ICSMachinePool(in *v1beta2.ICSMachinePool, out *ICSMachinePool, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta2_ICSMachinePoolSpec_To_v1beta1_ICSMachinePoolSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_ICSMachinePoolStatus_To_v1beta1_ICSMachinePoolStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSMachinePool_To_v1beta1_ICSMachinePool is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachinePool_To_v1beta1_ICSMachinePool(in *v1beta2.ICSMachinePool, out *ICSMachinePool, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachinePool_To_v1beta1_ICSMachinePool(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachinePoolInstanceStatus_To_v1beta2_ICSMachinePoolInstanceStatus(in *ICSMachinePoolInstanceStatus, out *v1beta2.ICSMachinePoolInstanceStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProviderID = in.ProviderID
+	out.Host = in.Host
+	out.Ready = in.Ready
+	out.UpToDate = in.UpToDate
+	return nil
+}
+
+// Convert_v1beta1_ICSMachinePoolInstanceStatus_To_v1beta2_ICSMachinePoolInstanceStatus is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachinePoolInstanceStatus_To_v1beta2_ICSMachinePoolInstanceStatus(in *ICSMachinePoolInstanceStatus, out *v1beta2.ICSMachinePoolInstanceStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachinePoolInstanceStatus_To_v1beta2_ICSMachinePoolInstanceStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachinePoolInstanceStatus_To_v1beta1_ICSMachinePoolInstanceStatus(in *v1beta2.ICSMachinePoolInstanceStatus, out *ICSMachinePoolInstanceStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProviderID = in.ProviderID
+	out.Host = in.Host
+	out.Ready = in.Ready
+	out.UpToDate = in.UpToDate
+	return nil
+}
+
+// Convert_v1beta2_ICSMachinePoolInstanceStatus_To_v1beta1_ICSMachinePoolInstanceStatus is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachinePoolInstanceStatus_To_v1beta1_ICSMachinePoolInstanceStatus(in *v1beta2.ICSMachinePoolInstanceStatus, out *ICSMachinePoolInstanceStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachinePoolInstanceStatus_To_v1beta1_ICSMachinePoolInstanceStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachinePoolList_To_v1beta2_ICSMachinePoolList(in *ICSMachinePoolList, out *v1beta2.ICSMachinePoolList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.ICSMachinePool, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ICSMachinePool_To_v1beta2_ICSMachinePool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSMachinePoolList_To_v1beta2_ICSMachinePoolList is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachinePoolList_To_v1beta2_ICSMachinePoolList(in *ICSMachinePoolList, out *v1beta2.ICSMachinePoolList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachinePoolList_To_v1beta2_ICSMachinePoolList(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachinePoolList_To_v1beta1_ICSMachinePoolList(in *v1beta2.ICSMachinePoolList, out *ICSMachinePoolList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSMachinePool, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ICSMachinePool_To_v1beta1_ICSMachinePool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSMachinePoolList_To_v1beta1_ICSMachinePoolList is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachinePoolList_To_v1beta1_ICSMachinePoolList(in *v1beta2.ICSMachinePoolList, out *ICSMachinePoolList, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachinePoolList_To_v1beta1_ICSMachinePoolList(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachinePoolSpec_To_v1beta2_ICSMachinePoolSpec(in *ICSMachinePoolSpec, out *v1beta2.ICSMachinePoolSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_VirtualMachineCloneSpec_To_v1beta2_VirtualMachineCloneSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	out.ProviderIDList = *(*[]string)(unsafe.Pointer(&in.ProviderIDList))
+	out.ScaleDownPolicy = v1beta2.MachinePoolScaleDownPolicy(in.ScaleDownPolicy)
+	out.RollingUpdate = (*v1beta2.MachinePoolRollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	return nil
+}
+
+// Convert_v1beta1_ICSMachinePoolSpec_To_v1beta2_ICSMachinePoolSpec is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachinePoolSpec_To_v1beta2_ICSMachinePoolSpec(in *ICSMachinePoolSpec, out *v1beta2.ICSMachinePoolSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachinePoolSpec_To_v1beta2_ICSMachinePoolSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachinePoolSpec_To_v1beta1_ICSMachinePoolSpec(in *v1beta2.ICSMachinePoolSpec, out *ICSMachinePoolSpec, s conversion.Scope) error {
+	if err := Convert_v1beta2_VirtualMachineCloneSpec_To_v1beta1_VirtualMachineCloneSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	out.ProviderIDList = *(*[]string)(unsafe.Pointer(&in.ProviderIDList))
+	out.ScaleDownPolicy = MachinePoolScaleDownPolicy(in.ScaleDownPolicy)
+	out.RollingUpdate = (*MachinePoolRollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	return nil
+}
+
+// Convert_v1beta2_ICSMachinePoolSpec_To_v1beta1_ICSMachinePoolSpec is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachinePoolSpec_To_v1beta1_ICSMachinePoolSpec(in *v1beta2.ICSMachinePoolSpec, out *ICSMachinePoolSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachinePoolSpec_To_v1beta1_ICSMachinePoolSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachinePoolStatus_To_v1beta2_ICSMachinePoolStatus(in *ICSMachinePoolStatus, out *v1beta2.ICSMachinePoolStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.Replicas = in.Replicas
+	out.Instances = *(*[]v1beta2.ICSMachinePoolInstanceStatus)(unsafe.Pointer(&in.Instances))
+	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
+	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_ICSMachinePoolStatus_To_v1beta2_ICSMachinePoolStatus is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachinePoolStatus_To_v1beta2_ICSMachinePoolStatus(in *ICSMachinePoolStatus, out *v1beta2.ICSMachinePoolStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachinePoolStatus_To_v1beta2_ICSMachinePoolStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachinePoolStatus_To_v1beta1_ICSMachinePoolStatus(in *v1beta2.ICSMachinePoolStatus, out *ICSMachinePoolStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.Replicas = in.Replicas
+	out.Instances = *(*[]ICSMachinePoolInstanceStatus)(unsafe.Pointer(&in.Instances))
+	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
+	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta2_ICSMachinePoolStatus_To_v1beta1_ICSMachinePoolStatus is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachinePoolStatus_To_v1beta1_ICSMachinePoolStatus(in *v1beta2.ICSMachinePoolStatus, out *ICSMachinePoolStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachinePoolStatus_To_v1beta1_ICSMachinePoolStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachineSpec_To_v1beta2_ICSMachineSpec(in *ICSMachineSpec, out *v1beta2.ICSMachineSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_VirtualMachineCloneSpec_To_v1beta2_VirtualMachineCloneSpec(&in.VirtualMachineCloneSpec, &out.VirtualMachineCloneSpec, s); err != nil {
+		return err
+	}
+	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
+	return nil
+}
+
+// Convert_v1beta1_ICSMachineSpec_To_v1beta2_ICSMachineSpec is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachineSpec_To_v1beta2_ICSMachineSpec(in *ICSMachineSpec, out *v1beta2.ICSMachineSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachineSpec_To_v1beta2_ICSMachineSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachineSpec_To_v1beta1_ICSMachineSpec(in *v1beta2.ICSMachineSpec, out *ICSMachineSpec, s conversion.Scope) error {
+	if err := Convert_v1beta2_VirtualMachineCloneSpec_To_v1beta1_VirtualMachineCloneSpec(&in.VirtualMachineCloneSpec, &out.VirtualMachineCloneSpec, s); err != nil {
+		return err
+	}
+	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
+	return nil
+}
+
+// Convert_v1beta2_ICSMachineSpec_To_v1beta1_ICSMachineSpec is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachineSpec_To_v1beta1_ICSMachineSpec(in *v1beta2.ICSMachineSpec, out *ICSMachineSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachineSpec_To_v1beta1_ICSMachineSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachineStatus_To_v1beta2_ICSMachineStatus(in *ICSMachineStatus, out *v1beta2.ICSMachineStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.Addresses = *(*[]apiv1beta1.MachineAddress)(unsafe.Pointer(&in.Addresses))
+	out.Network = *(*[]v1beta2.NetworkStatus)(unsafe.Pointer(&in.Network))
+	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
+	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_ICSMachineStatus_To_v1beta2_ICSMachineStatus is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachineStatus_To_v1beta2_ICSMachineStatus(in *ICSMachineStatus, out *v1beta2.ICSMachineStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachineStatus_To_v1beta2_ICSMachineStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachineStatus_To_v1beta1_ICSMachineStatus(in *v1beta2.ICSMachineStatus, out *ICSMachineStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.Addresses = *(*[]apiv1beta1.MachineAddress)(unsafe.Pointer(&in.Addresses))
+	out.Network = *(*[]NetworkStatus)(unsafe.Pointer(&in.Network))
+	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
+	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta2_ICSMachineStatus_To_v1beta1_ICSMachineStatus is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachineStatus_To_v1beta1_ICSMachineStatus(in *v1beta2.ICSMachineStatus, out *ICSMachineStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachineStatus_To_v1beta1_ICSMachineStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachineTemplate_To_v1beta2_ICSMachineTemplate(in *ICSMachineTemplate, out *v1beta2.ICSMachineTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ICSMachineTemplateSpec_To_v1beta2_ICSMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ICSMachineTemplateStatus_To_v1beta2_ICSMachineTemplateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSMachineTemplate_To_v1beta2_ICSMachineTemplate is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachineTemplate_To_v1beta2_ICSMachineTemplate(in *ICSMachineTemplate, out *v1beta2.ICSMachineTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachineTemplate_To_v1beta2_ICSMachineTemplate(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachineTemplate_To_v1beta1_ICSMachineTemplate(in *v1beta2.ICSMachineTemplate, out *ICSMachineTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta2_ICSMachineTemplateSpec_To_v1beta1_ICSMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_ICSMachineTemplateStatus_To_v1beta1_ICSMachineTemplateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSMachineTemplate_To_v1beta1_ICSMachineTemplate is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachineTemplate_To_v1beta1_ICSMachineTemplate(in *v1beta2.ICSMachineTemplate, out *ICSMachineTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachineTemplate_To_v1beta1_ICSMachineTemplate(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachineTemplateList_To_v1beta2_ICSMachineTemplateList(in *ICSMachineTemplateList, out *v1beta2.ICSMachineTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.ICSMachineTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ICSMachineTemplate_To_v1beta2_ICSMachineTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSMachineTemplateList_To_v1beta2_ICSMachineTemplateList is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachineTemplateList_To_v1beta2_ICSMachineTemplateList(in *ICSMachineTemplateList, out *v1beta2.ICSMachineTemplateList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachineTemplateList_To_v1beta2_ICSMachineTemplateList(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachineTemplateList_To_v1beta1_ICSMachineTemplateList(in *v1beta2.ICSMachineTemplateList, out *ICSMachineTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSMachineTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ICSMachineTemplate_To_v1beta1_ICSMachineTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSMachineTemplateList_To_v1beta1_ICSMachineTemplateList is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachineTemplateList_To_v1beta1_ICSMachineTemplateList(in *v1beta2.ICSMachineTemplateList, out *ICSMachineTemplateList, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachineTemplateList_To_v1beta1_ICSMachineTemplateList(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachineTemplateResource_To_v1beta2_ICSMachineTemplateResource(in *ICSMachineTemplateResource, out *v1beta2.ICSMachineTemplateResource, s conversion.Scope) error {
+	if err := Convert_v1beta1_ICSMachineSpec_To_v1beta2_ICSMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSMachineTemplateResource_To_v1beta2_ICSMachineTemplateResource is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachineTemplateResource_To_v1beta2_ICSMachineTemplateResource(in *ICSMachineTemplateResource, out *v1beta2.ICSMachineTemplateResource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachineTemplateResource_To_v1beta2_ICSMachineTemplateResource(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachineTemplateResource_To_v1beta1_ICSMachineTemplateResource(in *v1beta2.ICSMachineTemplateResource, out *ICSMachineTemplateResource, s conversion.Scope) error {
+	if err := Convert_v1beta2_ICSMachineSpec_To_v1beta1_ICSMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSMachineTemplateResource_To_v1beta1_ICSMachineTemplateResource is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachineTemplateResource_To_v1beta1_ICSMachineTemplateResource(in *v1beta2.ICSMachineTemplateResource, out *ICSMachineTemplateResource, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachineTemplateResource_To_v1beta1_ICSMachineTemplateResource(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachineTemplateSpec_To_v1beta2_ICSMachineTemplateSpec(in *ICSMachineTemplateSpec, out *v1beta2.ICSMachineTemplateSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_ICSMachineTemplateResource_To_v1beta2_ICSMachineTemplateResource(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSMachineTemplateSpec_To_v1beta2_ICSMachineTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachineTemplateSpec_To_v1beta2_ICSMachineTemplateSpec(in *ICSMachineTemplateSpec, out *v1beta2.ICSMachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachineTemplateSpec_To_v1beta2_ICSMachineTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachineTemplateSpec_To_v1beta1_ICSMachineTemplateSpec(in *v1beta2.ICSMachineTemplateSpec, out *ICSMachineTemplateSpec, s conversion.Scope) error {
+	if err := Convert_v1beta2_ICSMachineTemplateResource_To_v1beta1_ICSMachineTemplateResource(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSMachineTemplateSpec_To_v1beta1_ICSMachineTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachineTemplateSpec_To_v1beta1_ICSMachineTemplateSpec(in *v1beta2.ICSMachineTemplateSpec, out *ICSMachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachineTemplateSpec_To_v1beta1_ICSMachineTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSMachineTemplateStatus_To_v1beta2_ICSMachineTemplateStatus(in *ICSMachineTemplateStatus, out *v1beta2.ICSMachineTemplateStatus, s conversion.Scope) error {
+	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.NodeInfo = (*v1beta2.NodeInfo)(unsafe.Pointer(in.NodeInfo))
+	return nil
+}
+
+// Convert_v1beta1_ICSMachineTemplateStatus_To_v1beta2_ICSMachineTemplateStatus is an autogenerated conversion function.
+func Convert_v1beta1_ICSMachineTemplateStatus_To_v1beta2_ICSMachineTemplateStatus(in *ICSMachineTemplateStatus, out *v1beta2.ICSMachineTemplateStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSMachineTemplateStatus_To_v1beta2_ICSMachineTemplateStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSMachineTemplateStatus_To_v1beta1_ICSMachineTemplateStatus(in *v1beta2.ICSMachineTemplateStatus, out *ICSMachineTemplateStatus, s conversion.Scope) error {
+	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.NodeInfo = (*NodeInfo)(unsafe.Pointer(in.NodeInfo))
+	return nil
+}
+
+// Convert_v1beta2_ICSMachineTemplateStatus_To_v1beta1_ICSMachineTemplateStatus is an autogenerated conversion function.
+func Convert_v1beta2_ICSMachineTemplateStatus_To_v1beta1_ICSMachineTemplateStatus(in *v1beta2.ICSMachineTemplateStatus, out *ICSMachineTemplateStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSMachineTemplateStatus_To_v1beta1_ICSMachineTemplateStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSVM_To_v1beta2_ICSVM(in *ICSVM, out *v1beta2.ICSVM, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ICSVMSpec_To_v1beta2_ICSVMSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ICSVMStatus_To_v1beta2_ICSVMStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSVM_To_v1beta2_ICSVM is an autogenerated conversion function.
+func Convert_v1beta1_ICSVM_To_v1beta2_ICSVM(in *ICSVM, out *v1beta2.ICSVM, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSVM_To_v1beta2_ICSVM(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSVM_To_v1beta1_ICSVM(in *v1beta2.ICSVM, out *ICSVM, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta2_ICSVMSpec_To_v1beta1_ICSVMSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_ICSVMStatus_To_v1beta1_ICSVMStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSVM_To_v1beta1_ICSVM is an autogenerated conversion function.
+func Convert_v1beta2_ICSVM_To_v1beta1_ICSVM(in *v1beta2.ICSVM, out *ICSVM, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSVM_To_v1beta1_ICSVM(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSVMImage_To_v1beta2_ICSVMImage(in *ICSVMImage, out *v1beta2.ICSVMImage, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ICSVMImageSpec_To_v1beta2_ICSVMImageSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ICSVMImageStatus_To_v1beta2_ICSVMImageStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSVMImage_To_v1beta2_ICSVMImage is an autogenerated conversion function.
+func Convert_v1beta1_ICSVMImage_To_v1beta2_ICSVMImage(in *ICSVMImage, out *v1beta2.ICSVMImage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSVMImage_To_v1beta2_ICSVMImage(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSVMImage_To_v1beta1_ICSVMImage(in *v1beta2.ICSVMImage, out *ICSVMImage, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta2_ICSVMImageSpec_To_v1beta1_ICSVMImageSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_ICSVMImageStatus_To_v1beta1_ICSVMImageStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSVMImage_To_v1beta1_ICSVMImage is an autogenerated conversion function.
+func Convert_v1beta2_ICSVMImage_To_v1beta1_ICSVMImage(in *v1beta2.ICSVMImage, out *ICSVMImage, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSVMImage_To_v1beta1_ICSVMImage(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSVMImageList_To_v1beta2_ICSVMImageList(in *ICSVMImageList, out *v1beta2.ICSVMImageList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1beta2.ICSVMImage)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ICSVMImageList_To_v1beta2_ICSVMImageList is an autogenerated conversion function.
+func Convert_v1beta1_ICSVMImageList_To_v1beta2_ICSVMImageList(in *ICSVMImageList, out *v1beta2.ICSVMImageList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSVMImageList_To_v1beta2_ICSVMImageList(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSVMImageList_To_v1beta1_ICSVMImageList(in *v1beta2.ICSVMImageList, out *ICSVMImageList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ICSVMImage)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta2_ICSVMImageList_To_v1beta1_ICSVMImageList is an autogenerated conversion function.
+func Convert_v1beta2_ICSVMImageList_To_v1beta1_ICSVMImageList(in *v1beta2.ICSVMImageList, out *ICSVMImageList, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSVMImageList_To_v1beta1_ICSVMImageList(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSVMImageSource_To_v1beta2_ICSVMImageSource(in *ICSVMImageSource, out *v1beta2.ICSVMImageSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.OCI = (*v1beta2.OCIImageSource)(unsafe.Pointer(in.OCI))
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_v1beta1_ICSVMImageSource_To_v1beta2_ICSVMImageSource is an autogenerated conversion function.
+func Convert_v1beta1_ICSVMImageSource_To_v1beta2_ICSVMImageSource(in *ICSVMImageSource, out *v1beta2.ICSVMImageSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSVMImageSource_To_v1beta2_ICSVMImageSource(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSVMImageSource_To_v1beta1_ICSVMImageSource(in *v1beta2.ICSVMImageSource, out *ICSVMImageSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.OCI = (*OCIImageSource)(unsafe.Pointer(in.OCI))
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_v1beta2_ICSVMImageSource_To_v1beta1_ICSVMImageSource is an autogenerated conversion function.
+func Convert_v1beta2_ICSVMImageSource_To_v1beta1_ICSVMImageSource(in *v1beta2.ICSVMImageSource, out *ICSVMImageSource, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSVMImageSource_To_v1beta1_ICSVMImageSource(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSVMImageSpec_To_v1beta2_ICSVMImageSpec(in *ICSVMImageSpec, out *v1beta2.ICSVMImageSpec, s conversion.Scope) error {
+	out.CloudName = in.CloudName
+	out.IdentityRef = (*v1beta2.ICSIdentityReference)(unsafe.Pointer(in.IdentityRef))
+	if err := Convert_v1beta1_ICSVMImageSource_To_v1beta2_ICSVMImageSource(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	out.ImageName = in.ImageName
+	out.Datastores = *(*[]string)(unsafe.Pointer(&in.Datastores))
+	out.Checksum = in.Checksum
+	out.Template = (*v1beta2.ICSVMImageTemplateSpec)(unsafe.Pointer(in.Template))
+	return nil
+}
+
+// Convert_v1beta1_ICSVMImageSpec_To_v1beta2_ICSVMImageSpec is an autogenerated conversion function.
+func Convert_v1beta1_ICSVMImageSpec_To_v1beta2_ICSVMImageSpec(in *ICSVMImageSpec, out *v1beta2.ICSVMImageSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSVMImageSpec_To_v1beta2_ICSVMImageSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSVMImageSpec_To_v1beta1_ICSVMImageSpec(in *v1beta2.ICSVMImageSpec, out *ICSVMImageSpec, s conversion.Scope) error {
+	out.CloudName = in.CloudName
+	out.IdentityRef = (*ICSIdentityReference)(unsafe.Pointer(in.IdentityRef))
+	if err := Convert_v1beta2_ICSVMImageSource_To_v1beta1_ICSVMImageSource(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	out.ImageName = in.ImageName
+	out.Datastores = *(*[]string)(unsafe.Pointer(&in.Datastores))
+	out.Checksum = in.Checksum
+	out.Template = (*ICSVMImageTemplateSpec)(unsafe.Pointer(in.Template))
+	return nil
+}
+
+// Convert_v1beta2_ICSVMImageSpec_To_v1beta1_ICSVMImageSpec is an autogenerated conversion function.
+func Convert_v1beta2_ICSVMImageSpec_To_v1beta1_ICSVMImageSpec(in *v1beta2.ICSVMImageSpec, out *ICSVMImageSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSVMImageSpec_To_v1beta1_ICSVMImageSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSVMImageStatus_To_v1beta2_ICSVMImageStatus(in *ICSVMImageStatus, out *v1beta2.ICSVMImageStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.ImageName = in.ImageName
+	out.Checksum = in.Checksum
+	out.Datastores = *(*[]string)(unsafe.Pointer(&in.Datastores))
+	out.Uploading = *(*[]string)(unsafe.Pointer(&in.Uploading))
+	out.TemplateName = in.TemplateName
+	out.TaskRef = in.TaskRef
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_ICSVMImageStatus_To_v1beta2_ICSVMImageStatus is an autogenerated conversion function.
+func Convert_v1beta1_ICSVMImageStatus_To_v1beta2_ICSVMImageStatus(in *ICSVMImageStatus, out *v1beta2.ICSVMImageStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSVMImageStatus_To_v1beta2_ICSVMImageStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSVMImageStatus_To_v1beta1_ICSVMImageStatus(in *v1beta2.ICSVMImageStatus, out *ICSVMImageStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.ImageName = in.ImageName
+	out.Checksum = in.Checksum
+	out.Datastores = *(*[]string)(unsafe.Pointer(&in.Datastores))
+	out.Uploading = *(*[]string)(unsafe.Pointer(&in.Uploading))
+	out.TemplateName = in.TemplateName
+	out.TaskRef = in.TaskRef
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta2_ICSVMImageStatus_To_v1beta1_ICSVMImageStatus is an autogenerated conversion function.
+func Convert_v1beta2_ICSVMImageStatus_To_v1beta1_ICSVMImageStatus(in *v1beta2.ICSVMImageStatus, out *ICSVMImageStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSVMImageStatus_To_v1beta1_ICSVMImageStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSVMImageTemplateSpec_To_v1beta2_ICSVMImageTemplateSpec(in *ICSVMImageTemplateSpec, out *v1beta2.ICSVMImageTemplateSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Datastore = in.Datastore
+	out.Cluster = in.Cluster
+	return nil
+}
+
+// Convert_v1beta1_ICSVMImageTemplateSpec_To_v1beta2_ICSVMImageTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_ICSVMImageTemplateSpec_To_v1beta2_ICSVMImageTemplateSpec(in *ICSVMImageTemplateSpec, out *v1beta2.ICSVMImageTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSVMImageTemplateSpec_To_v1beta2_ICSVMImageTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSVMImageTemplateSpec_To_v1beta1_ICSVMImageTemplateSpec(in *v1beta2.ICSVMImageTemplateSpec, out *ICSVMImageTemplateSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Datastore = in.Datastore
+	out.Cluster = in.Cluster
+	return nil
+}
+
+// Convert_v1beta2_ICSVMImageTemplateSpec_To_v1beta1_ICSVMImageTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta2_ICSVMImageTemplateSpec_To_v1beta1_ICSVMImageTemplateSpec(in *v1beta2.ICSVMImageTemplateSpec, out *ICSVMImageTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSVMImageTemplateSpec_To_v1beta1_ICSVMImageTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSVMList_To_v1beta2_ICSVMList(in *ICSVMList, out *v1beta2.ICSVMList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.ICSVM, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ICSVM_To_v1beta2_ICSVM(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ICSVMList_To_v1beta2_ICSVMList is an autogenerated conversion function.
+func Convert_v1beta1_ICSVMList_To_v1beta2_ICSVMList(in *ICSVMList, out *v1beta2.ICSVMList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSVMList_To_v1beta2_ICSVMList(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSVMList_To_v1beta1_ICSVMList(in *v1beta2.ICSVMList, out *ICSVMList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSVM, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ICSVM_To_v1beta1_ICSVM(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta2_ICSVMList_To_v1beta1_ICSVMList is an autogenerated conversion function.
+func Convert_v1beta2_ICSVMList_To_v1beta1_ICSVMList(in *v1beta2.ICSVMList, out *ICSVMList, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSVMList_To_v1beta1_ICSVMList(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSVMSpec_To_v1beta2_ICSVMSpec(in *ICSVMSpec, out *v1beta2.ICSVMSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_VirtualMachineCloneSpec_To_v1beta2_VirtualMachineCloneSpec(&in.VirtualMachineCloneSpec, &out.VirtualMachineCloneSpec, s); err != nil {
+		return err
+	}
+	out.BootstrapRef = (*v1.ObjectReference)(unsafe.Pointer(in.BootstrapRef))
+	out.BiosUUID = in.BiosUUID
+	out.UID = in.UID
+	return nil
+}
+
+// Convert_v1beta1_ICSVMSpec_To_v1beta2_ICSVMSpec is an autogenerated conversion function.
+func Convert_v1beta1_ICSVMSpec_To_v1beta2_ICSVMSpec(in *ICSVMSpec, out *v1beta2.ICSVMSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ICSVMSpec_To_v1beta2_ICSVMSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_ICSVMSpec_To_v1beta1_ICSVMSpec(in *v1beta2.ICSVMSpec, out *ICSVMSpec, s conversion.Scope) error {
+	if err := Convert_v1beta2_VirtualMachineCloneSpec_To_v1beta1_VirtualMachineCloneSpec(&in.VirtualMachineCloneSpec, &out.VirtualMachineCloneSpec, s); err != nil {
+		return err
+	}
+	out.BootstrapRef = (*v1.ObjectReference)(unsafe.Pointer(in.BootstrapRef))
+	out.BiosUUID = in.BiosUUID
+	out.UID = in.UID
+	return nil
+}
+
+// Convert_v1beta2_ICSVMSpec_To_v1beta1_ICSVMSpec is an autogenerated conversion function.
+func Convert_v1beta2_ICSVMSpec_To_v1beta1_ICSVMSpec(in *v1beta2.ICSVMSpec, out *ICSVMSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSVMSpec_To_v1beta1_ICSVMSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ICSVMStatus_To_v1beta2_ICSVMStatus(in *ICSVMStatus, out *v1beta2.ICSVMStatus, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Ready = in.Ready
+	// INFO: in.Addresses opted out of conversion generation
+	out.CloneMode = v1beta2.CloneMode(in.CloneMode)
+	out.Snapshot = in.Snapshot
+	out.TaskRef = in.TaskRef
+	out.Network = *(*[]v1beta2.NetworkStatus)(unsafe.Pointer(&in.Network))
+	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
+	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	out.ModuleUUID = (*string)(unsafe.Pointer(in.ModuleUUID))
+	out.RetainedVolumes = *(*[]string)(unsafe.Pointer(&in.RetainedVolumes))
+	out.Disks = *(*[]v1beta2.DiskStatus)(unsafe.Pointer(&in.Disks))
+	return nil
+}
+
+func autoConvert_v1beta2_ICSVMStatus_To_v1beta1_ICSVMStatus(in *v1beta2.ICSVMStatus, out *ICSVMStatus, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Ready = in.Ready
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_MachineAddress_To_string(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Addresses = nil
+	}
+	out.CloneMode = CloneMode(in.CloneMode)
+	out.Snapshot = in.Snapshot
+	out.TaskRef = in.TaskRef
+	out.Network = *(*[]NetworkStatus)(unsafe.Pointer(&in.Network))
+	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
+	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	out.ModuleUUID = (*string)(unsafe.Pointer(in.ModuleUUID))
+	out.RetainedVolumes = *(*[]string)(unsafe.Pointer(&in.RetainedVolumes))
+	out.Disks = *(*[]DiskStatus)(unsafe.Pointer(&in.Disks))
+	return nil
+}
+
+// Convert_v1beta2_ICSVMStatus_To_v1beta1_ICSVMStatus is an autogenerated conversion function.
+func Convert_v1beta2_ICSVMStatus_To_v1beta1_ICSVMStatus(in *v1beta2.ICSVMStatus, out *ICSVMStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_ICSVMStatus_To_v1beta1_ICSVMStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_IPAddress_To_v1beta2_IPAddress(in *IPAddress, out *v1beta2.IPAddress, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_IPAddressSpec_To_v1beta2_IPAddressSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_IPAddress_To_v1beta2_IPAddress is an autogenerated conversion function.
+func Convert_v1beta1_IPAddress_To_v1beta2_IPAddress(in *IPAddress, out *v1beta2.IPAddress, s conversion.Scope) error {
+	return autoConvert_v1beta1_IPAddress_To_v1beta2_IPAddress(in, out, s)
+}
+
+func autoConvert_v1beta2_IPAddress_To_v1beta1_IPAddress(in *v1beta2.IPAddress, out *IPAddress, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta2_IPAddressSpec_To_v1beta1_IPAddressSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_IPAddress_To_v1beta1_IPAddress is an autogenerated conversion function.
+func Convert_v1beta2_IPAddress_To_v1beta1_IPAddress(in *v1beta2.IPAddress, out *IPAddress, s conversion.Scope) error {
+	return autoConvert_v1beta2_IPAddress_To_v1beta1_IPAddress(in, out, s)
+}
+
+func autoConvert_v1beta1_IPAddressList_To_v1beta2_IPAddressList(in *IPAddressList, out *v1beta2.IPAddressList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.IPAddress, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_IPAddress_To_v1beta2_IPAddress(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_IPAddressList_To_v1beta2_IPAddressList is an autogenerated conversion function.
+func Convert_v1beta1_IPAddressList_To_v1beta2_IPAddressList(in *IPAddressList, out *v1beta2.IPAddressList, s conversion.Scope) error {
+	return autoConvert_v1beta1_IPAddressList_To_v1beta2_IPAddressList(in, out, s)
+}
+
+func autoConvert_v1beta2_IPAddressList_To_v1beta1_IPAddressList(in *v1beta2.IPAddressList, out *IPAddressList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAddress, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_IPAddress_To_v1beta1_IPAddress(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta2_IPAddressList_To_v1beta1_IPAddressList is an autogenerated conversion function.
+func Convert_v1beta2_IPAddressList_To_v1beta1_IPAddressList(in *v1beta2.IPAddressList, out *IPAddressList, s conversion.Scope) error {
+	return autoConvert_v1beta2_IPAddressList_To_v1beta1_IPAddressList(in, out, s)
+}
+
+func autoConvert_v1beta1_IPAddressSpec_To_v1beta2_IPAddressSpec(in *IPAddressSpec, out *v1beta2.IPAddressSpec, s conversion.Scope) error {
+	out.VMRef = in.VMRef
+	out.TemplateRef = in.TemplateRef
+	out.Prefix = int32(in.Prefix)
+	out.Gateway = (*string)(unsafe.Pointer(in.Gateway))
+	out.Address = in.Address
+	out.MACAddr = in.MACAddr
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	return nil
+}
+
+// Convert_v1beta1_IPAddressSpec_To_v1beta2_IPAddressSpec is an autogenerated conversion function.
+func Convert_v1beta1_IPAddressSpec_To_v1beta2_IPAddressSpec(in *IPAddressSpec, out *v1beta2.IPAddressSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_IPAddressSpec_To_v1beta2_IPAddressSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_IPAddressSpec_To_v1beta1_IPAddressSpec(in *v1beta2.IPAddressSpec, out *IPAddressSpec, s conversion.Scope) error {
+	out.VMRef = in.VMRef
+	out.TemplateRef = in.TemplateRef
+	out.Prefix = int(in.Prefix)
+	out.Gateway = (*string)(unsafe.Pointer(in.Gateway))
+	out.Address = in.Address
+	out.MACAddr = in.MACAddr
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	return nil
+}
+
+// Convert_v1beta2_IPAddressSpec_To_v1beta1_IPAddressSpec is an autogenerated conversion function.
+func Convert_v1beta2_IPAddressSpec_To_v1beta1_IPAddressSpec(in *v1beta2.IPAddressSpec, out *IPAddressSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_IPAddressSpec_To_v1beta1_IPAddressSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_LoadBalancerSpec_To_v1beta2_LoadBalancerSpec(in *LoadBalancerSpec, out *v1beta2.LoadBalancerSpec, s conversion.Scope) error {
+	out.NetworkID = in.NetworkID
+	out.SubnetID = in.SubnetID
+	out.VIPAddress = in.VIPAddress
+	return nil
+}
+
+// Convert_v1beta1_LoadBalancerSpec_To_v1beta2_LoadBalancerSpec is an autogenerated conversion function.
+func Convert_v1beta1_LoadBalancerSpec_To_v1beta2_LoadBalancerSpec(in *LoadBalancerSpec, out *v1beta2.LoadBalancerSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_LoadBalancerSpec_To_v1beta2_LoadBalancerSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(in *v1beta2.LoadBalancerSpec, out *LoadBalancerSpec, s conversion.Scope) error {
+	out.NetworkID = in.NetworkID
+	out.SubnetID = in.SubnetID
+	out.VIPAddress = in.VIPAddress
+	return nil
+}
+
+// Convert_v1beta2_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec is an autogenerated conversion function.
+func Convert_v1beta2_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(in *v1beta2.LoadBalancerSpec, out *LoadBalancerSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_LoadBalancerStatus_To_v1beta2_LoadBalancerStatus(in *LoadBalancerStatus, out *v1beta2.LoadBalancerStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
+	out.VIPAddress = in.VIPAddress
+	return nil
+}
+
+// Convert_v1beta1_LoadBalancerStatus_To_v1beta2_LoadBalancerStatus is an autogenerated conversion function.
+func Convert_v1beta1_LoadBalancerStatus_To_v1beta2_LoadBalancerStatus(in *LoadBalancerStatus, out *v1beta2.LoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_LoadBalancerStatus_To_v1beta2_LoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_LoadBalancerStatus_To_v1beta1_LoadBalancerStatus(in *v1beta2.LoadBalancerStatus, out *LoadBalancerStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
+	out.VIPAddress = in.VIPAddress
+	return nil
+}
+
+// Convert_v1beta2_LoadBalancerStatus_To_v1beta1_LoadBalancerStatus is an autogenerated conversion function.
+func Convert_v1beta2_LoadBalancerStatus_To_v1beta1_LoadBalancerStatus(in *v1beta2.LoadBalancerStatus, out *LoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_LoadBalancerStatus_To_v1beta1_LoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDefaults_To_v1beta2_MachineDefaults(in *MachineDefaults, out *v1beta2.MachineDefaults, s conversion.Scope) error {
+	out.Datacenter = in.Datacenter
+	out.Cluster = in.Cluster
+	out.Datastore = in.Datastore
+	out.Template = in.Template
+	out.NetworkDevices = *(*[]v1beta2.NetworkDeviceSpec)(unsafe.Pointer(&in.NetworkDevices))
+	return nil
+}
+
+// Convert_v1beta1_MachineDefaults_To_v1beta2_MachineDefaults is an autogenerated conversion function.
+func Convert_v1beta1_MachineDefaults_To_v1beta2_MachineDefaults(in *MachineDefaults, out *v1beta2.MachineDefaults, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDefaults_To_v1beta2_MachineDefaults(in, out, s)
+}
+
+func autoConvert_v1beta2_MachineDefaults_To_v1beta1_MachineDefaults(in *v1beta2.MachineDefaults, out *MachineDefaults, s conversion.Scope) error {
+	out.Datacenter = in.Datacenter
+	out.Cluster = in.Cluster
+	out.Datastore = in.Datastore
+	out.Template = in.Template
+	out.NetworkDevices = *(*[]NetworkDeviceSpec)(unsafe.Pointer(&in.NetworkDevices))
+	return nil
+}
+
+// Convert_v1beta2_MachineDefaults_To_v1beta1_MachineDefaults is an autogenerated conversion function.
+func Convert_v1beta2_MachineDefaults_To_v1beta1_MachineDefaults(in *v1beta2.MachineDefaults, out *MachineDefaults, s conversion.Scope) error {
+	return autoConvert_v1beta2_MachineDefaults_To_v1beta1_MachineDefaults(in, out, s)
+}
+
+func autoConvert_v1beta1_MachinePoolRollingUpdate_To_v1beta2_MachinePoolRollingUpdate(in *MachinePoolRollingUpdate, out *v1beta2.MachinePoolRollingUpdate, s conversion.Scope) error {
+	out.MaxSurge = (*int32)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*int32)(unsafe.Pointer(in.MaxUnavailable))
+	return nil
+}
+
+// Convert_v1beta1_MachinePoolRollingUpdate_To_v1beta2_MachinePoolRollingUpdate is an autogenerated conversion function.
+func Convert_v1beta1_MachinePoolRollingUpdate_To_v1beta2_MachinePoolRollingUpdate(in *MachinePoolRollingUpdate, out *v1beta2.MachinePoolRollingUpdate, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachinePoolRollingUpdate_To_v1beta2_MachinePoolRollingUpdate(in, out, s)
+}
+
+func autoConvert_v1beta2_MachinePoolRollingUpdate_To_v1beta1_MachinePoolRollingUpdate(in *v1beta2.MachinePoolRollingUpdate, out *MachinePoolRollingUpdate, s conversion.Scope) error {
+	out.MaxSurge = (*int32)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*int32)(unsafe.Pointer(in.MaxUnavailable))
+	return nil
+}
+
+// Convert_v1beta2_MachinePoolRollingUpdate_To_v1beta1_MachinePoolRollingUpdate is an autogenerated conversion function.
+func Convert_v1beta2_MachinePoolRollingUpdate_To_v1beta1_MachinePoolRollingUpdate(in *v1beta2.MachinePoolRollingUpdate, out *MachinePoolRollingUpdate, s conversion.Scope) error {
+	return autoConvert_v1beta2_MachinePoolRollingUpdate_To_v1beta1_MachinePoolRollingUpdate(in, out, s)
+}
+
+func autoConvert_v1beta1_ManagedSecurityGroups_To_v1beta2_ManagedSecurityGroups(in *ManagedSecurityGroups, out *v1beta2.ManagedSecurityGroups, s conversion.Scope) error {
+	out.ControlPlaneRules = *(*[]v1beta2.SecurityGroupRule)(unsafe.Pointer(&in.ControlPlaneRules))
+	out.WorkerRules = *(*[]v1beta2.SecurityGroupRule)(unsafe.Pointer(&in.WorkerRules))
+	return nil
+}
+
+// Convert_v1beta1_ManagedSecurityGroups_To_v1beta2_ManagedSecurityGroups is an autogenerated conversion function.
+func Convert_v1beta1_ManagedSecurityGroups_To_v1beta2_ManagedSecurityGroups(in *ManagedSecurityGroups, out *v1beta2.ManagedSecurityGroups, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedSecurityGroups_To_v1beta2_ManagedSecurityGroups(in, out, s)
+}
+
+func autoConvert_v1beta2_ManagedSecurityGroups_To_v1beta1_ManagedSecurityGroups(in *v1beta2.ManagedSecurityGroups, out *ManagedSecurityGroups, s conversion.Scope) error {
+	out.ControlPlaneRules = *(*[]SecurityGroupRule)(unsafe.Pointer(&in.ControlPlaneRules))
+	out.WorkerRules = *(*[]SecurityGroupRule)(unsafe.Pointer(&in.WorkerRules))
+	return nil
+}
+
+// Convert_v1beta2_ManagedSecurityGroups_To_v1beta1_ManagedSecurityGroups is an autogenerated conversion function.
+func Convert_v1beta2_ManagedSecurityGroups_To_v1beta1_ManagedSecurityGroups(in *v1beta2.ManagedSecurityGroups, out *ManagedSecurityGroups, s conversion.Scope) error {
+	return autoConvert_v1beta2_ManagedSecurityGroups_To_v1beta1_ManagedSecurityGroups(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkDeviceSpec_To_v1beta2_NetworkDeviceSpec(in *NetworkDeviceSpec, out *v1beta2.NetworkDeviceSpec, s conversion.Scope) error {
+	out.SwitchType = v1beta2.SwitchType(in.SwitchType)
+	out.NetworkID = in.NetworkID
+	out.NetworkName = in.NetworkName
+	out.NetworkType = in.NetworkType
+	out.DeviceID = in.DeviceID
+	out.DeviceName = in.DeviceName
+	out.DHCP4 = in.DHCP4
+	out.DHCP6 = in.DHCP6
+	out.NetMask = in.NetMask
+	out.Gateway4 = in.Gateway4
+	out.Gateway6 = in.Gateway6
+	out.IPAddrs = *(*[]string)(unsafe.Pointer(&in.IPAddrs))
+	out.MTU = (*int64)(unsafe.Pointer(in.MTU))
+	out.MACAddr = in.MACAddr
+	out.MACAddrPool = *(*[]string)(unsafe.Pointer(&in.MACAddrPool))
+	out.MACAddrPrefix = in.MACAddrPrefix
+	out.SecurityGroups = *(*[]string)(unsafe.Pointer(&in.SecurityGroups))
+	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	out.Routes = *(*[]v1beta2.NetworkRouteSpec)(unsafe.Pointer(&in.Routes))
+	out.SearchDomains = *(*[]string)(unsafe.Pointer(&in.SearchDomains))
+	out.Model = in.Model
+	out.Queues = in.Queues
+	out.SendQueueLength = in.SendQueueLength
+	out.ReceiveQueueLength = in.ReceiveQueueLength
+	out.UplinkRate = in.UplinkRate
+	out.UplinkBurst = in.UplinkBurst
+	out.DownlinkRate = in.DownlinkRate
+	out.DownlinkBurst = in.DownlinkBurst
+	out.Priority = in.Priority
+	return nil
+}
+
+// Convert_v1beta1_NetworkDeviceSpec_To_v1beta2_NetworkDeviceSpec is an autogenerated conversion function.
+func Convert_v1beta1_NetworkDeviceSpec_To_v1beta2_NetworkDeviceSpec(in *NetworkDeviceSpec, out *v1beta2.NetworkDeviceSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkDeviceSpec_To_v1beta2_NetworkDeviceSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_NetworkDeviceSpec_To_v1beta1_NetworkDeviceSpec(in *v1beta2.NetworkDeviceSpec, out *NetworkDeviceSpec, s conversion.Scope) error {
+	out.SwitchType = SwitchType(in.SwitchType)
+	out.NetworkID = in.NetworkID
+	out.NetworkName = in.NetworkName
+	out.NetworkType = in.NetworkType
+	out.DeviceID = in.DeviceID
+	out.DeviceName = in.DeviceName
+	out.DHCP4 = in.DHCP4
+	out.DHCP6 = in.DHCP6
+	out.NetMask = in.NetMask
+	out.Gateway4 = in.Gateway4
+	out.Gateway6 = in.Gateway6
+	out.IPAddrs = *(*[]string)(unsafe.Pointer(&in.IPAddrs))
+	out.MTU = (*int64)(unsafe.Pointer(in.MTU))
+	out.MACAddr = in.MACAddr
+	out.MACAddrPool = *(*[]string)(unsafe.Pointer(&in.MACAddrPool))
+	out.MACAddrPrefix = in.MACAddrPrefix
+	out.SecurityGroups = *(*[]string)(unsafe.Pointer(&in.SecurityGroups))
+	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	out.Routes = *(*[]NetworkRouteSpec)(unsafe.Pointer(&in.Routes))
+	out.SearchDomains = *(*[]string)(unsafe.Pointer(&in.SearchDomains))
+	out.Model = in.Model
+	out.Queues = in.Queues
+	out.SendQueueLength = in.SendQueueLength
+	out.ReceiveQueueLength = in.ReceiveQueueLength
+	out.UplinkRate = in.UplinkRate
+	out.UplinkBurst = in.UplinkBurst
+	out.DownlinkRate = in.DownlinkRate
+	out.DownlinkBurst = in.DownlinkBurst
+	out.Priority = in.Priority
+	return nil
+}
+
+// Convert_v1beta2_NetworkDeviceSpec_To_v1beta1_NetworkDeviceSpec is an autogenerated conversion function.
+func Convert_v1beta2_NetworkDeviceSpec_To_v1beta1_NetworkDeviceSpec(in *v1beta2.NetworkDeviceSpec, out *NetworkDeviceSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_NetworkDeviceSpec_To_v1beta1_NetworkDeviceSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkRouteSpec_To_v1beta2_NetworkRouteSpec(in *NetworkRouteSpec, out *v1beta2.NetworkRouteSpec, s conversion.Scope) error {
+	out.To = in.To
+	out.Via = in.Via
+	out.Metric = in.Metric
+	return nil
+}
+
+// Convert_v1beta1_NetworkRouteSpec_To_v1beta2_NetworkRouteSpec is an autogenerated conversion function.
+func Convert_v1beta1_NetworkRouteSpec_To_v1beta2_NetworkRouteSpec(in *NetworkRouteSpec, out *v1beta2.NetworkRouteSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkRouteSpec_To_v1beta2_NetworkRouteSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_NetworkRouteSpec_To_v1beta1_NetworkRouteSpec(in *v1beta2.NetworkRouteSpec, out *NetworkRouteSpec, s conversion.Scope) error {
+	out.To = in.To
+	out.Via = in.Via
+	out.Metric = in.Metric
+	return nil
+}
+
+// Convert_v1beta2_NetworkRouteSpec_To_v1beta1_NetworkRouteSpec is an autogenerated conversion function.
+func Convert_v1beta2_NetworkRouteSpec_To_v1beta1_NetworkRouteSpec(in *v1beta2.NetworkRouteSpec, out *NetworkRouteSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_NetworkRouteSpec_To_v1beta1_NetworkRouteSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkSpec_To_v1beta2_NetworkSpec(in *NetworkSpec, out *v1beta2.NetworkSpec, s conversion.Scope) error {
+	out.Devices = *(*[]v1beta2.NetworkDeviceSpec)(unsafe.Pointer(&in.Devices))
+	out.Routes = *(*[]v1beta2.NetworkRouteSpec)(unsafe.Pointer(&in.Routes))
+	// WARNING: in.PreferredAPIServerCIDR requires manual conversion: does not exist in peer-type
+	return nil
+}
+
+func autoConvert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in *v1beta2.NetworkSpec, out *NetworkSpec, s conversion.Scope) error {
+	out.Devices = *(*[]NetworkDeviceSpec)(unsafe.Pointer(&in.Devices))
+	out.Routes = *(*[]NetworkRouteSpec)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec is an autogenerated conversion function.
+func Convert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in *v1beta2.NetworkSpec, out *NetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkStatus_To_v1beta2_NetworkStatus(in *NetworkStatus, out *v1beta2.NetworkStatus, s conversion.Scope) error {
+	out.Connected = in.Connected
+	out.IPAddrs = *(*[]string)(unsafe.Pointer(&in.IPAddrs))
+	out.MACAddr = in.MACAddr
+	out.NetworkName = in.NetworkName
+	return nil
+}
+
+// Convert_v1beta1_NetworkStatus_To_v1beta2_NetworkStatus is an autogenerated conversion function.
+func Convert_v1beta1_NetworkStatus_To_v1beta2_NetworkStatus(in *NetworkStatus, out *v1beta2.NetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkStatus_To_v1beta2_NetworkStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_NetworkStatus_To_v1beta1_NetworkStatus(in *v1beta2.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	out.Connected = in.Connected
+	out.IPAddrs = *(*[]string)(unsafe.Pointer(&in.IPAddrs))
+	out.MACAddr = in.MACAddr
+	out.NetworkName = in.NetworkName
+	return nil
+}
+
+// Convert_v1beta2_NetworkStatus_To_v1beta1_NetworkStatus is an autogenerated conversion function.
+func Convert_v1beta2_NetworkStatus_To_v1beta1_NetworkStatus(in *v1beta2.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_NetworkStatus_To_v1beta1_NetworkStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_NodeInfo_To_v1beta2_NodeInfo(in *NodeInfo, out *v1beta2.NodeInfo, s conversion.Scope) error {
+	out.Architecture = in.Architecture
+	out.OperatingSystem = in.OperatingSystem
+	return nil
+}
+
+// Convert_v1beta1_NodeInfo_To_v1beta2_NodeInfo is an autogenerated conversion function.
+func Convert_v1beta1_NodeInfo_To_v1beta2_NodeInfo(in *NodeInfo, out *v1beta2.NodeInfo, s conversion.Scope) error {
+	return autoConvert_v1beta1_NodeInfo_To_v1beta2_NodeInfo(in, out, s)
+}
+
+func autoConvert_v1beta2_NodeInfo_To_v1beta1_NodeInfo(in *v1beta2.NodeInfo, out *NodeInfo, s conversion.Scope) error {
+	out.Architecture = in.Architecture
+	out.OperatingSystem = in.OperatingSystem
+	return nil
+}
+
+// Convert_v1beta2_NodeInfo_To_v1beta1_NodeInfo is an autogenerated conversion function.
+func Convert_v1beta2_NodeInfo_To_v1beta1_NodeInfo(in *v1beta2.NodeInfo, out *NodeInfo, s conversion.Scope) error {
+	return autoConvert_v1beta2_NodeInfo_To_v1beta1_NodeInfo(in, out, s)
+}
+
+func autoConvert_v1beta1_OCIImageSource_To_v1beta2_OCIImageSource(in *OCIImageSource, out *v1beta2.OCIImageSource, s conversion.Scope) error {
+	out.Reference = in.Reference
+	out.SecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	out.Insecure = in.Insecure
+	return nil
+}
+
+// Convert_v1beta1_OCIImageSource_To_v1beta2_OCIImageSource is an autogenerated conversion function.
+func Convert_v1beta1_OCIImageSource_To_v1beta2_OCIImageSource(in *OCIImageSource, out *v1beta2.OCIImageSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_OCIImageSource_To_v1beta2_OCIImageSource(in, out, s)
+}
+
+func autoConvert_v1beta2_OCIImageSource_To_v1beta1_OCIImageSource(in *v1beta2.OCIImageSource, out *OCIImageSource, s conversion.Scope) error {
+	out.Reference = in.Reference
+	out.SecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	out.Insecure = in.Insecure
+	return nil
+}
+
+// Convert_v1beta2_OCIImageSource_To_v1beta1_OCIImageSource is an autogenerated conversion function.
+func Convert_v1beta2_OCIImageSource_To_v1beta1_OCIImageSource(in *v1beta2.OCIImageSource, out *OCIImageSource, s conversion.Scope) error {
+	return autoConvert_v1beta2_OCIImageSource_To_v1beta1_OCIImageSource(in, out, s)
+}
+
+func autoConvert_v1beta1_SSHUser_To_v1beta2_SSHUser(in *SSHUser, out *v1beta2.SSHUser, s conversion.Scope) error {
+	out.Name = in.Name
+	out.AuthorizedType = v1beta2.AuthorizedMode(in.AuthorizedType)
+	out.AuthorizedKey = in.AuthorizedKey
+	return nil
+}
+
+// Convert_v1beta1_SSHUser_To_v1beta2_SSHUser is an autogenerated conversion function.
+func Convert_v1beta1_SSHUser_To_v1beta2_SSHUser(in *SSHUser, out *v1beta2.SSHUser, s conversion.Scope) error {
+	return autoConvert_v1beta1_SSHUser_To_v1beta2_SSHUser(in, out, s)
+}
+
+func autoConvert_v1beta2_SSHUser_To_v1beta1_SSHUser(in *v1beta2.SSHUser, out *SSHUser, s conversion.Scope) error {
+	out.Name = in.Name
+	out.AuthorizedType = AuthorizedMode(in.AuthorizedType)
+	out.AuthorizedKey = in.AuthorizedKey
+	return nil
+}
+
+// Convert_v1beta2_SSHUser_To_v1beta1_SSHUser is an autogenerated conversion function.
+func Convert_v1beta2_SSHUser_To_v1beta1_SSHUser(in *v1beta2.SSHUser, out *SSHUser, s conversion.Scope) error {
+	return autoConvert_v1beta2_SSHUser_To_v1beta1_SSHUser(in, out, s)
+}
+
+func autoConvert_v1beta1_SecurityGroupRule_To_v1beta2_SecurityGroupRule(in *SecurityGroupRule, out *v1beta2.SecurityGroupRule, s conversion.Scope) error {
+	out.Description = in.Description
+	out.Direction = in.Direction
+	out.Protocol = in.Protocol
+	out.PortRangeMin = in.PortRangeMin
+	out.PortRangeMax = in.PortRangeMax
+	out.RemoteIPPrefix = in.RemoteIPPrefix
+	return nil
+}
+
+// Convert_v1beta1_SecurityGroupRule_To_v1beta2_SecurityGroupRule is an autogenerated conversion function.
+func Convert_v1beta1_SecurityGroupRule_To_v1beta2_SecurityGroupRule(in *SecurityGroupRule, out *v1beta2.SecurityGroupRule, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecurityGroupRule_To_v1beta2_SecurityGroupRule(in, out, s)
+}
+
+func autoConvert_v1beta2_SecurityGroupRule_To_v1beta1_SecurityGroupRule(in *v1beta2.SecurityGroupRule, out *SecurityGroupRule, s conversion.Scope) error {
+	out.Description = in.Description
+	out.Direction = in.Direction
+	out.Protocol = in.Protocol
+	out.PortRangeMin = in.PortRangeMin
+	out.PortRangeMax = in.PortRangeMax
+	out.RemoteIPPrefix = in.RemoteIPPrefix
+	return nil
+}
+
+// Convert_v1beta2_SecurityGroupRule_To_v1beta1_SecurityGroupRule is an autogenerated conversion function.
+func Convert_v1beta2_SecurityGroupRule_To_v1beta1_SecurityGroupRule(in *v1beta2.SecurityGroupRule, out *SecurityGroupRule, s conversion.Scope) error {
+	return autoConvert_v1beta2_SecurityGroupRule_To_v1beta1_SecurityGroupRule(in, out, s)
+}
+
+func autoConvert_v1beta1_SecurityGroupStatus_To_v1beta2_SecurityGroupStatus(in *SecurityGroupStatus, out *v1beta2.SecurityGroupStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_SecurityGroupStatus_To_v1beta2_SecurityGroupStatus is an autogenerated conversion function.
+func Convert_v1beta1_SecurityGroupStatus_To_v1beta2_SecurityGroupStatus(in *SecurityGroupStatus, out *v1beta2.SecurityGroupStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecurityGroupStatus_To_v1beta2_SecurityGroupStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_SecurityGroupStatus_To_v1beta1_SecurityGroupStatus(in *v1beta2.SecurityGroupStatus, out *SecurityGroupStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta2_SecurityGroupStatus_To_v1beta1_SecurityGroupStatus is an autogenerated conversion function.
+func Convert_v1beta2_SecurityGroupStatus_To_v1beta1_SecurityGroupStatus(in *v1beta2.SecurityGroupStatus, out *SecurityGroupStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_SecurityGroupStatus_To_v1beta1_SecurityGroupStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachine_To_v1beta2_VirtualMachine(in *VirtualMachine, out *v1beta2.VirtualMachine, s conversion.Scope) error {
+	out.UID = in.UID
+	out.Name = in.Name
+	out.BiosUUID = in.BiosUUID
+	out.State = v1beta2.VirtualMachineState(in.State)
+	out.Network = *(*[]v1beta2.NetworkStatus)(unsafe.Pointer(&in.Network))
+	return nil
+}
+
+// Convert_v1beta1_VirtualMachine_To_v1beta2_VirtualMachine is an autogenerated conversion function.
+func Convert_v1beta1_VirtualMachine_To_v1beta2_VirtualMachine(in *VirtualMachine, out *v1beta2.VirtualMachine, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualMachine_To_v1beta2_VirtualMachine(in, out, s)
+}
+
+func autoConvert_v1beta2_VirtualMachine_To_v1beta1_VirtualMachine(in *v1beta2.VirtualMachine, out *VirtualMachine, s conversion.Scope) error {
+	out.UID = in.UID
+	out.Name = in.Name
+	out.BiosUUID = in.BiosUUID
+	out.State = VirtualMachineState(in.State)
+	out.Network = *(*[]NetworkStatus)(unsafe.Pointer(&in.Network))
+	return nil
+}
+
+// Convert_v1beta2_VirtualMachine_To_v1beta1_VirtualMachine is an autogenerated conversion function.
+func Convert_v1beta2_VirtualMachine_To_v1beta1_VirtualMachine(in *v1beta2.VirtualMachine, out *VirtualMachine, s conversion.Scope) error {
+	return autoConvert_v1beta2_VirtualMachine_To_v1beta1_VirtualMachine(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualMachineCloneSpec_To_v1beta2_VirtualMachineCloneSpec(in *VirtualMachineCloneSpec, out *v1beta2.VirtualMachineCloneSpec, s conversion.Scope) error {
+	out.CloudName = in.CloudName
+	out.IdentityRef = (*v1beta2.ICSIdentityReference)(unsafe.Pointer(in.IdentityRef))
+	out.Template = in.Template
+	out.ImageRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.ImageRef))
+	out.CloneMode = v1beta2.CloneMode(in.CloneMode)
+	out.Snapshot = in.Snapshot
+	// WARNING: in.Datacenter requires manual conversion: does not exist in peer-type
+	// WARNING: in.Cluster requires manual conversion: does not exist in peer-type
+	// WARNING: in.Datastore requires manual conversion: does not exist in peer-type
+	// WARNING: in.DatastoreSelector requires manual conversion: does not exist in peer-type
+	if err := Convert_v1beta1_NetworkSpec_To_v1beta2_NetworkSpec(&in.Network, &out.Network, s); err != nil {
+		return err
+	}
+	out.NumCPUs = in.NumCPUs
+	out.NumCoresPerSocket = in.NumCoresPerSocket
+	out.MemoryMiB = in.MemoryMiB
+	out.Disks = *(*[]v1beta2.DiskSpec)(unsafe.Pointer(&in.Disks))
+	out.User = (*v1beta2.SSHUser)(unsafe.Pointer(in.User))
+	out.DeletionPolicy = v1beta2.DeletionPolicy(in.DeletionPolicy)
+	out.DriftPolicy = v1beta2.DriftPolicy(in.DriftPolicy)
+	out.ResizePolicy = v1beta2.ResizePolicy(in.ResizePolicy)
+	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
+	return nil
+}
+
+func autoConvert_v1beta2_VirtualMachineCloneSpec_To_v1beta1_VirtualMachineCloneSpec(in *v1beta2.VirtualMachineCloneSpec, out *VirtualMachineCloneSpec, s conversion.Scope) error {
+	out.CloudName = in.CloudName
+	out.IdentityRef = (*ICSIdentityReference)(unsafe.Pointer(in.IdentityRef))
+	out.Template = in.Template
+	out.ImageRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.ImageRef))
+	out.CloneMode = CloneMode(in.CloneMode)
+	out.Snapshot = in.Snapshot
+	// WARNING: in.Placement requires manual conversion: does not exist in peer-type
+	if err := Convert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(&in.Network, &out.Network, s); err != nil {
+		return err
+	}
+	out.NumCPUs = in.NumCPUs
+	out.NumCoresPerSocket = in.NumCoresPerSocket
+	out.MemoryMiB = in.MemoryMiB
+	out.Disks = *(*[]DiskSpec)(unsafe.Pointer(&in.Disks))
+	out.User = (*SSHUser)(unsafe.Pointer(in.User))
+	out.DeletionPolicy = DeletionPolicy(in.DeletionPolicy)
+	out.DriftPolicy = DriftPolicy(in.DriftPolicy)
+	out.ResizePolicy = ResizePolicy(in.ResizePolicy)
+	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
+	return nil
+}
@@ -43,6 +43,106 @@ func (in *ClusterModule) DeepCopy() *ClusterModule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNetworkSpec) DeepCopyInto(out *ClusterNetworkSpec) {
+	*out = *in
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetworkSpec.
+func (in *ClusterNetworkSpec) DeepCopy() *ClusterNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNetworkStatus) DeepCopyInto(out *ClusterNetworkStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetworkStatus.
+func (in *ClusterNetworkStatus) DeepCopy() *ClusterNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSecurityGroupsStatus) DeepCopyInto(out *ClusterSecurityGroupsStatus) {
+	*out = *in
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(SecurityGroupStatus)
+		**out = **in
+	}
+	if in.Worker != nil {
+		in, out := &in.Worker, &out.Worker
+		*out = new(SecurityGroupStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSecurityGroupsStatus.
+func (in *ClusterSecurityGroupsStatus) DeepCopy() *ClusterSecurityGroupsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSecurityGroupsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneVIPSpec) DeepCopyInto(out *ControlPlaneVIPSpec) {
+	*out = *in
+	if in.AddressPool != nil {
+		in, out := &in.AddressPool, &out.AddressPool
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneVIPSpec.
+func (in *ControlPlaneVIPSpec) DeepCopy() *ControlPlaneVIPSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneVIPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatastoreSelector) DeepCopyInto(out *DatastoreSelector) {
+	*out = *in
+	if in.Candidates != nil {
+		in, out := &in.Candidates, &out.Candidates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatastoreSelector.
+func (in *DatastoreSelector) DeepCopy() *DatastoreSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(DatastoreSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSpec) DeepCopyInto(out *DiskSpec) {
 	*out = *in
@@ -58,6 +158,21 @@ func (in *DiskSpec) DeepCopy() *DiskSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskStatus) DeepCopyInto(out *DiskStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskStatus.
+func (in *DiskStatus) DeepCopy() *DiskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ICSCluster) DeepCopyInto(out *ICSCluster) {
 	*out = *in
@@ -130,12 +245,42 @@ func (in *ICSClusterSpec) DeepCopyInto(out *ICSClusterSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerSpec)
+		**out = **in
+	}
+	if in.ControlPlaneVIP != nil {
+		in, out := &in.ControlPlaneVIP, &out.ControlPlaneVIP
+		*out = new(ControlPlaneVIPSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancerRef != nil {
+		in, out := &in.LoadBalancerRef, &out.LoadBalancerRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	if in.ClusterModules != nil {
 		in, out := &in.ClusterModules, &out.ClusterModules
 		*out = make([]ClusterModule, len(*in))
 		copy(*out, *in)
 	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ClusterNetworkSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedSecurityGroups != nil {
+		in, out := &in.ManagedSecurityGroups, &out.ManagedSecurityGroups
+		*out = new(ManagedSecurityGroups)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineDefaults != nil {
+		in, out := &in.MachineDefaults, &out.MachineDefaults
+		*out = new(MachineDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSClusterSpec.
@@ -158,6 +303,21 @@ func (in *ICSClusterStatus) DeepCopyInto(out *ICSClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ClusterNetworkStatus)
+		**out = **in
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = new(ClusterSecurityGroupsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSClusterStatus.
@@ -170,6 +330,198 @@ func (in *ICSClusterStatus) DeepCopy() *ICSClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSClusterTemplate) DeepCopyInto(out *ICSClusterTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSClusterTemplate.
+func (in *ICSClusterTemplate) DeepCopy() *ICSClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ICSClusterTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSClusterTemplateList) DeepCopyInto(out *ICSClusterTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSClusterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSClusterTemplateList.
+func (in *ICSClusterTemplateList) DeepCopy() *ICSClusterTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSClusterTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ICSClusterTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSClusterTemplateResource) DeepCopyInto(out *ICSClusterTemplateResource) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSClusterTemplateResource.
+func (in *ICSClusterTemplateResource) DeepCopy() *ICSClusterTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSClusterTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSClusterTemplateSpec) DeepCopyInto(out *ICSClusterTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSClusterTemplateSpec.
+func (in *ICSClusterTemplateSpec) DeepCopy() *ICSClusterTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSClusterTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSHAProxyLoadBalancer) DeepCopyInto(out *ICSHAProxyLoadBalancer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSHAProxyLoadBalancer.
+func (in *ICSHAProxyLoadBalancer) DeepCopy() *ICSHAProxyLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSHAProxyLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ICSHAProxyLoadBalancer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSHAProxyLoadBalancerList) DeepCopyInto(out *ICSHAProxyLoadBalancerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSHAProxyLoadBalancer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSHAProxyLoadBalancerList.
+func (in *ICSHAProxyLoadBalancerList) DeepCopy() *ICSHAProxyLoadBalancerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSHAProxyLoadBalancerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ICSHAProxyLoadBalancerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSHAProxyLoadBalancerSpec) DeepCopyInto(out *ICSHAProxyLoadBalancerSpec) {
+	*out = *in
+	in.VirtualMachineConfiguration.DeepCopyInto(&out.VirtualMachineConfiguration)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSHAProxyLoadBalancerSpec.
+func (in *ICSHAProxyLoadBalancerSpec) DeepCopy() *ICSHAProxyLoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSHAProxyLoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSHAProxyLoadBalancerStatus) DeepCopyInto(out *ICSHAProxyLoadBalancerStatus) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(apiv1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSHAProxyLoadBalancerStatus.
+func (in *ICSHAProxyLoadBalancerStatus) DeepCopy() *ICSHAProxyLoadBalancerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSHAProxyLoadBalancerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ICSIdentityReference) DeepCopyInto(out *ICSIdentityReference) {
 	*out = *in
@@ -249,6 +601,143 @@ func (in *ICSMachineList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSMachinePool) DeepCopyInto(out *ICSMachinePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachinePool.
+func (in *ICSMachinePool) DeepCopy() *ICSMachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSMachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ICSMachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSMachinePoolInstanceStatus) DeepCopyInto(out *ICSMachinePoolInstanceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachinePoolInstanceStatus.
+func (in *ICSMachinePoolInstanceStatus) DeepCopy() *ICSMachinePoolInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSMachinePoolInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSMachinePoolList) DeepCopyInto(out *ICSMachinePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSMachinePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachinePoolList.
+func (in *ICSMachinePoolList) DeepCopy() *ICSMachinePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSMachinePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ICSMachinePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSMachinePoolSpec) DeepCopyInto(out *ICSMachinePoolSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.ProviderIDList != nil {
+		in, out := &in.ProviderIDList, &out.ProviderIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(MachinePoolRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachinePoolSpec.
+func (in *ICSMachinePoolSpec) DeepCopy() *ICSMachinePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSMachinePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSMachinePoolStatus) DeepCopyInto(out *ICSMachinePoolStatus) {
+	*out = *in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]ICSMachinePoolInstanceStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(errors.MachineStatusError)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(apiv1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachinePoolStatus.
+func (in *ICSMachinePoolStatus) DeepCopy() *ICSMachinePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSMachinePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ICSMachineSpec) DeepCopyInto(out *ICSMachineSpec) {
 	*out = *in
@@ -260,80 +749,226 @@ func (in *ICSMachineSpec) DeepCopyInto(out *ICSMachineSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineSpec.
-func (in *ICSMachineSpec) DeepCopy() *ICSMachineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineSpec.
+func (in *ICSMachineSpec) DeepCopy() *ICSMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSMachineStatus) DeepCopyInto(out *ICSMachineStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]apiv1beta1.MachineAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = make([]NetworkStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(errors.MachineStatusError)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(apiv1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineStatus.
+func (in *ICSMachineStatus) DeepCopy() *ICSMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSMachineTemplate) DeepCopyInto(out *ICSMachineTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineTemplate.
+func (in *ICSMachineTemplate) DeepCopy() *ICSMachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSMachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ICSMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSMachineTemplateList) DeepCopyInto(out *ICSMachineTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ICSMachineTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineTemplateList.
+func (in *ICSMachineTemplateList) DeepCopy() *ICSMachineTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSMachineTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ICSMachineTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSMachineTemplateResource) DeepCopyInto(out *ICSMachineTemplateResource) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineTemplateResource.
+func (in *ICSMachineTemplateResource) DeepCopy() *ICSMachineTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSMachineTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSMachineTemplateSpec) DeepCopyInto(out *ICSMachineTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineTemplateSpec.
+func (in *ICSMachineTemplateSpec) DeepCopy() *ICSMachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ICSMachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSMachineTemplateStatus) DeepCopyInto(out *ICSMachineTemplateStatus) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.NodeInfo != nil {
+		in, out := &in.NodeInfo, &out.NodeInfo
+		*out = new(NodeInfo)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineTemplateStatus.
+func (in *ICSMachineTemplateStatus) DeepCopy() *ICSMachineTemplateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ICSMachineSpec)
+	out := new(ICSMachineTemplateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ICSMachineStatus) DeepCopyInto(out *ICSMachineStatus) {
+func (in *ICSVM) DeepCopyInto(out *ICSVM) {
 	*out = *in
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]apiv1beta1.MachineAddress, len(*in))
-		copy(*out, *in)
-	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = make([]NetworkStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.FailureReason != nil {
-		in, out := &in.FailureReason, &out.FailureReason
-		*out = new(errors.MachineStatusError)
-		**out = **in
-	}
-	if in.FailureMessage != nil {
-		in, out := &in.FailureMessage, &out.FailureMessage
-		*out = new(string)
-		**out = **in
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make(apiv1beta1.Conditions, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineStatus.
-func (in *ICSMachineStatus) DeepCopy() *ICSMachineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSVM.
+func (in *ICSVM) DeepCopy() *ICSVM {
 	if in == nil {
 		return nil
 	}
-	out := new(ICSMachineStatus)
+	out := new(ICSVM)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ICSVM) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ICSMachineTemplate) DeepCopyInto(out *ICSMachineTemplate) {
+func (in *ICSVMImage) DeepCopyInto(out *ICSVMImage) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineTemplate.
-func (in *ICSMachineTemplate) DeepCopy() *ICSMachineTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSVMImage.
+func (in *ICSVMImage) DeepCopy() *ICSVMImage {
 	if in == nil {
 		return nil
 	}
-	out := new(ICSMachineTemplate)
+	out := new(ICSVMImage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ICSMachineTemplate) DeepCopyObject() runtime.Object {
+func (in *ICSVMImage) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -341,31 +976,31 @@ func (in *ICSMachineTemplate) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ICSMachineTemplateList) DeepCopyInto(out *ICSMachineTemplateList) {
+func (in *ICSVMImageList) DeepCopyInto(out *ICSVMImageList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ICSMachineTemplate, len(*in))
+		*out = make([]ICSVMImage, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineTemplateList.
-func (in *ICSMachineTemplateList) DeepCopy() *ICSMachineTemplateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSVMImageList.
+func (in *ICSVMImageList) DeepCopy() *ICSVMImageList {
 	if in == nil {
 		return nil
 	}
-	out := new(ICSMachineTemplateList)
+	out := new(ICSVMImageList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ICSMachineTemplateList) DeepCopyObject() runtime.Object {
+func (in *ICSVMImageList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -373,62 +1008,101 @@ func (in *ICSMachineTemplateList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ICSMachineTemplateResource) DeepCopyInto(out *ICSMachineTemplateResource) {
+func (in *ICSVMImageSource) DeepCopyInto(out *ICSVMImageSource) {
 	*out = *in
-	in.Spec.DeepCopyInto(&out.Spec)
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIImageSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineTemplateResource.
-func (in *ICSMachineTemplateResource) DeepCopy() *ICSMachineTemplateResource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSVMImageSource.
+func (in *ICSVMImageSource) DeepCopy() *ICSVMImageSource {
 	if in == nil {
 		return nil
 	}
-	out := new(ICSMachineTemplateResource)
+	out := new(ICSVMImageSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ICSMachineTemplateSpec) DeepCopyInto(out *ICSMachineTemplateSpec) {
+func (in *ICSVMImageSpec) DeepCopyInto(out *ICSVMImageSpec) {
 	*out = *in
-	in.Template.DeepCopyInto(&out.Template)
+	if in.IdentityRef != nil {
+		in, out := &in.IdentityRef, &out.IdentityRef
+		*out = new(ICSIdentityReference)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Source.DeepCopyInto(&out.Source)
+	if in.Datastores != nil {
+		in, out := &in.Datastores, &out.Datastores
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(ICSVMImageTemplateSpec)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSMachineTemplateSpec.
-func (in *ICSMachineTemplateSpec) DeepCopy() *ICSMachineTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSVMImageSpec.
+func (in *ICSVMImageSpec) DeepCopy() *ICSVMImageSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ICSMachineTemplateSpec)
+	out := new(ICSVMImageSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ICSVM) DeepCopyInto(out *ICSVM) {
+func (in *ICSVMImageStatus) DeepCopyInto(out *ICSVMImageStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Datastores != nil {
+		in, out := &in.Datastores, &out.Datastores
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Uploading != nil {
+		in, out := &in.Uploading, &out.Uploading
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(apiv1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSVM.
-func (in *ICSVM) DeepCopy() *ICSVM {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSVMImageStatus.
+func (in *ICSVMImageStatus) DeepCopy() *ICSVMImageStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ICSVM)
+	out := new(ICSVMImageStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ICSVM) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICSVMImageTemplateSpec) DeepCopyInto(out *ICSVMImageTemplateSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSVMImageTemplateSpec.
+func (in *ICSVMImageTemplateSpec) DeepCopy() *ICSVMImageTemplateSpec {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ICSVMImageTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -521,6 +1195,16 @@ func (in *ICSVMStatus) DeepCopyInto(out *ICSVMStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RetainedVolumes != nil {
+		in, out := &in.RetainedVolumes, &out.RetainedVolumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]DiskStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICSVMStatus.
@@ -618,6 +1302,108 @@ func (in *IPAddressSpec) DeepCopy() *IPAddressSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
+func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerStatus) DeepCopyInto(out *LoadBalancerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerStatus.
+func (in *LoadBalancerStatus) DeepCopy() *LoadBalancerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDefaults) DeepCopyInto(out *MachineDefaults) {
+	*out = *in
+	if in.NetworkDevices != nil {
+		in, out := &in.NetworkDevices, &out.NetworkDevices
+		*out = make([]NetworkDeviceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDefaults.
+func (in *MachineDefaults) DeepCopy() *MachineDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolRollingUpdate) DeepCopyInto(out *MachinePoolRollingUpdate) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolRollingUpdate.
+func (in *MachinePoolRollingUpdate) DeepCopy() *MachinePoolRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSecurityGroups) DeepCopyInto(out *ManagedSecurityGroups) {
+	*out = *in
+	if in.ControlPlaneRules != nil {
+		in, out := &in.ControlPlaneRules, &out.ControlPlaneRules
+		*out = make([]SecurityGroupRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkerRules != nil {
+		in, out := &in.WorkerRules, &out.WorkerRules
+		*out = make([]SecurityGroupRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSecurityGroups.
+func (in *ManagedSecurityGroups) DeepCopy() *ManagedSecurityGroups {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSecurityGroups)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkDeviceSpec) DeepCopyInto(out *NetworkDeviceSpec) {
 	*out = *in
@@ -631,6 +1417,16 @@ func (in *NetworkDeviceSpec) DeepCopyInto(out *NetworkDeviceSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.MACAddrPool != nil {
+		in, out := &in.MACAddrPool, &out.MACAddrPool
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Nameservers != nil {
 		in, out := &in.Nameservers, &out.Nameservers
 		*out = make([]string, len(*in))
@@ -720,6 +1516,41 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeInfo) DeepCopyInto(out *NodeInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeInfo.
+func (in *NodeInfo) DeepCopy() *NodeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIImageSource) DeepCopyInto(out *OCIImageSource) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIImageSource.
+func (in *OCIImageSource) DeepCopy() *OCIImageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIImageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHUser) DeepCopyInto(out *SSHUser) {
 	*out = *in
@@ -735,6 +1566,36 @@ func (in *SSHUser) DeepCopy() *SSHUser {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
+func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupStatus.
+func (in *SecurityGroupStatus) DeepCopy() *SecurityGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
@@ -765,6 +1626,16 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = new(ICSIdentityReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageRef != nil {
+		in, out := &in.ImageRef, &out.ImageRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.DatastoreSelector != nil {
+		in, out := &in.DatastoreSelector, &out.DatastoreSelector
+		*out = new(DatastoreSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Network.DeepCopyInto(&out.Network)
 	if in.Disks != nil {
 		in, out := &in.Disks, &out.Disks
@@ -776,6 +1647,11 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = new(SSHUser)
 		**out = **in
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCloneSpec.
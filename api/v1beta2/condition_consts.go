@@ -0,0 +1,339 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+// Conditions and condition Reasons for the ICSMachine and the ICSVM object.
+//
+// NOTE: ICSMachine wraps a ICS VM, some we are using a unique set of conditions and reasons in order
+// to ensure a consistent UX; differences between the two objects will be highlighted in the comments.
+
+const (
+	// VMProvisionedCondition documents the status of the provisioning of a ICSMachine and its underlying ICSVM.
+	VMProvisionedCondition clusterv1.ConditionType = "VMProvisioned"
+
+	// WaitingForClusterInfrastructureReason (Severity=Info) documents a ICSMachine waiting for the cluster
+	// infrastructure to be ready before starting the provisioning process.
+	//
+	// NOTE: This reason does not apply to ICSVM (this state happens before the ICSVM is actually created).
+	WaitingForClusterInfrastructureReason = "WaitingForClusterInfrastructure"
+
+	// WaitingForBootstrapDataReason (Severity=Info) documents a ICSMachine waiting for the bootstrap
+	// script to be ready before starting the provisioning process.
+	//
+	// NOTE: This reason does not apply to ICSVM (this state happens before the ICSVM is actually created).
+	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
+
+	// WaitingForStaticIPAllocationReason (Severity=Info) documents a ICSVM waiting for the allocation of
+	// a static IP address.
+	WaitingForStaticIPAllocationReason = "WaitingForStaticIPAllocation"
+
+	// CloningReason documents (Severity=Info) a ICSMachine/ICSVM currently executing the clone operation.
+	CloningReason = "Cloning"
+
+	// CloningFailedReason (Severity=Warning) documents a ICSMachine/ICSVM controller detecting
+	// an error while provisioning; those kind of errors are usually transient and failed provisioning
+	// are automatically re-tried by the controller.
+	CloningFailedReason = "CloningFailed"
+
+	// PoweringOnReason documents (Severity=Info) a ICSMachine/ICSVM currently executing the power on sequence.
+	PoweringOnReason = "PoweringOn"
+
+	// PoweringOnFailedReason (Severity=Warning) documents a ICSMachine/ICSVM controller detecting
+	// an error while powering on; those kind of errors are usually transient and failed provisioning
+	// are automatically re-tried by the controller.
+	PoweringOnFailedReason = "PoweringOnFailed"
+
+	// TaskFailure (Severity=Warning) documents a ICSMachine/ICS task failure; the reconcile look will automatically
+	// retry the operation, but a user intervention might be required to fix the problem.
+	TaskFailure = "TaskFailure"
+
+	// WaitingForNetworkAddressesReason (Severity=Info) documents a ICSMachine waiting for the the machine network
+	// settings to be reported after machine being powered on.
+	//
+	// NOTE: This reason does not apply to ICSVM (this state happens after the ICSVM is in ready state).
+	WaitingForNetworkAddressesReason = "WaitingForNetworkAddresses"
+
+	// TagsAttachmentFailedReason (Severity=Error) documents a ICSMachine/ICSVM tags attachment failure.
+	TagsAttachmentFailedReason = "TagsAttachmentFailed"
+)
+
+const (
+	// VMAdoptedCondition documents the adoption of a pre-existing ICS VM by a ICSVM, instead of
+	// cloning a new one.
+	VMAdoptedCondition clusterv1.ConditionType = "VMAdopted"
+
+	// AdoptingReason (Severity=Info) documents a ICSVM looking up and validating the pre-existing VM
+	// it was asked to adopt.
+	AdoptingReason = "Adopting"
+
+	// AdoptionTargetNotFoundReason (Severity=Error) documents a ICSVM asked to adopt a VM that could not
+	// be found in ICS.
+	AdoptionTargetNotFoundReason = "AdoptionTargetNotFound"
+
+	// AdoptionValidationFailedReason (Severity=Error) documents a ICSVM asked to adopt a VM whose
+	// configuration does not match the ICSVM spec; the VM is left untouched until the mismatch is fixed.
+	AdoptionValidationFailedReason = "AdoptionValidationFailed"
+)
+
+const (
+	// VMConfigurationDriftedCondition documents that the configuration of the VM of a ICSVM differs from
+	// its spec. The condition is True while drift is detected, with the differences as its message, and
+	// is removed once the VM matches its spec again.
+	VMConfigurationDriftedCondition clusterv1.ConditionType = "VMConfigurationDrifted"
+
+	// DriftDetectedReason documents a VM whose configuration drifted away from the ICSVM spec.
+	DriftDetectedReason = "DriftDetected"
+
+	// DriftCorrectingReason documents a VM whose CPU and memory are being set back to the ICSVM spec.
+	DriftCorrectingReason = "DriftCorrecting"
+
+	// DriftRemediationReason documents a VM whose machine was marked as failed because of drift, so that
+	// it gets replaced by a MachineHealthCheck.
+	DriftRemediationReason = "DriftRemediation"
+)
+
+const (
+	// VMResizingCondition documents that the CPU and memory of the VM of a ICSVM are being changed in
+	// place. The condition is True while the resize is in progress, with the current step as its reason,
+	// and is removed once the VM runs with the CPU and memory of its spec.
+	VMResizingCondition clusterv1.ConditionType = "VMResizing"
+
+	// HotPluggingReason documents a VM whose CPU and memory are changed while it keeps running.
+	HotPluggingReason = "HotPlugging"
+
+	// DrainingForResizeReason documents a VM whose node is drained before the VM is rebooted to be resized.
+	DrainingForResizeReason = "DrainingForResize"
+
+	// ShuttingDownForResizeReason documents a VM whose guest is shut down to be resized.
+	ShuttingDownForResizeReason = "ShuttingDownForResize"
+
+	// PoweringOffForResizeReason documents a VM that is powered off to be resized because its guest
+	// did not shut down in time.
+	PoweringOffForResizeReason = "PoweringOffForResize"
+
+	// ResizingReason documents a powered off VM whose CPU and memory are being changed.
+	ResizingReason = "Resizing"
+
+	// ResizeFailedReason documents a VM whose CPU and memory could not be changed.
+	ResizeFailedReason = "ResizeFailed"
+)
+
+// Conditions and Reasons related to utilizing a ICSIdentity to make connections to a ICenter.
+// Can currently be used by ICSCluster and ICSVM.
+const (
+	// ICenterAvailableCondition documents the connectivity with icenter
+	// for a given resource.
+	ICenterAvailableCondition clusterv1.ConditionType = "ICenterAvailable"
+
+	// ICenterUnreachableReason (Severity=Error) documents a controller detecting
+	// issues with ICenter reachability.
+	ICenterUnreachableReason = "ICenterUnreachable"
+)
+
+const (
+	// ClusterModulesAvailableCondition documents the availability of cluster modules for the ICSCluster object.
+	ClusterModulesAvailableCondition clusterv1.ConditionType = "ClusterModulesAvailable"
+
+	// MissingICenterVersionReason (Severity=Warning) documents a controller detecting
+	//  the scenario in which the iCenter version is not set in the status of the ICSCluster object.
+	MissingICenterVersionReason = "MissingICenterVersion"
+
+	// ICenterVersionIncompatibleReason (Severity=Info) documents the case where the iCenter version of the
+	// ICSCluster object does not support cluster modules.
+	ICenterVersionIncompatibleReason = "ICenterVersionIncompatible"
+
+	// ClusterModuleSetupFailedReason (Severity=Warning) documents a controller detecting
+	// issues when setting up anti-affinity constraints via cluster modules for objects
+	// belonging to the cluster.
+	ClusterModuleSetupFailedReason = "ClusterModuleSetupFailed"
+)
+
+const (
+	// LoadBalancerReadyCondition reports the successful reconciliation of
+	// a static control plane endpoint.
+	LoadBalancerReadyCondition clusterv1.ConditionType = "LoadBalancerReady"
+
+	// LoadBalancerCreationFailedReason is used when load balancer related
+	// resources creation fails.
+	LoadBalancerCreationFailedReason = "LoadBalancerCreationFailed"
+	// WaitingForLoadBalancerIPReason is used when waiting for load
+	// balancer IP to exist.
+	WaitingForLoadBalancerIPReason = "WaitingForLoadBalancerIP"
+	// LoadBalancerProvisioningReason is used while the listener and the
+	// backend pool of the load balancer are created.
+	LoadBalancerProvisioningReason = "LoadBalancerProvisioning"
+	// LoadBalancerMembersUpdateFailedReason is used when the backend pool of
+	// the load balancer cannot be synced with the control plane machines.
+	LoadBalancerMembersUpdateFailedReason = "LoadBalancerMembersUpdateFailed"
+	// ControlPlaneVIPAllocationFailedReason is used when no virtual IP can
+	// be allocated for the control plane.
+	ControlPlaneVIPAllocationFailedReason = "ControlPlaneVIPAllocationFailed"
+)
+
+const (
+	// LoadBalancerBackendsSyncedCondition documents the configuration of the backends of an
+	// ICSHAProxyLoadBalancer with the addresses of the control plane machines.
+	LoadBalancerBackendsSyncedCondition clusterv1.ConditionType = "LoadBalancerBackendsSynced"
+
+	// WaitingForLoadBalancerVMReason (Severity=Info) documents an ICSHAProxyLoadBalancer waiting for its
+	// VM to be ready.
+	WaitingForLoadBalancerVMReason = "WaitingForLoadBalancerVM"
+
+	// LoadBalancerBackendsSyncFailedReason (Severity=Warning) documents an ICSHAProxyLoadBalancer controller
+	// detecting an error while configuring the backends through the HAProxy data plane API.
+	LoadBalancerBackendsSyncFailedReason = "LoadBalancerBackendsSyncFailed"
+)
+
+const (
+	// ClusterNetworkReadyCondition documents the provisioning of the SDN network, subnet and router
+	// of a ICSCluster with a cluster network.
+	ClusterNetworkReadyCondition clusterv1.ConditionType = "ClusterNetworkReady"
+
+	// ClusterNetworkProvisioningReason (Severity=Info) documents a ICSCluster waiting for the resources
+	// of its cluster network to be created.
+	ClusterNetworkProvisioningReason = "ClusterNetworkProvisioning"
+
+	// ClusterNetworkProvisioningFailedReason (Severity=Error) documents a ICSCluster controller detecting
+	// an error while creating the resources of the cluster network.
+	ClusterNetworkProvisioningFailedReason = "ClusterNetworkProvisioningFailed"
+)
+
+const (
+	// SecurityGroupsReadyCondition documents the reconciliation of the managed security groups of a
+	// ICSCluster.
+	SecurityGroupsReadyCondition clusterv1.ConditionType = "SecurityGroupsReady"
+
+	// SecurityGroupsProvisioningReason (Severity=Info) documents a ICSCluster waiting for its managed
+	// security groups to be created.
+	SecurityGroupsProvisioningReason = "SecurityGroupsProvisioning"
+
+	// SecurityGroupsReconcileFailedReason (Severity=Error) documents a ICSCluster controller detecting
+	// an error while creating or updating the managed security groups.
+	SecurityGroupsReconcileFailedReason = "SecurityGroupsReconcileFailed"
+)
+
+const (
+	// ReplicasReadyCondition documents that the VMs of an ICSMachinePool are ready and created from
+	// the current template and bootstrap data of the pool.
+	ReplicasReadyCondition clusterv1.ConditionType = "ReplicasReady"
+
+	// ScalingReason (Severity=Info) documents an ICSMachinePool creating or deleting VMs to match
+	// the number of replicas of its MachinePool.
+	ScalingReason = "Scaling"
+
+	// RollingUpdateReason (Severity=Info) documents an ICSMachinePool replacing the VMs that were
+	// created from a previous template or bootstrap data.
+	RollingUpdateReason = "RollingUpdate"
+)
+
+const (
+	// KubeconfigEndpointSyncedCondition documents that the kubeconfig secret of the cluster points to the
+	// control plane endpoint of the ICSCluster.
+	KubeconfigEndpointSyncedCondition clusterv1.ConditionType = "KubeconfigEndpointSynced"
+
+	// ClusterInfoEndpointSyncedCondition documents that the cluster-info ConfigMap of the workload cluster
+	// points to the control plane endpoint of the ICSCluster.
+	ClusterInfoEndpointSyncedCondition clusterv1.ConditionType = "ClusterInfoEndpointSynced"
+
+	// KubeadmConfigEndpointSyncedCondition documents that the kubeadm ClusterConfiguration of the workload
+	// cluster points to the control plane endpoint of the ICSCluster.
+	KubeadmConfigEndpointSyncedCondition clusterv1.ConditionType = "KubeadmConfigEndpointSynced"
+
+	// KubeProxyEndpointSyncedCondition documents that the kube-proxy kubeconfig of the workload cluster
+	// points to the control plane endpoint of the ICSCluster.
+	KubeProxyEndpointSyncedCondition clusterv1.ConditionType = "KubeProxyEndpointSynced"
+
+	// WaitingForKubeconfigReason (Severity=Info) documents a ICSCluster waiting for the control plane
+	// provider to create the kubeconfig secret of the cluster.
+	WaitingForKubeconfigReason = "WaitingForKubeconfig"
+
+	// EndpointSyncFailedReason (Severity=Warning) documents a ICSCluster controller detecting an error
+	// while pointing a kubeconfig or configuration at the control plane endpoint.
+	EndpointSyncFailedReason = "EndpointSyncFailed"
+)
+
+const (
+	// CredentialsAvailableCondition is used by ICSClusterIdentity when a credential
+	// secret is available and unused by other ICSClusterIdentities.
+	CredentialsAvailableCondition clusterv1.ConditionType = "CredentialsAvailable"
+
+	// SecretNotAvailableReason is used when the secret referenced by the ICSClusterIdentity cannot be found.
+	SecretNotAvailableReason = "SecretNotAvailable"
+
+	// SecretOwnerReferenceFailedReason is used for errors while updating the owner reference of the secret.
+	SecretOwnerReferenceFailedReason = "SecretOwnerReferenceFailed"
+
+	// SecretAlreadyInUseReason is used when another ICSClusterIdentity is using the secret.
+	SecretAlreadyInUseReason = "SecretInUse"
+)
+
+const (
+	// PlacementConstraintMetCondition documents whether the placement constraint is configured correctly or not.
+	PlacementConstraintMetCondition clusterv1.ConditionType = "PlacementConstraintMet"
+
+	// DatastoreNotFoundReason (Severity=Error) documents that no datastore matches the datastore selector
+	// of a ICSVM.
+	DatastoreNotFoundReason = "DatastoreNotFound"
+
+	// InsufficientDatastoreCapacityReason (Severity=Error) documents that none of the matched datastores
+	// has the capacity for the disks of a ICSVM.
+	InsufficientDatastoreCapacityReason = "InsufficientDatastoreCapacity"
+
+	// NoAvailableHostReason (Severity=Error) documents that none of the hosts of the matched datastores
+	// can run a ICSVM.
+	NoAvailableHostReason = "NoAvailableHost"
+)
+
+// Conditions and Reasons related to ICSVMImages.
+const (
+	// ImageAvailableCondition documents that the OVA image of an ICSVMImage is registered on all its
+	// image datastores with the expected checksum.
+	ImageAvailableCondition clusterv1.ConditionType = "ImageAvailable"
+
+	// ImageUploadingReason (Severity=Info) documents an ICSVMImage whose OVA image is being downloaded
+	// by iCenter.
+	ImageUploadingReason = "ImageUploading"
+
+	// ImageUploadFailedReason (Severity=Warning) documents an ICSVMImage controller detecting an error
+	// while resolving the source of the image or requesting iCenter to download it.
+	ImageUploadFailedReason = "ImageUploadFailed"
+
+	// ChecksumMismatchReason (Severity=Error) documents an OVA image whose checksum differs from the
+	// checksum of the ICSVMImage.
+	ChecksumMismatchReason = "ChecksumMismatch"
+
+	// TemplateAvailableCondition documents that the template of an ICSVMImage exists.
+	TemplateAvailableCondition clusterv1.ConditionType = "TemplateAvailable"
+
+	// TemplateNotFoundReason (Severity=Warning) documents an ICSVMImage whose source template does
+	// not exist.
+	TemplateNotFoundReason = "TemplateNotFound"
+
+	// ConvertingToTemplateReason (Severity=Info) documents an ICSVMImage whose OVA image is being
+	// imported and converted into a template.
+	ConvertingToTemplateReason = "ConvertingToTemplate"
+
+	// TemplateConversionFailedReason (Severity=Warning) documents an ICSVMImage controller detecting
+	// an error while converting the OVA image into a template.
+	TemplateConversionFailedReason = "TemplateConversionFailed"
+
+	// WaitingForVMImageReason (Severity=Info) documents an ICSVM waiting for the ICSVMImage it is
+	// created from to be ready.
+	WaitingForVMImageReason = "WaitingForVMImage"
+)
@@ -0,0 +1,396 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// ClusterFinalizer allows ReconcileICSCluster to clean up ics
+	// resources associated with ICSCluster before removing it from the
+	// API server.
+	ClusterFinalizer = "icscluster.infrastructure.cluster.x-k8s.io"
+
+	SecretIdentitySetFinalizer = "icscluster/infrastructure.cluster.x-k8s.io"
+)
+
+// ICenterVersion conveys the API version of the iCenter instance.
+type ICenterVersion string
+
+func NewICenterVersion(version string) ICenterVersion {
+	return ICenterVersion(version)
+}
+
+// ICSClusterSpec defines the desired state of ICSCluster
+type ICSClusterSpec struct {
+	// The name of the cloud to use from the clouds secret
+	// +optional
+	CloudName string `json:"cloudName"`
+
+	// IdentityRef is a reference to either a Secret that contains
+	// the identity to use when reconciling the cluster.
+	// +optional
+	IdentityRef *ICSIdentityReference `json:"identityRef,omitempty"`
+
+	// Insecure is a flag that controls whether or not to validate the
+	// ics server's certificate.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+
+	// CreateLoadBalancer defines whether an ICS load balancer should be
+	// created for the control plane.
+	// +optional
+	CreateLoadBalancer bool `json:"createLoadBalancer,omitempty"`
+
+	// LoadBalancer configures the ICS load balancer created for the control
+	// plane when CreateLoadBalancer is true and no ControlPlaneEndpoint is
+	// set.
+	// +optional
+	LoadBalancer *LoadBalancerSpec `json:"loadBalancer,omitempty"`
+
+	// ControlPlaneVIP makes the control plane endpoint a virtual IP announced
+	// by kube-vip from the control plane machines. The endpoint is set to the
+	// VIP before any machine is created, and a kube-vip static pod is added
+	// to the bootstrap data of the control plane machines.
+	// +optional
+	ControlPlaneVIP *ControlPlaneVIPSpec `json:"controlPlaneVIP,omitempty"`
+
+	// LoadBalancerRef is a reference to a load balancer resource, such as an
+	// ICSHAProxyLoadBalancer, that provides the control plane endpoint. The
+	// endpoint is set to the address in the status of the load balancer
+	// once it is ready.
+	// +optional
+	LoadBalancerRef *corev1.ObjectReference `json:"loadBalancerRef,omitempty"`
+
+	// ControlPlaneEndpoint represents the endpoint used to communicate with the control plane.
+	// +optional
+	ControlPlaneEndpoint clusterv1.APIEndpoint `json:"controlPlaneEndpoint,omitempty"`
+
+	// ClusterModules hosts information regarding the anti-affinity ICS constructs
+	// for each of the objects responsible for creation of VM objects belonging to the cluster.
+	// +optional
+	ClusterModules []ClusterModule `json:"clusterModules,omitempty"`
+
+	// Network is the configuration of a dedicated SDN network for the cluster.
+	// When set, a network, subnet and router are created for the cluster and
+	// the machines without network devices of their own are connected to it.
+	// They are deleted with the cluster.
+	// +optional
+	Network *ClusterNetworkSpec `json:"network,omitempty"`
+
+	// ManagedSecurityGroups makes the controller create a security group for
+	// the control plane machines and one for the worker machines, and attach
+	// them to the network devices of the machines on SDN networks. The groups
+	// allow the API server, etcd, kubelet and NodePort traffic, plus the extra
+	// rules of each role.
+	// +optional
+	ManagedSecurityGroups *ManagedSecurityGroups `json:"managedSecurityGroups,omitempty"`
+
+	// MachineDefaults are the defaults of the ICSMachines, ICSMachineTemplates
+	// and ICSVMs labeled with the cluster, for the properties they leave
+	// empty. The CloudName and IdentityRef of the cluster are defaulted too.
+	// +optional
+	MachineDefaults *MachineDefaults `json:"machineDefaults,omitempty"`
+}
+
+// MachineDefaults defines the defaults of the machines of a cluster.
+type MachineDefaults struct {
+	// Datacenter is the name or inventory path of the datacenter in which
+	// the virtual machines are created.
+	// +optional
+	Datacenter string `json:"datacenter,omitempty"`
+
+	// Cluster is the name or inventory path of the compute cluster in which
+	// the virtual machines are created.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+
+	// Datastore is the name or inventory path of the datastore in which the
+	// virtual machines are created.
+	// +optional
+	Datastore string `json:"datastore,omitempty"`
+
+	// Template is the name or inventory path of the template the virtual
+	// machines are cloned from.
+	// +optional
+	Template string `json:"template,omitempty"`
+
+	// NetworkDevices are the network devices of the machines that have none.
+	// They cannot be set together with the Network of the cluster, which the
+	// machines without network devices are connected to.
+	// +optional
+	NetworkDevices []NetworkDeviceSpec `json:"networkDevices,omitempty"`
+}
+
+// ClusterNetworkSpec defines the SDN network created for a cluster.
+type ClusterNetworkSpec struct {
+	// CIDR is the address range of the subnet, for example 10.6.0.0/24.
+	CIDR string `json:"cidr"`
+
+	// Gateway is the gateway address of the subnet. Defaults to the first
+	// address of the CIDR.
+	// +optional
+	Gateway string `json:"gateway,omitempty"`
+
+	// DNSServers is a list of nameservers handed out by the DHCP of the subnet.
+	// +optional
+	DNSServers []string `json:"dnsServers,omitempty"`
+
+	// ExternalNetworkID is the ID of the external network the router uses as
+	// its gateway. The cluster network has no external access when it is
+	// unset.
+	// +optional
+	ExternalNetworkID string `json:"externalNetworkID,omitempty"`
+
+	// NetworkType is the type of the SDN network, for example VXLAN or VLAN.
+	// +kubebuilder:default=VXLAN
+	// +optional
+	NetworkType string `json:"networkType,omitempty"`
+}
+
+// ManagedSecurityGroups defines the security groups managed for the machines
+// of a cluster.
+type ManagedSecurityGroups struct {
+	// ControlPlaneRules are extra rules of the security group of the control
+	// plane machines.
+	// +optional
+	ControlPlaneRules []SecurityGroupRule `json:"controlPlaneRules,omitempty"`
+
+	// WorkerRules are extra rules of the security group of the worker machines.
+	// +optional
+	WorkerRules []SecurityGroupRule `json:"workerRules,omitempty"`
+}
+
+// SecurityGroupRule defines a rule of a security group.
+type SecurityGroupRule struct {
+	// Description of the rule.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Direction of the traffic the rule applies to.
+	// +kubebuilder:validation:Enum=ingress;egress
+	// +kubebuilder:default=ingress
+	// +optional
+	Direction string `json:"direction,omitempty"`
+
+	// Protocol of the traffic the rule applies to.
+	// +kubebuilder:validation:Enum=tcp;udp;icmp;any
+	// +kubebuilder:default=tcp
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+
+	// PortRangeMin is the first port of the range the rule applies to.
+	// +optional
+	PortRangeMin int32 `json:"portRangeMin,omitempty"`
+
+	// PortRangeMax is the last port of the range the rule applies to.
+	// Defaults to PortRangeMin.
+	// +optional
+	PortRangeMax int32 `json:"portRangeMax,omitempty"`
+
+	// RemoteIPPrefix is the CIDR the traffic is allowed from or to. Defaults
+	// to all addresses.
+	// +optional
+	RemoteIPPrefix string `json:"remoteIPPrefix,omitempty"`
+}
+
+// ClusterNetworkStatus holds the IDs of the SDN resources created for a cluster.
+type ClusterNetworkStatus struct {
+	// NetworkID is the ID of the SDN network.
+	// +optional
+	NetworkID string `json:"networkID,omitempty"`
+
+	// NetworkName is the name of the SDN network.
+	// +optional
+	NetworkName string `json:"networkName,omitempty"`
+
+	// SubnetID is the ID of the subnet of the SDN network.
+	// +optional
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// SubnetName is the name of the subnet of the SDN network.
+	// +optional
+	SubnetName string `json:"subnetName,omitempty"`
+
+	// RouterID is the ID of the router the subnet is attached to.
+	// +optional
+	RouterID string `json:"routerID,omitempty"`
+}
+
+// LoadBalancerSpec defines the ICS load balancer of the control plane.
+type LoadBalancerSpec struct {
+	// NetworkID is the ID of the SDN network the VIP of the load balancer is
+	// allocated on. Defaults to the cluster network.
+	// +optional
+	NetworkID string `json:"networkID,omitempty"`
+
+	// SubnetID is the ID of the subnet the VIP of the load balancer is
+	// allocated on. Defaults to the subnet of the cluster network.
+	// +optional
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// VIPAddress is the address of the load balancer. An address of the
+	// subnet is allocated when it is unset.
+	// +optional
+	VIPAddress string `json:"vipAddress,omitempty"`
+}
+
+// ControlPlaneVIPSpec defines the virtual IP of the control plane.
+type ControlPlaneVIPSpec struct {
+	// Address is the virtual IP. It is allocated from AddressPool when unset.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// AddressPool is a list of IP addresses and CIDRs the virtual IP is
+	// allocated from when Address is unset. Addresses used by IPAddress
+	// objects are skipped.
+	// +optional
+	AddressPool []string `json:"addressPool,omitempty"`
+
+	// Interface is the network interface of the control plane machines the
+	// virtual IP is announced on.
+	// +kubebuilder:default=eth0
+	// +optional
+	Interface string `json:"interface,omitempty"`
+
+	// Image is the kube-vip container image.
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// ClusterModule holds the anti affinity construct `ClusterModule` identifier
+// in use by the VMs owned by the object referred by the TargetObjectName field.
+type ClusterModule struct {
+	// ControlPlane indicates whether the referred object is responsible for control plane nodes.
+	// Currently, only the KubeadmControlPlane objects have this flag set to true.
+	// Only a single object in the slice can have this value set to true.
+	ControlPlane bool `json:"controlPlane"`
+
+	// TargetObjectName points to the object that uses the Cluster Module information to enforce
+	// anti-affinity amongst its descendant VM objects.
+	TargetObjectName string `json:"targetObjectName"`
+
+	// ModuleUUID is the unique identifier of the `ClusterModule` used by the object.
+	ModuleUUID string `json:"moduleUUID"`
+}
+
+// ICSClusterStatus defines the observed state of ICSCluster
+type ICSClusterStatus struct {
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// Conditions defines current service state of the ICSCluster.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+
+	// ICenterVersion defines the version of the iCenter server defined in the spec.
+	ICenterVersion ICenterVersion `json:"iCenterVersion,omitempty"`
+
+	// Network holds the IDs of the SDN resources created for the cluster
+	// network of the spec.
+	// +optional
+	Network *ClusterNetworkStatus `json:"network,omitempty"`
+
+	// SecurityGroups holds the security groups managed for the machines of
+	// the cluster.
+	// +optional
+	SecurityGroups *ClusterSecurityGroupsStatus `json:"securityGroups,omitempty"`
+
+	// LoadBalancerEnabled is true when an ICS load balancer is serving the
+	// control plane endpoint of the cluster.
+	// +optional
+	LoadBalancerEnabled bool `json:"loadBalancerEnabled,omitempty"`
+
+	// LoadBalancer describes the ICS load balancer created for the control
+	// plane.
+	// +optional
+	LoadBalancer *LoadBalancerStatus `json:"loadBalancer,omitempty"`
+}
+
+// LoadBalancerStatus describes an ICS load balancer.
+type LoadBalancerStatus struct {
+	// ID of the load balancer.
+	ID string `json:"id"`
+
+	// Name of the load balancer.
+	Name string `json:"name"`
+
+	// VIPAddress is the address of the load balancer.
+	// +optional
+	VIPAddress string `json:"vipAddress,omitempty"`
+}
+
+// ClusterSecurityGroupsStatus holds the security groups of the machine roles.
+type ClusterSecurityGroupsStatus struct {
+	// ControlPlane is the security group of the control plane machines.
+	// +optional
+	ControlPlane *SecurityGroupStatus `json:"controlPlane,omitempty"`
+
+	// Worker is the security group of the worker machines.
+	// +optional
+	Worker *SecurityGroupStatus `json:"worker,omitempty"`
+}
+
+// SecurityGroupStatus identifies a security group.
+type SecurityGroupStatus struct {
+	// ID of the security group.
+	ID string `json:"id"`
+
+	// Name of the security group.
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=icsclusters,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Cluster infrastructure is ready for ICSMachine"
+// +kubebuilder:printcolumn:name="CloudName",type="string",JSONPath=".spec.cloudName",description="Server is the address of the iCenter endpoint."
+// +kubebuilder:printcolumn:name="ControlPlaneEndpoint",type="string",JSONPath=".spec.controlPlaneEndpoint[0]",description="API Endpoint",priority=1
+
+// ICSCluster is the Schema for the icsclusters API
+type ICSCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ICSClusterSpec   `json:"spec,omitempty"`
+	Status ICSClusterStatus `json:"status,omitempty"`
+}
+
+func (m *ICSCluster) GetConditions() clusterv1.Conditions {
+	return m.Status.Conditions
+}
+
+func (m *ICSCluster) SetConditions(conditions clusterv1.Conditions) {
+	m.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// ICSClusterList contains a list of ICSCluster
+type ICSClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ICSCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ICSCluster{}, &ICSClusterList{})
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/errors"
+)
+
+const (
+	// MachinePoolFinalizer allows the reconciler to clean up resources
+	// associated with an ICSMachinePool before removing it from the API
+	// server.
+	MachinePoolFinalizer = "icsmachinepool.infrastructure.cluster.x-k8s.io"
+
+	// MachinePoolNameLabel is the label set on the ICSVMs of an
+	// ICSMachinePool to the name of the pool.
+	MachinePoolNameLabel = "icsmachinepool.infrastructure.cluster.x-k8s.io/name"
+
+	// MachinePoolTemplateHashAnnotation is the annotation set on the ICSVMs
+	// of an ICSMachinePool to the hash of the template and bootstrap data
+	// they were created from.
+	MachinePoolTemplateHashAnnotation = "icsmachinepool.infrastructure.cluster.x-k8s.io/template-hash"
+)
+
+// MachinePoolScaleDownPolicy selects the VMs of an ICSMachinePool that are
+// deleted first when the pool is scaled down or rolled out.
+type MachinePoolScaleDownPolicy string
+
+const (
+	// ScaleDownOldestFirst deletes the oldest VMs first.
+	ScaleDownOldestFirst MachinePoolScaleDownPolicy = "OldestFirst"
+
+	// ScaleDownNewestFirst deletes the newest VMs first.
+	ScaleDownNewestFirst MachinePoolScaleDownPolicy = "NewestFirst"
+
+	// ScaleDownPlacement deletes the VMs of the host running the most VMs
+	// of the pool first, oldest first, to keep the pool spread over the
+	// hosts.
+	ScaleDownPlacement MachinePoolScaleDownPolicy = "Placement"
+)
+
+// ICSMachinePoolSpec defines the desired state of ICSMachinePool.
+type ICSMachinePoolSpec struct {
+	// Template is the configuration of the VMs of the pool. Changing it
+	// replaces the VMs of the pool.
+	Template VirtualMachineCloneSpec `json:"template"`
+
+	// ProviderIDList are the identification IDs of the VMs of the pool.
+	// It is set by the controller.
+	// +optional
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// ScaleDownPolicy selects the VMs deleted first when the pool is scaled
+	// down or rolled out. VMs that are not ready are always deleted first.
+	// Defaults to OldestFirst.
+	// +kubebuilder:validation:Enum=OldestFirst;NewestFirst;Placement
+	// +kubebuilder:default=OldestFirst
+	// +optional
+	ScaleDownPolicy MachinePoolScaleDownPolicy `json:"scaleDownPolicy,omitempty"`
+
+	// RollingUpdate controls the replacement of the VMs of the pool when the
+	// template or bootstrap data changes.
+	// +optional
+	RollingUpdate *MachinePoolRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// MachinePoolRollingUpdate controls the replacement of the VMs of an
+// ICSMachinePool.
+type MachinePoolRollingUpdate struct {
+	// MaxSurge is the number of VMs that may be created above the desired
+	// number of replicas while the pool is replaced.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	// +optional
+	MaxSurge *int32 `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the number of VMs below the desired number of
+	// replicas that may be unavailable while the pool is replaced.
+	// Defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=0
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+}
+
+// ICSMachinePoolStatus defines the observed state of ICSMachinePool.
+type ICSMachinePoolStatus struct {
+	// Ready is true when all the VMs of the pool are ready and up to date.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Replicas is the number of ready VMs of the pool.
+	// +optional
+	Replicas int32 `json:"replicas"`
+
+	// Instances are the VMs of the pool.
+	// +optional
+	Instances []ICSMachinePoolInstanceStatus `json:"instances,omitempty"`
+
+	// FailureReason will be set in the event that there is a terminal problem
+	// reconciling the pool and will contain a succinct value suitable
+	// for machine interpretation.
+	// +optional
+	FailureReason *errors.MachineStatusError `json:"failureReason,omitempty"`
+
+	// FailureMessage will be set in the event that there is a terminal problem
+	// reconciling the pool and will contain a more verbose string suitable
+	// for logging and human consumption.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// Conditions defines current service state of the ICSMachinePool.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// ICSMachinePoolInstanceStatus is the status of a VM of an ICSMachinePool.
+type ICSMachinePoolInstanceStatus struct {
+	// Name is the name of the ICSVM.
+	Name string `json:"name"`
+
+	// ProviderID is the identification ID of the VM.
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+
+	// Host is the host the VM runs on.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// Ready is true when the VM is powered on and has an address.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// UpToDate is true when the VM was created from the current template
+	// and bootstrap data of the pool.
+	// +optional
+	UpToDate bool `json:"upToDate"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=icsmachinepools,scope=Namespaced,categories=cluster-api
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Machine pool is ready"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Ready VMs of the machine pool"
+
+// ICSMachinePool is the Schema for the icsmachinepools API
+type ICSMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ICSMachinePoolSpec   `json:"spec,omitempty"`
+	Status ICSMachinePoolStatus `json:"status,omitempty"`
+}
+
+func (r *ICSMachinePool) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+func (r *ICSMachinePool) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// ICSMachinePoolList contains a list of ICSMachinePool
+type ICSMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ICSMachinePool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ICSMachinePool{}, &ICSMachinePoolList{})
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// ICSVMImageSource is where the OVA image or template of an ICSVMImage comes
+// from. Exactly one of the fields must be set.
+type ICSVMImageSource struct {
+	// URL is the HTTP or HTTPS URL of an OVA image. iCenter downloads the
+	// image from the URL, so it must be reachable from iCenter.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// OCI is an OVA image stored as the only layer of an OCI artifact.
+	// +optional
+	OCI *OCIImageSource `json:"oci,omitempty"`
+
+	// Template is the name of an existing template. The image is ready as
+	// soon as the template is found, nothing is uploaded.
+	// +optional
+	Template string `json:"template,omitempty"`
+}
+
+// OCIImageSource is an OVA image stored in an OCI registry.
+type OCIImageSource struct {
+	// Reference is the reference of the artifact, e.g.
+	// registry.example.com/images/ubuntu-2004:v1.23.5.
+	Reference string `json:"reference"`
+
+	// SecretRef is a reference to a Secret in the same namespace with the
+	// username and password keys used to pull the artifact.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+
+	// Insecure pulls the artifact over HTTP.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// ICSVMImageTemplateSpec is the template an OVA image is converted into.
+type ICSVMImageTemplateSpec struct {
+	// Name is the name of the template.
+	// Defaults to the name of the ICSVMImage.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Datastore is the name of the datastore of the disks of the template.
+	Datastore string `json:"datastore"`
+
+	// Cluster is the ID of the compute cluster of the host the image is
+	// imported on.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// ICSVMImageSpec defines the desired state of ICSVMImage.
+type ICSVMImageSpec struct {
+	// CloudName is the name of the iCenter the image is registered on.
+	CloudName string `json:"cloudName"`
+
+	// IdentityRef is a reference to either a Secret that contains
+	// the identity to use when reconciling the image.
+	// +optional
+	IdentityRef *ICSIdentityReference `json:"identityRef,omitempty"`
+
+	// Source is where the image comes from.
+	Source ICSVMImageSource `json:"source"`
+
+	// ImageName is the file name of the OVA image on the image datastores.
+	// Defaults to the name of the ICSVMImage with the .ova extension.
+	// +optional
+	ImageName string `json:"imageName,omitempty"`
+
+	// Datastores are the names of the image datastores the image is
+	// uploaded to.
+	// Defaults to the first image datastore of iCenter.
+	// +optional
+	Datastores []string `json:"datastores,omitempty"`
+
+	// Checksum is the expected MD5 checksum of the OVA image. An image
+	// with another checksum is not used.
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
+
+	// Template converts the uploaded image into a template, so that
+	// virtual machines are cloned instead of imported from it.
+	// +optional
+	Template *ICSVMImageTemplateSpec `json:"template,omitempty"`
+}
+
+// ICSVMImageStatus defines the observed state of ICSVMImage.
+type ICSVMImageStatus struct {
+	// Ready is true when virtual machines can be created from the image. It
+	// stays true while iCenter cannot be reached, and is reset once the image
+	// or the template is found missing.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// ImageName is the file name of the OVA image on the image datastores.
+	// +optional
+	ImageName string `json:"imageName,omitempty"`
+
+	// Checksum is the MD5 checksum of the OVA image reported by iCenter.
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
+
+	// Datastores are the names of the image datastores the image is
+	// registered on.
+	// +optional
+	Datastores []string `json:"datastores,omitempty"`
+
+	// Uploading are the names of the image datastores the image is being
+	// downloaded into.
+	// +optional
+	Uploading []string `json:"uploading,omitempty"`
+
+	// TemplateName is the name of the template virtual machines are cloned
+	// from. It is empty when they are imported from the OVA image.
+	// +optional
+	TemplateName string `json:"templateName,omitempty"`
+
+	// TaskRef is the ID of the iCenter task importing the image to convert
+	// it into a template.
+	// +optional
+	TaskRef string `json:"taskRef,omitempty"`
+
+	// Conditions defines current service state of the ICSVMImage.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=icsvmimages,scope=Namespaced,categories=cluster-api
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Image is ready"
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".status.imageName",description="OVA image on the image datastores"
+// +kubebuilder:printcolumn:name="Template",type="string",JSONPath=".status.templateName",description="Template the image was converted into"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of ICSVMImage"
+
+// ICSVMImage is the Schema for the icsvmimages API. The OVA image and the
+// template of an ICSVMImage are left in iCenter when it is deleted, as
+// virtual machines may still be created from them.
+type ICSVMImage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ICSVMImageSpec   `json:"spec,omitempty"`
+	Status ICSVMImageStatus `json:"status,omitempty"`
+}
+
+func (r *ICSVMImage) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+func (r *ICSVMImage) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// ICSVMImageList contains a list of ICSVMImage
+type ICSVMImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ICSVMImage `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ICSVMImage{}, &ICSVMImageList{})
+}
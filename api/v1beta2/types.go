@@ -0,0 +1,707 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// CloneMode is the type of clone operation used to clone a VM from a template.
+type CloneMode string
+
+type ICSIdentityKind string
+
+var (
+	SecretKind = ICSIdentityKind("Secret")
+)
+
+const (
+	// FullClone indicates a VM will have no relationship to the source of the
+	// clone operation once the operation is complete. This is the safest clone
+	// mode, but it is not the fastest.
+	FullClone CloneMode = "fullClone"
+
+	// LinkedClone means resulting VMs will be dependent upon the snapshot of
+	// the source VM/template from which the VM was cloned. This is the fastest
+	// clone mode, but it also prevents expanding a VMs disk beyond the size of
+	// the source VM/template.
+	LinkedClone CloneMode = "linkedClone"
+
+	ImportVM CloneMode = "importVM"
+)
+
+// DriftPolicy describes how the controller reacts when the configuration of
+// a virtual machine drifted away from its spec, for example after it was
+// edited in iCenter.
+type DriftPolicy string
+
+const (
+	// DriftPolicyReport only reports the drift in the VMConfigurationDrifted
+	// condition. This is the default behavior.
+	DriftPolicyReport DriftPolicy = "Report"
+
+	// DriftPolicyCorrect applies the CPU and memory of the spec back to the
+	// virtual machine while it is powered off, or while it runs if the change
+	// can be hot-plugged. Drift that cannot be corrected in place is reported.
+	DriftPolicyCorrect DriftPolicy = "Correct"
+
+	// DriftPolicyRemediate marks the machine as failed so that a
+	// MachineHealthCheck replaces it.
+	DriftPolicyRemediate DriftPolicy = "Remediate"
+)
+
+// DeletionPolicy describes what happens to a virtual machine and its disks
+// when the owning ICSVM is deleted.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete removes the virtual machine together with all of
+	// its disks. This is the default behavior.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyRetainDataDisks detaches every disk but the system disk
+	// from the virtual machine before it is removed. Each detached volume is
+	// marked as retained in its description in iCenter, which records the
+	// cluster, namespace and name of the machine it belonged to, so the data
+	// can be inspected or re-attached later.
+	DeletionPolicyRetainDataDisks DeletionPolicy = "RetainDataDisks"
+
+	// DeletionPolicyRetainPoweredOff powers off the virtual machine and
+	// leaves it, and all of its disks, in place for later analysis. The
+	// virtual machine is marked as retained in its description so that the
+	// orphan garbage collector does not delete it.
+	DeletionPolicyRetainPoweredOff DeletionPolicy = "RetainPoweredOff"
+)
+
+// DatastoreSelectionStrategy describes how a datastore is chosen among the
+// datastores matched by a DatastoreSelector.
+type DatastoreSelectionStrategy string
+
+const (
+	// DatastoreSelectionMostFreeSpace chooses the datastore with the most
+	// available capacity. This is the default strategy.
+	DatastoreSelectionMostFreeSpace DatastoreSelectionStrategy = "MostFreeSpace"
+
+	// DatastoreSelectionRoundRobin spreads virtual machines evenly across
+	// the matched datastores.
+	DatastoreSelectionRoundRobin DatastoreSelectionStrategy = "RoundRobin"
+
+	// DatastoreSelectionHostLocal prefers datastores that are local to the
+	// host the virtual machine is placed on.
+	DatastoreSelectionHostLocal DatastoreSelectionStrategy = "HostLocal"
+)
+
+// DatastoreSelector selects the datastore of a virtual machine. A datastore
+// has to match all the criteria that are set.
+type DatastoreSelector struct {
+	// Tag is the name of an ICS tag the datastore has to be tagged with.
+	// +optional
+	Tag string `json:"tag,omitempty"`
+
+	// Type is the type of the datastore, for example LOCAL or NFS.
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Candidates is a list of names of datastores to choose from.
+	// +optional
+	Candidates []string `json:"candidates,omitempty"`
+
+	// Strategy is how a datastore is chosen among the matched datastores.
+	// Defaults to MostFreeSpace.
+	// +kubebuilder:validation:Enum=MostFreeSpace;RoundRobin;HostLocal
+	// +optional
+	Strategy DatastoreSelectionStrategy `json:"strategy,omitempty"`
+}
+
+// PlacementSpec selects where a virtual machine is created.
+type PlacementSpec struct {
+	// Datacenter is the name or inventory path of the datacenter in which
+	// the virtual machine is created/located.
+	// +optional
+	Datacenter string `json:"datacenter,omitempty"`
+
+	// Cluster is the name or inventory path of the compute cluster in which
+	// the virtual machine is created/located.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+
+	// Datastores is a list of names or inventory paths of the datastores the
+	// virtual machine may be created on, in order of preference.
+	// +optional
+	Datastores []string `json:"datastores,omitempty"`
+
+	// DatastoreSelector selects the datastore of the virtual machine from a
+	// set of datastores. It cannot be set together with Datastores.
+	// +optional
+	DatastoreSelector *DatastoreSelector `json:"datastoreSelector,omitempty"`
+}
+
+// ResizePolicy describes how changes to the CPU and memory of a machine are
+// rolled out.
+type ResizePolicy string
+
+const (
+	// ResizePolicyReplace rolls out CPU and memory changes by replacing the
+	// machine. This is the default behavior.
+	ResizePolicyReplace ResizePolicy = "Replace"
+
+	// ResizePolicyInPlace applies CPU and memory changes to the existing
+	// virtual machine. The change is hot-plugged when the VM and its guest
+	// OS support it, otherwise the node is drained and the VM is rebooted.
+	ResizePolicyInPlace ResizePolicy = "InPlace"
+)
+
+type ICSIdentityReference struct {
+	// Kind of the identity. Can either be Secret
+	// +kubebuilder:validation:Enum=Secret
+	Kind ICSIdentityKind `json:"kind"`
+
+	// Name of the identity.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// +optional
+	IdentityKey *string `json:"identityKey,omitempty"`
+}
+
+// VirtualMachineCloneSpec is information used to clone a virtual machine.
+type VirtualMachineCloneSpec struct {
+	// Server is the IP address or FQDN of the ics server on which
+	// the virtual machine is created/located.
+	// +optional
+	CloudName string `json:"cloudName"`
+
+	// IdentityRef is a reference to either a Secret that contains
+	// the identity to use when reconciling the cluster.
+	// +optional
+	IdentityRef *ICSIdentityReference `json:"identityRef,omitempty"`
+
+	// Template is the name or inventory path of the template used to clone
+	// the virtual machine.
+	// Defaults to the template of the MachineDefaults of the ICSCluster.
+	// +optional
+	Template string `json:"template"`
+
+	// ImageRef is a reference to an ICSVMImage in the same namespace that
+	// the virtual machine is created from when Template is empty. The
+	// virtual machine is not created before the image is ready.
+	// +optional
+	ImageRef *corev1.LocalObjectReference `json:"imageRef,omitempty"`
+
+	// CloneMode specifies the type of clone operation.
+	// The LinkedClone mode is only support for templates that have at least
+	// one snapshot. If the template has no snapshots, then CloneMode defaults
+	// to FullClone.
+	// When LinkedClone mode is enabled the DiskGiB field is ignored as it is
+	// not possible to expand disks of linked clones.
+	// Defaults to LinkedClone, but fails gracefully to FullClone if the source
+	// of the clone operation has no snapshots.
+	// +optional
+	CloneMode CloneMode `json:"cloneMode,omitempty"`
+
+	// Snapshot is the name of the snapshot from which to create a linked clone.
+	// This field is ignored if LinkedClone is not enabled.
+	// Defaults to the source's current snapshot.
+	// +optional
+	Snapshot string `json:"snapshot,omitempty"`
+
+	// Placement selects where the virtual machine is created.
+	// +optional
+	Placement PlacementSpec `json:"placement,omitempty"`
+
+	// Network is the network configuration for this machine's VM.
+	Network NetworkSpec `json:"network"`
+
+	// NumCPUs is the number of virtual processors in a virtual machine.
+	// Defaults to the eponymous property value in the template from which the
+	// virtual machine is cloned.
+	// +optional
+	NumCPUs int32 `json:"numCPUs,omitempty"`
+
+	// NumCPUs is the number of cores among which to distribute CPUs in this
+	// virtual machine.
+	// Defaults to the eponymous property value in the template from which the
+	// virtual machine is cloned.
+	// +optional
+	NumCoresPerSocket int32 `json:"numCoresPerSocket,omitempty"`
+
+	// MemoryMiB is the size of a virtual machine's memory, in MiB.
+	// Defaults to the eponymous property value in the template from which the
+	// virtual machine is cloned.
+	// +optional
+	MemoryMiB int64 `json:"memoryMiB,omitempty"`
+
+	// Disks is the vm disks configuration for this machine's VM.
+	// +optional
+	Disks []DiskSpec `json:"disks,omitempty"`
+
+	// SSHUser specifies the name of a user that is granted remote access to the
+	// deployed VM.
+	// +optional
+	User *SSHUser `json:"user,omitempty"`
+
+	// DeletionPolicy specifies what happens to the virtual machine and its
+	// disks when the machine is deleted.
+	// Defaults to Delete.
+	// +kubebuilder:validation:Enum=Delete;RetainDataDisks;RetainPoweredOff
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy specifies how the controller reacts when the configuration
+	// of the virtual machine drifted away from this spec.
+	// Defaults to Report.
+	// +kubebuilder:validation:Enum=Report;Correct;Remediate
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// ResizePolicy specifies how changes to NumCPUs and MemoryMiB are
+	// rolled out. With InPlace, NumCPUs and MemoryMiB may be changed on an
+	// existing machine.
+	// Defaults to Replace.
+	// +kubebuilder:validation:Enum=Replace;InPlace
+	// +optional
+	ResizePolicy ResizePolicy `json:"resizePolicy,omitempty"`
+
+	// Tags is a list of names of ICS tags to attach to the virtual machine.
+	// Tags that do not exist yet are created.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// AuthorizedMode describes the Authorized Type of the user.
+type AuthorizedMode string
+
+const (
+	SSHKey = AuthorizedMode("sshkey")
+
+	PasswordToken = AuthorizedMode("token")
+)
+
+// SSHUser is granted remote access to a system.
+type SSHUser struct {
+	// Name is the name of the vm system user.
+	Name string `json:"name"`
+
+	// AuthorizedType is the authorized type that grant remote access.
+	AuthorizedType AuthorizedMode `json:"authorizedType"`
+
+	// AuthorizedKey is one SSH keys that grant remote access.
+	AuthorizedKey string `json:"authorizedKey"`
+}
+
+// VolumeFormat is the format of the volume of a disk.
+type VolumeFormat string
+
+const (
+	// VolumeFormatRaw is a raw volume.
+	VolumeFormatRaw VolumeFormat = "RAW"
+
+	// VolumeFormatQCOW2 is a QCOW2 volume.
+	VolumeFormatQCOW2 VolumeFormat = "QCOW2"
+)
+
+// VolumePolicy is the provisioning policy of the volume of a disk.
+type VolumePolicy string
+
+const (
+	// VolumePolicyThin allocates the volume on demand.
+	VolumePolicyThin VolumePolicy = "THIN"
+
+	// VolumePolicyThick allocates the whole volume when it is created.
+	VolumePolicyThick VolumePolicy = "THICK"
+)
+
+// DiskCacheMode is the read/write cache mode of a disk.
+type DiskCacheMode string
+
+const (
+	// DiskCacheModeNone disables the cache.
+	DiskCacheModeNone DiskCacheMode = "NONE"
+
+	// DiskCacheModeDirectSync writes through the cache and bypasses the
+	// host page cache.
+	DiskCacheModeDirectSync DiskCacheMode = "DIRECTSYNC"
+
+	// DiskCacheModeWriteThrough writes through the cache.
+	DiskCacheModeWriteThrough DiskCacheMode = "WRITETHROUGH"
+
+	// DiskCacheModeWriteBack writes back from the cache.
+	DiskCacheModeWriteBack DiskCacheMode = "WRITEBACK"
+)
+
+// DiskSpec defines a disk of a virtual machine. The first disk is the system
+// disk.
+type DiskSpec struct {
+	// DiskSize is the size of a virtual machine's disk, in GiB.
+	// Defaults to the eponymous property value in the template from which the
+	// virtual machine is cloned.
+	// +optional
+	DiskSize int32 `json:"diskSize,omitempty"`
+
+	// BusModel default value: VIRTIO
+	// +optional
+	BusModel string `json:"busModel,omitempty"`
+
+	// VolumeFormat is the format of the volume of the disk.
+	// Defaults to RAW.
+	// +kubebuilder:validation:Enum=RAW;QCOW2
+	// +optional
+	VolumeFormat VolumeFormat `json:"volumeFormat,omitempty"`
+
+	// VolumePolicy is the provisioning policy of the volume of the disk.
+	// Defaults to THIN.
+	// +kubebuilder:validation:Enum=THIN;THICK
+	// +optional
+	VolumePolicy VolumePolicy `json:"volumePolicy,omitempty"`
+
+	// Datastore is the name of the datastore the disk is created on.
+	// Defaults to the datastore of the virtual machine.
+	// +optional
+	Datastore string `json:"datastore,omitempty"`
+
+	// ReadIOPS limits the read operations per second of the disk.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ReadIOPS int32 `json:"readIOPS,omitempty"`
+
+	// WriteIOPS limits the write operations per second of the disk.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	WriteIOPS int32 `json:"writeIOPS,omitempty"`
+
+	// ReadBPS limits the bytes read per second from the disk.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ReadBPS int32 `json:"readBPS,omitempty"`
+
+	// WriteBPS limits the bytes written per second to the disk.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	WriteBPS int32 `json:"writeBPS,omitempty"`
+
+	// QueueCount is the number of IO queues of the disk.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	QueueCount int32 `json:"queueCount,omitempty"`
+
+	// NativeIO enables native asynchronous IO for the disk.
+	// +optional
+	NativeIO bool `json:"nativeIO,omitempty"`
+
+	// KernelIO enables kernel IO for the disk.
+	// +optional
+	KernelIO bool `json:"kernelIO,omitempty"`
+
+	// CacheMode is the read/write cache mode of the disk.
+	// Defaults to NONE.
+	// +kubebuilder:validation:Enum=NONE;DIRECTSYNC;WRITETHROUGH;WRITEBACK
+	// +optional
+	CacheMode DiskCacheMode `json:"cacheMode,omitempty"`
+}
+
+// NetworkSpec defines the virtual machine's network configuration.
+type NetworkSpec struct {
+	// Devices is the list of network devices used by the virtual machine.
+	// Make sure at least one network matches the
+	//             ClusterSpec.CloudProviderConfiguration.Network.Name
+	Devices []NetworkDeviceSpec `json:"devices"`
+
+	// Routes is a list of optional, static routes applied to the virtual
+	// machine.
+	// +optional
+	Routes []NetworkRouteSpec `json:"routes,omitempty"`
+}
+
+// SwitchType is the type of the ICS switch a network device is connected to.
+type SwitchType string
+
+const (
+	// NormalSwitch is a virtual switch of a host.
+	NormalSwitch SwitchType = "NORMALSWITCH"
+
+	// SDNSwitch is a switch of the SDN of ICS.
+	SDNSwitch SwitchType = "SDNSWITCH"
+
+	// ExtSDNSwitch is a switch of an external OpenStack VXLAN SDN.
+	ExtSDNSwitch SwitchType = "VXLANOPENSTACKSWITCH"
+)
+
+// NetworkDeviceSpec defines the network configuration for a virtual machine's
+// network device.
+type NetworkDeviceSpec struct {
+	// SwitchType the type of the ics switch network to which the device will be connected.
+	// +kubebuilder:validation:Enum=NORMALSWITCH;SDNSWITCH;VXLANOPENSTACKSWITCH
+	SwitchType SwitchType `json:"switchType"`
+
+	// NetworkID is the ID of the ics network to which the device
+	// will be connected.
+	NetworkID string `json:"networkID"`
+
+	// NetworkName is the name of the ics network to which the device
+	// will be connected.
+	NetworkName string `json:"networkName"`
+
+	// NetworkType the type of the ics network to which the device will be connected.
+	// +optional
+	NetworkType string `json:"networkType,omitempty"`
+
+	// DeviceID may be used to explicitly assign a name to the network device
+	// as it exists in the guest operating system.
+	// +optional
+	DeviceID string `json:"deviceID,omitempty"`
+
+	// DeviceName may be used to explicitly assign a name to the network device
+	// as it exists in the guest operating system.
+	// +optional
+	DeviceName string `json:"deviceName,omitempty"`
+
+	// DHCP4 is a flag that indicates whether or not to use DHCP for IPv4
+	// on this device.
+	// If true then IPAddrs should not contain any IPv4 addresses.
+	// +optional
+	DHCP4 bool `json:"dhcp4,omitempty"`
+
+	// DHCP6 is a flag that indicates whether or not to use DHCP for IPv6
+	// on this device.
+	// If true then IPAddrs should not contain any IPv6 addresses.
+	// +optional
+	DHCP6 bool `json:"dhcp6,omitempty"`
+
+	// NetMask the network device network.
+	// +optional
+	NetMask string `json:"netMask,omitempty"`
+
+	// Gateway4 is the IPv4 gateway used by this device.
+	// Required when DHCP4 is false.
+	// +optional
+	Gateway4 string `json:"gateway4,omitempty"`
+
+	// Gateway4 is the IPv4 gateway used by this device.
+	// Required when DHCP6 is false.
+	// +optional
+	Gateway6 string `json:"gateway6,omitempty"`
+
+	// IPAddrs is a list of one or more IPv4 and/or IPv6 addresses to assign
+	// to this device.
+	// Required when DHCP4 and DHCP6 are both false.
+	// +optional
+	IPAddrs []string `json:"ipAddrs,omitempty"`
+
+	// MTU is the device’s Maximum Transmission Unit size in bytes.
+	// +optional
+	MTU *int64 `json:"mtu,omitempty"`
+
+	// MACAddr is the MAC address used by this device.
+	// It is generally a good idea to omit this field and allow a MAC address
+	// to be generated.
+	// Please note that this value must use the OUI to work with the
+	// in-tree ics cloud provider.
+	// +optional
+	MACAddr string `json:"macAddr,omitempty"`
+
+	// MACAddrPool is a list of MAC addresses, or ranges of MAC addresses in
+	// the form first-last, to allocate the MAC address of the device from
+	// when MACAddr is not set. The lowest free address is used, so that a
+	// replacement machine gets the address of the machine it replaces.
+	// Multicast addresses cannot be allocated, so the first octet of a range
+	// must be the same even number for all its addresses.
+	// +optional
+	MACAddrPool []string `json:"macAddrPool,omitempty"`
+
+	// MACAddrPrefix is a prefix of one to five octets, for example
+	// 52:54:00:10, to allocate the MAC address of the device from when
+	// MACAddr is not set. It is used after the addresses of MACAddrPool.
+	// The first octet must be even, multicast prefixes are rejected.
+	// +optional
+	MACAddrPrefix string `json:"macAddrPrefix,omitempty"`
+
+	// SecurityGroups is a list of IDs of the security groups attached to the
+	// device. It only applies to devices on SDN networks, and defaults to the
+	// managed security group of the machine role of the ICSCluster.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// Nameservers is a list of IPv4 and/or IPv6 addresses used as DNS
+	// nameservers.
+	// Please note that Linux allows only three nameservers (https://linux.die.net/man/5/resolv.conf).
+	// +optional
+	Nameservers []string `json:"nameservers,omitempty"`
+
+	// Routes is a list of optional, static routes applied to the device.
+	// +optional
+	Routes []NetworkRouteSpec `json:"routes,omitempty"`
+
+	// SearchDomains is a list of search domains used when resolving IP
+	// addresses with DNS.
+	// +optional
+	SearchDomains []string `json:"searchDomains,omitempty"`
+
+	// Model is the model of the virtual NIC.
+	// Default VIRTIO, VIRTIO\E1000\RTL8139
+	// +kubebuilder:validation:Enum=VIRTIO;E1000;RTL8139
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// Queues is the number of queues of a multiqueue virtio NIC.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Queues int32 `json:"queues,omitempty"`
+
+	// SendQueueLength is the length of the send queue of the NIC.
+	// Defaults to 256.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SendQueueLength int32 `json:"sendQueueLength,omitempty"`
+
+	// ReceiveQueueLength is the length of the receive queue of the NIC.
+	// Defaults to 256.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ReceiveQueueLength int32 `json:"receiveQueueLength,omitempty"`
+
+	// UplinkRate limits the outbound traffic of the NIC.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	UplinkRate int32 `json:"uplinkRate,omitempty"`
+
+	// UplinkBurst is the outbound burst allowed above UplinkRate.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	UplinkBurst int32 `json:"uplinkBurst,omitempty"`
+
+	// DownlinkRate limits the inbound traffic of the NIC.
+	// Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DownlinkRate int32 `json:"downlinkRate,omitempty"`
+
+	// DownlinkBurst is the inbound burst allowed above DownlinkRate.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DownlinkBurst int32 `json:"downlinkBurst,omitempty"`
+
+	// Priority is the network priority of the NIC.
+	// HIGH\MEDIUM\LOW, no priority is set by default.
+	// +kubebuilder:validation:Enum=HIGH;MEDIUM;LOW
+	// +optional
+	Priority string `json:"priority,omitempty"`
+}
+
+// NetworkRouteSpec defines a static network route.
+type NetworkRouteSpec struct {
+	// To is an IPv4 or IPv6 address.
+	To string `json:"to"`
+	// Via is an IPv4 or IPv6 address.
+	Via string `json:"via"`
+	// Metric is the weight/priority of the route.
+	Metric int32 `json:"metric"`
+}
+
+// NetworkStatus provides information about one of a VM's networks.
+type NetworkStatus struct {
+	// Connected is a flag that indicates whether this network is currently
+	// connected to the VM.
+	Connected bool `json:"connected,omitempty"`
+
+	// IPAddrs is one or more IP addresses reported by vm-tools.
+	// +optional
+	IPAddrs []string `json:"ipAddrs,omitempty"`
+
+	// MACAddr is the MAC address of the network device.
+	MACAddr string `json:"macAddr"`
+
+	// NetworkName is the name of the network.
+	// +optional
+	NetworkName string `json:"networkName,omitempty"`
+}
+
+// DiskStatus provides information about one of a VM's disks.
+type DiskStatus struct {
+	// VolumeID is the ID of the ICS volume backing the disk.
+	VolumeID string `json:"volumeID"`
+
+	// DiskSize is the size of the disk, in GiB.
+	// +optional
+	DiskSize int32 `json:"diskSize,omitempty"`
+
+	// Datastore is the name of the datastore the volume is located on.
+	// +optional
+	Datastore string `json:"datastore,omitempty"`
+}
+
+// ICSMachineTemplateResource describes the data needed to create a ICSMachine from a template
+type ICSMachineTemplateResource struct {
+	// Spec is the specification of the desired behavior of the machine.
+	Spec ICSMachineSpec `json:"spec"`
+}
+
+// VirtualMachineState describes the state of a VM.
+type VirtualMachineState string
+
+const (
+	// VirtualMachineStateNotFound is the string representing a VM that
+	// cannot be located.
+	VirtualMachineStateNotFound = VirtualMachineState("notfound")
+
+	// VirtualMachineStatePending is the string representing a VM with an in-flight task.
+	VirtualMachineStatePending = VirtualMachineState("pending")
+
+	// VirtualMachineStateReady is the string representing a powered-on VM with reported IP addresses.
+	VirtualMachineStateReady = VirtualMachineState("ready")
+)
+
+// VirtualMachinePowerState describe the power state of a VM
+type VirtualMachinePowerState string
+
+const (
+	// VirtualMachinePowerStatePoweredOn is the string representing a VM in powered on state
+	VirtualMachinePowerStatePoweredOn = VirtualMachinePowerState("poweredOn")
+
+	// VirtualMachinePowerStatePoweredOff is the string representing a VM in powered off state
+	VirtualMachinePowerStatePoweredOff = VirtualMachinePowerState("poweredOff")
+
+	// VirtualMachinePowerStateSuspended is the string representing a VM in suspended state
+	VirtualMachinePowerStateSuspended = VirtualMachinePowerState("suspended")
+
+	// VirtualMachineTransientState is the string representing a VM in transient state
+	VirtualMachineTransientState = VirtualMachinePowerState("transient")
+)
+
+// VirtualMachine represents data about a ics virtual machine object.
+type VirtualMachine struct {
+	// UID is the VM's ID.
+	UID string `json:"UID"`
+
+	// Name is the VM's name.
+	Name string `json:"name"`
+
+	// BiosUUID is the VM's BIOS UUID.
+	BiosUUID string `json:"biosUUID"`
+
+	// State is the VM's state.
+	State VirtualMachineState `json:"state"`
+
+	// Network is the status of the VM's network devices.
+	Network []NetworkStatus `json:"network"`
+}
@@ -28,16 +28,18 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/klog"
+	"k8s.io/utils/pointer"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	clusterutilv1 "sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	capisecret "sigs.k8s.io/cluster-api/util/secret"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -52,6 +54,7 @@ import (
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/identity"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/record"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/sdn"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
 	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
 )
@@ -256,6 +259,53 @@ func (r clusterReconciler) reconcileDelete(ctx *context.ClusterContext) (reconci
 		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
 	}
 
+	// Delete the load balancers once no machine is behind them, and the
+	// security groups and cluster network once no VM is connected to them.
+	if ok, err := r.reconcileLoadBalancerRefDelete(ctx); !ok {
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err,
+				"unexpected error while deleting load balancer for %s", ctx)
+		}
+		ctx.Logger.Info("Waiting for load balancer to be deleted")
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	if ok, err := r.reconcileManagedLoadBalancerDelete(ctx); !ok {
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err,
+				"unexpected error while deleting load balancer for %s", ctx)
+		}
+		ctx.Logger.Info("Waiting for load balancer to be deleted")
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	// The VMs of machine pools and load balancers have no ICSMachine, but
+	// their NICs are on the cluster network and in its security groups too.
+	icsVMs, err := infrautilv1.GetICSVMsInCluster(ctx, ctx.Client, ctx.Cluster.Namespace, ctx.Cluster.Name)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err,
+			"unable to list ICSVMs part of ICSCluster %s/%s", ctx.ICSCluster.Namespace, ctx.ICSCluster.Name)
+	}
+	if len(icsVMs) > 0 {
+		ctx.Logger.Info("Waiting for ICSVMs to be deleted", "count", len(icsVMs))
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	if ok, err := r.reconcileSecurityGroupsDelete(ctx); !ok {
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err,
+				"unexpected error while deleting security groups for %s", ctx)
+		}
+		ctx.Logger.Info("Waiting for security groups to be deleted")
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	if ok, err := r.reconcileNetworkDelete(ctx); !ok {
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err,
+				"unexpected error while deleting cluster network for %s", ctx)
+		}
+		ctx.Logger.Info("Waiting for cluster network to be deleted")
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
 	// Remove finalizer on Identity Secret
 	if identity.IsSecretIdentity(ctx.ICSCluster) {
 		secret := &corev1.Secret{}
@@ -306,6 +356,7 @@ func (r clusterReconciler) reconcileNormal(ctx *context.ClusterContext) (reconci
 			"unexpected error while probing icenter for %s", ctx)
 	}
 	conditions.MarkTrue(ctx.ICSCluster, infrav1.ICenterAvailableCondition)
+	ctx.Session = iCenterSession
 
 	err = r.reconcileICenterVersion(ctx, iCenterSession)
 	if err != nil || ctx.ICSCluster.Status.ICenterVersion == "" {
@@ -313,6 +364,26 @@ func (r clusterReconciler) reconcileNormal(ctx *context.ClusterContext) (reconci
 		ctx.Logger.Error(err, "could not reconcile iCenter version")
 	}
 
+	// Reconcile the ICSCluster's cluster network.
+	if ok, err := r.reconcileNetwork(ctx); !ok {
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err,
+				"unexpected error while reconciling cluster network for %s", ctx)
+		}
+		ctx.Logger.Info("cluster network is not reconciled")
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	// Reconcile the ICSCluster's managed security groups.
+	if ok, err := r.reconcileSecurityGroups(ctx); !ok {
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err,
+				"unexpected error while reconciling security groups for %s", ctx)
+		}
+		ctx.Logger.Info("security groups are not reconciled")
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
 	// Reconcile the ICSCluster's load balancer.
 	if ok, err := r.reconcileLoadBalancer(ctx); !ok {
 		if err != nil {
@@ -320,7 +391,7 @@ func (r clusterReconciler) reconcileNormal(ctx *context.ClusterContext) (reconci
 				"unexpected error while reconciling load balancer for %s", ctx)
 		}
 		ctx.Logger.Info("load balancer is not reconciled")
-		return reconcile.Result{}, nil
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
 	}
 
 	ctx.ICSCluster.Status.Ready = true
@@ -335,6 +406,12 @@ func (r clusterReconciler) reconcileNormal(ctx *context.ClusterContext) (reconci
 		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
 	}
 
+	// Point the kubeconfig of the cluster at the control plane endpoint.
+	if err := r.reconcileKubeconfigEndpoint(ctx); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err,
+			"unexpected error while reconciling kubeconfig endpoint for %s", ctx)
+	}
+
 	// Ensure the ICSCluster is reconciled when the API server first comes online.
 	// A reconcile event will only be triggered if the Cluster is not marked as
 	// ControlPlaneInitialized.
@@ -354,8 +431,12 @@ func (r clusterReconciler) reconcileNormal(ctx *context.ClusterContext) (reconci
 		return reconcile.Result{}, nil
 	}
 
-	// Sync Custom kubeconfig
-	r.syncCustomKubeConfig(ctx)
+	// Point the configuration of the workload cluster at the control plane
+	// endpoint.
+	if err := r.reconcileWorkloadClusterEndpoint(ctx); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err,
+			"unexpected error while reconciling workload cluster endpoint for %s", ctx)
+	}
 
 	return reconcile.Result{}, nil
 }
@@ -441,6 +522,25 @@ func (r clusterReconciler) reconcileICenterVersion(ctx *context.ClusterContext,
 }
 
 func (r clusterReconciler) reconcileLoadBalancer(ctx *context.ClusterContext) (bool, error) {
+	// Announce a virtual IP from the control plane machines when one is
+	// configured.
+	if ctx.ICSCluster.Spec.ControlPlaneVIP != nil {
+		return r.reconcileControlPlaneVIP(ctx)
+	}
+
+	// Take the endpoint from the referenced load balancer when there is one.
+	if ctx.ICSCluster.Spec.LoadBalancerRef != nil {
+		return r.reconcileLoadBalancerRef(ctx)
+	}
+
+	// Create an ICS load balancer for the control plane when one is enabled
+	// without an endpoint.
+	if ctx.ICSCluster.Spec.EnabledLoadBalancer &&
+		(ctx.ICSCluster.Status.LoadBalancer != nil ||
+			(ctx.Cluster.Spec.ControlPlaneEndpoint.IsZero() && ctx.ICSCluster.Spec.ControlPlaneEndpoint.IsZero())) {
+		return r.reconcileManagedLoadBalancer(ctx)
+	}
+
 	if !ctx.Cluster.Spec.ControlPlaneEndpoint.IsZero() {
 		ctx.ICSCluster.Spec.ControlPlaneEndpoint.Host = ctx.Cluster.Spec.ControlPlaneEndpoint.Host
 		ctx.ICSCluster.Spec.ControlPlaneEndpoint.Port = ctx.Cluster.Spec.ControlPlaneEndpoint.Port
@@ -467,9 +567,531 @@ func (r clusterReconciler) reconcileLoadBalancer(ctx *context.ClusterContext) (b
 	return true, nil
 }
 
+// reconcileLoadBalancerRef sets the control plane endpoint to the address of
+// the referenced load balancer once it is ready. The load balancer is owned
+// by the ICSCluster and labeled with the name of the cluster, so that its
+// controller can find the control plane machines.
+func (r clusterReconciler) reconcileLoadBalancerRef(ctx *context.ClusterContext) (bool, error) {
+	lb, err := r.getLoadBalancerRef(ctx)
+	if err != nil {
+		return false, err
+	}
+	if lb == nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition, infrav1.WaitingForLoadBalancerIPReason, clusterv1.ConditionSeverityInfo,
+			"load balancer %s %s not found", ctx.ICSCluster.Spec.LoadBalancerRef.Kind, ctx.ICSCluster.Spec.LoadBalancerRef.Name)
+		return false, nil
+	}
+
+	patchHelper, err := patch.NewHelper(lb, ctx.Client)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to init patch helper for load balancer %s", lb.GetName())
+	}
+	lb.SetOwnerReferences(clusterutilv1.EnsureOwnerRef(lb.GetOwnerReferences(), metav1.OwnerReference{
+		APIVersion: ctx.ICSCluster.APIVersion,
+		Kind:       ctx.ICSCluster.Kind,
+		Name:       ctx.ICSCluster.Name,
+		UID:        ctx.ICSCluster.UID,
+		Controller: pointer.Bool(true),
+	}))
+	labels := lb.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[clusterv1.ClusterLabelName] = ctx.Cluster.Name
+	lb.SetLabels(labels)
+	if err := patchHelper.Patch(ctx, lb); err != nil {
+		return false, errors.Wrapf(err, "failed to patch load balancer %s", lb.GetName())
+	}
+
+	ready, _, err := unstructured.NestedBool(lb.Object, "status", "ready")
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get ready state of load balancer %s", lb.GetName())
+	}
+	address, _, err := unstructured.NestedString(lb.Object, "status", "address")
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get address of load balancer %s", lb.GetName())
+	}
+	if !ready || address == "" {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition, infrav1.WaitingForLoadBalancerIPReason, clusterv1.ConditionSeverityInfo, "")
+		return false, nil
+	}
+
+	port := ctx.ICSCluster.Spec.ControlPlaneEndpoint.Port
+	if port == 0 {
+		lbPort, ok, err := unstructured.NestedInt64(lb.Object, "spec", "port")
+		if err != nil || !ok || lbPort == 0 {
+			lbPort = int64(defaultAPIEndpointPort)
+		}
+		port = int32(lbPort)
+	}
+	ctx.ICSCluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{Host: address, Port: port}
+	conditions.MarkTrue(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition)
+	ctx.Logger.Info("ControlPlaneEndpoint discovered via load balancer",
+		"controlPlaneEndpoint", ctx.ICSCluster.Spec.ControlPlaneEndpoint.String())
+	return true, nil
+}
+
+// reconcileLoadBalancerRefDelete deletes the referenced load balancer. It
+// returns true once the load balancer is gone.
+func (r clusterReconciler) reconcileLoadBalancerRefDelete(ctx *context.ClusterContext) (bool, error) {
+	if ctx.ICSCluster.Spec.LoadBalancerRef == nil {
+		return true, nil
+	}
+	lb, err := r.getLoadBalancerRef(ctx)
+	if err != nil || lb == nil {
+		return lb == nil && err == nil, err
+	}
+	if lb.GetDeletionTimestamp().IsZero() {
+		if err := ctx.Client.Delete(ctx, lb); err != nil && !apierrors.IsNotFound(err) {
+			return false, errors.Wrapf(err, "failed to delete load balancer %s", lb.GetName())
+		}
+	}
+	return false, nil
+}
+
+// getLoadBalancerRef returns the load balancer referenced by the ICSCluster,
+// or nil if it does not exist.
+func (r clusterReconciler) getLoadBalancerRef(ctx *context.ClusterContext) (*unstructured.Unstructured, error) {
+	ref := ctx.ICSCluster.Spec.LoadBalancerRef
+	lb := &unstructured.Unstructured{}
+	lb.SetAPIVersion(ref.APIVersion)
+	lb.SetKind(ref.Kind)
+	key := client.ObjectKey{Namespace: ctx.ICSCluster.Namespace, Name: ref.Name}
+	if err := ctx.Client.Get(ctx, key, lb); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get load balancer %s %s", ref.Kind, key)
+	}
+	return lb, nil
+}
+
+// reconcileControlPlaneVIP sets the control plane endpoint to the virtual IP
+// of the cluster, allocating it from the address pool if needed. The address
+// is reserved with an IPAddress owned by the ICSCluster, so that it is not
+// handed out to a machine, and kept for the life of the cluster.
+func (r clusterReconciler) reconcileControlPlaneVIP(ctx *context.ClusterContext) (bool, error) {
+	vip := ctx.ICSCluster.Spec.ControlPlaneVIP
+	reservation := &infrav1.IPAddress{}
+	reservationKey := client.ObjectKey{Namespace: ctx.ICSCluster.Namespace, Name: controlPlaneVIPName(ctx.ICSCluster)}
+	err := ctx.Client.Get(ctx, reservationKey, reservation)
+	switch {
+	case apierrors.IsNotFound(err):
+		address := vip.Address
+		if address == "" {
+			if address, err = r.allocateControlPlaneVIP(ctx); err != nil {
+				conditions.MarkFalse(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition, infrav1.ControlPlaneVIPAllocationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+				r.Recorder.Warnf(ctx.ICSCluster, "ControlPlaneVIPAllocationFailed", "Failed to allocate control plane virtual IP: %v", err)
+				return false, err
+			}
+		}
+		reservation = &infrav1.IPAddress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: reservationKey.Namespace,
+				Name:      reservationKey.Name,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "ICSCluster",
+					Name:       ctx.ICSCluster.Name,
+					UID:        ctx.ICSCluster.UID,
+				}},
+			},
+			Spec: infrav1.IPAddressSpec{
+				VMRef: corev1.ObjectReference{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "ICSCluster",
+					Name:       ctx.ICSCluster.Name,
+					Namespace:  ctx.ICSCluster.Namespace,
+				},
+				Address: address,
+			},
+		}
+		if err := ctx.Client.Create(ctx, reservation); err != nil {
+			return false, errors.Wrapf(err, "failed to reserve control plane virtual IP %s", address)
+		}
+		r.Recorder.Eventf(ctx.ICSCluster, "ControlPlaneVIPAllocated", "Reserved %s as the control plane virtual IP", address)
+	case err != nil:
+		return false, errors.Wrapf(err, "failed to get IPAddress %s", reservationKey)
+	}
+
+	port := ctx.ICSCluster.Spec.ControlPlaneEndpoint.Port
+	if port == 0 {
+		port = defaultAPIEndpointPort
+	}
+	ctx.ICSCluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{Host: reservation.Spec.Address, Port: port}
+	ctx.ICSCluster.Spec.EnabledLoadBalancer = false
+	conditions.MarkTrue(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition)
+	ctx.Logger.Info("ControlPlaneEndpoint set to virtual IP",
+		"controlPlaneEndpoint", ctx.ICSCluster.Spec.ControlPlaneEndpoint.String())
+	return true, nil
+}
+
+// allocateControlPlaneVIP returns the first address of the address pool of
+// the control plane virtual IP that is not used by an IPAddress.
+func (r clusterReconciler) allocateControlPlaneVIP(ctx *context.ClusterContext) (string, error) {
+	allocations := &infrav1.IPAddressList{}
+	if err := ctx.Client.List(ctx, allocations); err != nil {
+		return "", errors.Wrap(err, "failed to list IPAddresses")
+	}
+	allocated := map[string]string{}
+	for _, ipAddress := range allocations.Items {
+		allocated[ipAddress.Spec.Address] = ipAddress.Name
+	}
+	return infrautilv1.GetFreeIPFromPool(ctx.ICSCluster.Spec.ControlPlaneVIP.AddressPool, allocated)
+}
+
+// controlPlaneVIPName returns the name of the IPAddress reserving the
+// control plane virtual IP.
+func controlPlaneVIPName(icsCluster *infrav1.ICSCluster) string {
+	return fmt.Sprintf("%s-control-plane-vip", icsCluster.Name)
+}
+
+// reconcileManagedLoadBalancer creates the ICS load balancer of the control
+// plane, uses its VIP as the control plane endpoint and keeps its backend
+// pool in sync with the control plane machines. It returns true once the
+// load balancer is ready.
+func (r clusterReconciler) reconcileManagedLoadBalancer(ctx *context.ClusterContext) (bool, error) {
+	spec := sdn.LoadBalancer{Name: loadBalancerName(ctx.ICSCluster)}
+	if lbSpec := ctx.ICSCluster.Spec.LoadBalancer; lbSpec != nil {
+		spec.NetworkID = lbSpec.NetworkID
+		spec.SubnetID = lbSpec.SubnetID
+		spec.VIPAddress = lbSpec.VIPAddress
+	}
+	if network := ctx.ICSCluster.Status.Network; spec.SubnetID == "" && network != nil {
+		spec.NetworkID = network.NetworkID
+		spec.SubnetID = network.SubnetID
+	}
+	if spec.SubnetID == "" {
+		err := errors.New("no subnet for the load balancer, set spec.loadBalancer.subnetID or spec.network")
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition, infrav1.LoadBalancerCreationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return false, err
+	}
+
+	lb, err := sdn.EnsureLoadBalancer(ctx, spec)
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition, infrav1.LoadBalancerCreationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		r.Recorder.Warnf(ctx.ICSCluster, "LoadBalancerCreationFailed", "Failed to create load balancer %s: %v", spec.Name, err)
+		return false, err
+	}
+	if lb == nil || lb.VIPAddress == "" {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition, infrav1.WaitingForLoadBalancerIPReason, clusterv1.ConditionSeverityInfo, "")
+		return false, nil
+	}
+	ctx.ICSCluster.Status.LoadBalancer = &infrav1.LoadBalancerStatus{ID: lb.ID, Name: lb.Name, VIPAddress: lb.VIPAddress}
+
+	port := ctx.ICSCluster.Spec.ControlPlaneEndpoint.Port
+	if port == 0 {
+		port = defaultAPIEndpointPort
+	}
+	listener, err := sdn.EnsureListener(ctx, lb, port)
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition, infrav1.LoadBalancerCreationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		r.Recorder.Warnf(ctx.ICSCluster, "LoadBalancerCreationFailed", "Failed to create listener of load balancer %s: %v", lb.Name, err)
+		return false, err
+	}
+	if listener == nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition, infrav1.LoadBalancerProvisioningReason, clusterv1.ConditionSeverityInfo, "")
+		return false, nil
+	}
+	if ctx.ICSCluster.Spec.ControlPlaneEndpoint.Host != lb.VIPAddress {
+		r.Recorder.Eventf(ctx.ICSCluster, "LoadBalancerReady", "Using load balancer %s at %s:%d as control plane endpoint", lb.Name, lb.VIPAddress, port)
+	}
+	ctx.ICSCluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{Host: lb.VIPAddress, Port: port}
+
+	addresses, err := controlPlaneAddresses(ctx, ctx.Client, ctx.Cluster)
+	if err != nil {
+		return false, err
+	}
+	changed, err := sdn.ReconcilePoolMembers(ctx, listener.PoolID, addresses, port)
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition, infrav1.LoadBalancerMembersUpdateFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		r.Recorder.Warnf(ctx.ICSCluster, "LoadBalancerMembersUpdateFailed", "Failed to update members of load balancer %s: %v", lb.Name, err)
+		return false, err
+	}
+	if changed {
+		r.Recorder.Eventf(ctx.ICSCluster, "LoadBalancerMembersUpdated", "Updated members of load balancer %s to %v", lb.Name, addresses)
+	}
+	conditions.MarkTrue(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition)
+	return true, nil
+}
+
+// reconcileManagedLoadBalancerDelete deletes the ICS load balancer of the
+// control plane. It returns true if the cluster has none or once it is gone.
+func (r clusterReconciler) reconcileManagedLoadBalancerDelete(ctx *context.ClusterContext) (bool, error) {
+	if ctx.ICSCluster.Status.LoadBalancer == nil {
+		return true, nil
+	}
+	if err := r.ensureICenterSession(ctx); err != nil {
+		return false, err
+	}
+
+	conditions.MarkFalse(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+	deleted, err := sdn.DeleteLoadBalancer(ctx, ctx.ICSCluster.Status.LoadBalancer.Name)
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.LoadBalancerReadyCondition, clusterv1.DeletionFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		r.Recorder.Warnf(ctx.ICSCluster, "LoadBalancerDeletionFailed", "Failed to delete load balancer %s: %v", ctx.ICSCluster.Status.LoadBalancer.Name, err)
+		return false, err
+	}
+	if !deleted {
+		return false, nil
+	}
+	ctx.ICSCluster.Status.LoadBalancer = nil
+	return true, nil
+}
+
+// controlPlaneAddresses returns the preferred IP addresses of the control
+// plane machines of the cluster that are not being deleted.
+func controlPlaneAddresses(ctx goctx.Context, c client.Client, cluster *clusterv1.Cluster) ([]string, error) {
+	icsMachines, err := infrautilv1.GetControlPlaneICSMachinesInCluster(ctx, c, cluster.Namespace, cluster.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err,
+			"failed to get control plane ICSMachines for Cluster %s/%s",
+			cluster.Namespace, cluster.Name)
+	}
+	addresses := []string{}
+	for _, icsMachine := range icsMachines {
+		if !icsMachine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		ipAddr, err := infrautilv1.GetMachinePreferredIPAddress(icsMachine)
+		if err != nil {
+			continue
+		}
+		addresses = append(addresses, ipAddr)
+	}
+	return addresses, nil
+}
+
+// loadBalancerName returns the name of the ICS load balancer of the control
+// plane.
+func loadBalancerName(icsCluster *infrav1.ICSCluster) string {
+	return fmt.Sprintf("capics-%s-%s-apiserver", icsCluster.Namespace, icsCluster.Name)
+}
+
+// reconcileNetwork creates the SDN network, subnet and router of the
+// cluster network and records their IDs in the status. It returns true if
+// the cluster has no cluster network or once all of its resources exist.
+func (r clusterReconciler) reconcileNetwork(ctx *context.ClusterContext) (bool, error) {
+	if ctx.ICSCluster.Spec.Network == nil {
+		return true, nil
+	}
+
+	status, ready, err := sdn.ReconcileNetwork(ctx, clusterNetworkName(ctx.ICSCluster), ctx.ICSCluster.Spec.Network)
+	if status.NetworkID != "" {
+		ctx.ICSCluster.Status.Network = status
+	}
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.ClusterNetworkReadyCondition, infrav1.ClusterNetworkProvisioningFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		r.Recorder.Warnf(ctx.ICSCluster, "ClusterNetworkProvisioningFailed", "Failed to provision cluster network: %v", err)
+		return false, err
+	}
+	if !ready {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.ClusterNetworkReadyCondition, infrav1.ClusterNetworkProvisioningReason, clusterv1.ConditionSeverityInfo, "")
+		return false, nil
+	}
+	if !conditions.IsTrue(ctx.ICSCluster, infrav1.ClusterNetworkReadyCondition) {
+		r.Recorder.Eventf(ctx.ICSCluster, "ClusterNetworkReady", "Provisioned cluster network %s with subnet %s and router %s",
+			status.NetworkID, status.SubnetID, status.RouterID)
+	}
+	conditions.MarkTrue(ctx.ICSCluster, infrav1.ClusterNetworkReadyCondition)
+	return true, nil
+}
+
+// reconcileNetworkDelete deletes the resources of the cluster network. It
+// returns true if the cluster has no cluster network or once all of its
+// resources are gone.
+func (r clusterReconciler) reconcileNetworkDelete(ctx *context.ClusterContext) (bool, error) {
+	if ctx.ICSCluster.Spec.Network == nil && ctx.ICSCluster.Status.Network == nil {
+		return true, nil
+	}
+
+	if err := r.ensureICenterSession(ctx); err != nil {
+		return false, err
+	}
+
+	conditions.MarkFalse(ctx.ICSCluster, infrav1.ClusterNetworkReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+	deleted, err := sdn.DeleteNetwork(ctx, clusterNetworkName(ctx.ICSCluster))
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.ClusterNetworkReadyCondition, clusterv1.DeletionFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		r.Recorder.Warnf(ctx.ICSCluster, "ClusterNetworkDeletionFailed", "Failed to delete cluster network: %v", err)
+		return false, err
+	}
+	if !deleted {
+		return false, nil
+	}
+	ctx.ICSCluster.Status.Network = nil
+	r.Recorder.Eventf(ctx.ICSCluster, "ClusterNetworkDeleted", "Deleted cluster network")
+	return true, nil
+}
+
+// reconcileSecurityGroups creates the managed security groups of the control
+// plane and worker machines and keeps their rules in sync with the spec. It
+// returns true if the cluster has no managed security groups or once both
+// groups exist.
+func (r clusterReconciler) reconcileSecurityGroups(ctx *context.ClusterContext) (bool, error) {
+	spec := ctx.ICSCluster.Spec.ManagedSecurityGroups
+	if spec == nil {
+		return true, nil
+	}
+
+	controlPlaneName, workerName := securityGroupNames(ctx.ICSCluster)
+	controlPlane, err := sdn.EnsureSecurityGroup(ctx, controlPlaneName)
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.SecurityGroupsReadyCondition, infrav1.SecurityGroupsReconcileFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return false, err
+	}
+	worker, err := sdn.EnsureSecurityGroup(ctx, workerName)
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.SecurityGroupsReadyCondition, infrav1.SecurityGroupsReconcileFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return false, err
+	}
+	if controlPlane == nil || worker == nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.SecurityGroupsReadyCondition, infrav1.SecurityGroupsProvisioningReason, clusterv1.ConditionSeverityInfo, "")
+		return false, nil
+	}
+	ctx.ICSCluster.Status.SecurityGroups = &infrav1.ClusterSecurityGroupsStatus{
+		ControlPlane: &infrav1.SecurityGroupStatus{ID: controlPlane.ID, Name: controlPlane.Name},
+		Worker:       &infrav1.SecurityGroupStatus{ID: worker.ID, Name: worker.Name},
+	}
+
+	groupRules := []struct {
+		group *sdn.SecurityGroup
+		rules []sdn.SecurityGroupRule
+	}{
+		{controlPlane, controlPlaneSecurityGroupRules(controlPlane.ID, spec.ControlPlaneRules)},
+		{worker, workerSecurityGroupRules(controlPlane.ID, spec.WorkerRules)},
+	}
+	for _, g := range groupRules {
+		changed, err := sdn.ReconcileSecurityGroupRules(ctx, g.group, g.rules)
+		if err != nil {
+			conditions.MarkFalse(ctx.ICSCluster, infrav1.SecurityGroupsReadyCondition, infrav1.SecurityGroupsReconcileFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			r.Recorder.Warnf(ctx.ICSCluster, "SecurityGroupReconcileFailed", "Failed to update the rules of security group %s: %v", g.group.Name, err)
+			return false, err
+		}
+		if changed {
+			r.Recorder.Eventf(ctx.ICSCluster, "SecurityGroupUpdated", "Updated the rules of security group %s", g.group.Name)
+		}
+	}
+	conditions.MarkTrue(ctx.ICSCluster, infrav1.SecurityGroupsReadyCondition)
+	return true, nil
+}
+
+// reconcileSecurityGroupsDelete deletes the managed security groups. The
+// worker group goes first, as its rules refer to the control plane group.
+// It returns true if the cluster has no managed security groups or once
+// both groups are gone.
+func (r clusterReconciler) reconcileSecurityGroupsDelete(ctx *context.ClusterContext) (bool, error) {
+	if ctx.ICSCluster.Spec.ManagedSecurityGroups == nil && ctx.ICSCluster.Status.SecurityGroups == nil {
+		return true, nil
+	}
+	if err := r.ensureICenterSession(ctx); err != nil {
+		return false, err
+	}
+
+	conditions.MarkFalse(ctx.ICSCluster, infrav1.SecurityGroupsReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+	controlPlaneName, workerName := securityGroupNames(ctx.ICSCluster)
+	for _, name := range []string{workerName, controlPlaneName} {
+		deleted, err := sdn.DeleteSecurityGroup(ctx, name)
+		if err != nil {
+			conditions.MarkFalse(ctx.ICSCluster, infrav1.SecurityGroupsReadyCondition, clusterv1.DeletionFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			r.Recorder.Warnf(ctx.ICSCluster, "SecurityGroupDeletionFailed", "Failed to delete security group %s: %v", name, err)
+			return false, err
+		}
+		if !deleted {
+			return false, nil
+		}
+	}
+	ctx.ICSCluster.Status.SecurityGroups = nil
+	return true, nil
+}
+
+// ensureICenterSession sets the iCenter session of the context if it is not
+// set yet.
+func (r clusterReconciler) ensureICenterSession(ctx *context.ClusterContext) error {
+	if ctx.Session != nil {
+		return nil
+	}
+	iCenterSession, err := r.reconcileICenterConnectivity(ctx)
+	if err != nil {
+		return err
+	}
+	ctx.Session = iCenterSession
+	return nil
+}
+
+// securityGroupNames returns the names of the managed security groups of the
+// control plane and worker machines.
+func securityGroupNames(icsCluster *infrav1.ICSCluster) (string, string) {
+	return fmt.Sprintf("capics-%s-%s-controlplane", icsCluster.Namespace, icsCluster.Name),
+		fmt.Sprintf("capics-%s-%s-worker", icsCluster.Namespace, icsCluster.Name)
+}
+
+// controlPlaneSecurityGroupRules returns the rules of the control plane
+// security group: the API server is open to all, etcd and the kubelet only
+// to the control plane machines.
+func controlPlaneSecurityGroupRules(controlPlaneGroupID string, extra []infrav1.SecurityGroupRule) []sdn.SecurityGroupRule {
+	rules := []sdn.SecurityGroupRule{
+		{Description: "Egress", Direction: "egress"},
+		{Description: "Kubernetes API server", Direction: "ingress", Protocol: "tcp", PortRangeMin: defaultAPIEndpointPort, PortRangeMax: defaultAPIEndpointPort},
+		{Description: "etcd", Direction: "ingress", Protocol: "tcp", PortRangeMin: 2379, PortRangeMax: 2380, RemoteGroupID: controlPlaneGroupID},
+		{Description: "Kubelet API", Direction: "ingress", Protocol: "tcp", PortRangeMin: 10250, PortRangeMax: 10250, RemoteGroupID: controlPlaneGroupID},
+	}
+	return append(rules, securityGroupRules(extra)...)
+}
+
+// workerSecurityGroupRules returns the rules of the worker security group:
+// the kubelet is open to the control plane machines and the NodePorts to
+// all.
+func workerSecurityGroupRules(controlPlaneGroupID string, extra []infrav1.SecurityGroupRule) []sdn.SecurityGroupRule {
+	rules := []sdn.SecurityGroupRule{
+		{Description: "Egress", Direction: "egress"},
+		{Description: "Kubelet API", Direction: "ingress", Protocol: "tcp", PortRangeMin: 10250, PortRangeMax: 10250, RemoteGroupID: controlPlaneGroupID},
+		{Description: "NodePort services", Direction: "ingress", Protocol: "tcp", PortRangeMin: 30000, PortRangeMax: 32767},
+	}
+	return append(rules, securityGroupRules(extra)...)
+}
+
+func securityGroupRules(specs []infrav1.SecurityGroupRule) []sdn.SecurityGroupRule {
+	rules := make([]sdn.SecurityGroupRule, 0, len(specs))
+	for _, spec := range specs {
+		rule := sdn.SecurityGroupRule{
+			Description:    spec.Description,
+			Direction:      spec.Direction,
+			Protocol:       spec.Protocol,
+			PortRangeMin:   spec.PortRangeMin,
+			PortRangeMax:   spec.PortRangeMax,
+			RemoteIPPrefix: spec.RemoteIPPrefix,
+		}
+		if rule.Direction == "" {
+			rule.Direction = "ingress"
+		}
+		switch rule.Protocol {
+		case "":
+			rule.Protocol = "tcp"
+		case "any":
+			rule.Protocol = ""
+		}
+		if rule.PortRangeMax == 0 {
+			rule.PortRangeMax = rule.PortRangeMin
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// clusterNetworkName returns the name of the SDN resources of the cluster
+// network.
+func clusterNetworkName(icsCluster *infrav1.ICSCluster) string {
+	return fmt.Sprintf("capics-%s-%s", icsCluster.Namespace, icsCluster.Name)
+}
 
 func (r clusterReconciler) reconcileControlPlaneEndpoint(ctx *context.ClusterContext) (bool, error) {
 	ctx.Logger.Info("Reconciling control plane endpoint")
+	if ctx.ICSCluster.Spec.ControlPlaneVIP != nil || ctx.ICSCluster.Spec.LoadBalancerRef != nil {
+		// The endpoint was set to the virtual IP or to the address of the
+		// load balancer by reconcileLoadBalancer.
+		return !ctx.ICSCluster.Spec.ControlPlaneEndpoint.IsZero(), nil
+	}
 	if ctx.ICSCluster.Spec.EnabledLoadBalancer {
 		if !ctx.Cluster.Spec.ControlPlaneEndpoint.IsZero() {
 			ctx.ICSCluster.Spec.ControlPlaneEndpoint.Host = ctx.Cluster.Spec.ControlPlaneEndpoint.Host
@@ -587,28 +1209,6 @@ func (r clusterReconciler) reconcileControlPlaneEndpoint(ctx *context.ClusterCon
 		if err != nil {
 			return false, errors.Wrapf(err,"failed to patch the cluster object")
 		}
-		apiServer := fmt.Sprintf("https://%s:%d", ctx.ICSCluster.Spec.ControlPlaneEndpoint.Host,
-			ctx.ICSCluster.Spec.ControlPlaneEndpoint.Port)
-		secret := &corev1.Secret{}
-		secretKey := apitypes.NamespacedName{
-			Namespace: ctx.ICSCluster.Namespace,
-			Name:      ctx.ICSCluster.Name + "-kubeconfig",
-		}
-		if err := ctx.Client.Get(ctx, secretKey, secret); err != nil {
-			return false, errors.Wrapf(err, "failed to retrieve kubeconfig secret for the cluster %s/%s",
-				ctx.ICSCluster.Namespace, ctx.ICSCluster.Name)
-		}
-		value, ok := secret.Data["value"]
-		if !ok {
-			return false, errors.New("error retrieving kubeconfig data: secret value key is missing")
-		}
-		data := strings.Replace(string(value), "https://127.0.0.1:6443", apiServer, -1)
-		secret.Data["value"] = []byte(data)
-
-		err = r.Client.Update(ctx, secret.DeepCopy())
-		if err != nil {
-			return false, errors.Wrapf(err,"failed to update the secret object")
-		}
 	}
 	ctx.Logger.Info(
 		"ControlPlaneEndpoin discovered via control plane machine",
@@ -687,64 +1287,116 @@ func (r clusterReconciler) isAPIServerOnline(ctx *context.ClusterContext) bool {
 	return false
 }
 
-func (r clusterReconciler) syncCustomKubeConfig(ctx *context.ClusterContext) {
-	if kubeClient, err := infrautilv1.NewKubeClient(ctx, ctx.Client, ctx.Cluster); err == nil {
-		clusterInfo, err := kubeClient.CoreV1().ConfigMaps("kube-public").Get(ctx, "cluster-info", metav1.GetOptions{})
-		if err == nil {
-			defaultAPIEndpoint := "127.0.0.1:6443"
-			customerConfig := clusterInfo.Data["kubeconfig"]
-			if !strings.Contains(customerConfig, defaultAPIEndpoint) {
-				return
-			}
-			syncCustom := true
-			apiServer := fmt.Sprintf("%s:%d", ctx.ICSCluster.Spec.ControlPlaneEndpoint.Host,
-				ctx.ICSCluster.Spec.ControlPlaneEndpoint.Port)
-			data := strings.Replace(customerConfig, defaultAPIEndpoint, apiServer, -1)
-			clusterInfo.Data["kubeconfig"] = data
-			_, err = kubeClient.CoreV1().ConfigMaps("kube-public").Update(ctx, clusterInfo, metav1.UpdateOptions{})
-			if err != nil {
-				klog.Errorf("failed to update customer cluster info %s/%s", ctx.ICSCluster.Namespace, ctx.ICSCluster.Name)
-				syncCustom = false
-			} else {
-				ctx.Logger.Info("Sync custom cluster kubeconfig success",
-					"Namespace", ctx.ICSCluster.Namespace, "Name", ctx.ICSCluster.Name)
-			}
-			kubeadmConfig, err := kubeClient.CoreV1().ConfigMaps("kube-system").Get(ctx, "kubeadm-config", metav1.GetOptions{})
-			if err == nil {
-				customerConfig = kubeadmConfig.Data["ClusterConfiguration"]
-				if strings.Contains(customerConfig, defaultAPIEndpoint) {
-					data = strings.Replace(customerConfig, defaultAPIEndpoint, apiServer, -1)
-					kubeadmConfig.Data["ClusterConfiguration"] = data
-					_, err = kubeClient.CoreV1().ConfigMaps("kube-system").Update(ctx, kubeadmConfig, metav1.UpdateOptions{})
-					if err != nil {
-						klog.Errorf("failed to update customer cluster kubeadm Config %s/%s", ctx.ICSCluster.Namespace, ctx.ICSCluster.Name)
-						syncCustom = false
-					}
-				}
-			}
-			kubeProxy, err := kubeClient.CoreV1().ConfigMaps("kube-system").Get(ctx, "kube-proxy", metav1.GetOptions{})
-			if err == nil {
-				kubeConfig := kubeProxy.Data["kubeconfig.conf"]
-				if strings.Contains(kubeConfig, defaultAPIEndpoint) {
-					data = strings.Replace(kubeConfig, defaultAPIEndpoint, apiServer, -1)
-					kubeProxy.Data["kubeconfig.conf"] = data
-					_, err = kubeClient.CoreV1().ConfigMaps("kube-system").Update(ctx, kubeProxy, metav1.UpdateOptions{})
-					if err != nil {
-						klog.Errorf("failed to update customer cluster kubeproxy Config %s/%s", ctx.ICSCluster.Namespace, ctx.ICSCluster.Name)
-						syncCustom = false
-					}
-				}
+// reconcileKubeconfigEndpoint points the kubeconfig secret of the cluster at
+// the control plane endpoint. The secret is created by the control plane
+// provider, possibly with a local endpoint, and is rewritten whenever the
+// endpoint changes.
+func (r clusterReconciler) reconcileKubeconfigEndpoint(ctx *context.ClusterContext) error {
+	if ctx.ICSCluster.Spec.ControlPlaneEndpoint.IsZero() {
+		return nil
+	}
+	kubeconfig, err := capisecret.GetFromNamespacedName(ctx, ctx.Client,
+		client.ObjectKey{Namespace: ctx.Cluster.Namespace, Name: ctx.Cluster.Name}, capisecret.Kubeconfig)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(ctx.ICSCluster, infrav1.KubeconfigEndpointSyncedCondition,
+				infrav1.WaitingForKubeconfigReason, clusterv1.ConditionSeverityInfo, "")
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get kubeconfig secret for %s", ctx)
+	}
+	value, ok := kubeconfig.Data[capisecret.KubeconfigDataName]
+	if !ok {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.KubeconfigEndpointSyncedCondition,
+			infrav1.EndpointSyncFailedReason, clusterv1.ConditionSeverityWarning,
+			"kubeconfig secret %s has no %q key", kubeconfig.Name, capisecret.KubeconfigDataName)
+		return errors.Errorf("kubeconfig secret %s has no %q key", kubeconfig.Name, capisecret.KubeconfigDataName)
+	}
+	server := infrautilv1.EndpointURL(ctx.ICSCluster.Spec.ControlPlaneEndpoint)
+	data, changed, err := infrautilv1.SetKubeconfigServer(value, server)
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSCluster, infrav1.KubeconfigEndpointSyncedCondition,
+			infrav1.EndpointSyncFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return errors.Wrapf(err, "failed to rewrite kubeconfig secret %s", kubeconfig.Name)
+	}
+	if changed {
+		kubeconfig.Data[capisecret.KubeconfigDataName] = data
+		if err := ctx.Client.Update(ctx, kubeconfig); err != nil {
+			conditions.MarkFalse(ctx.ICSCluster, infrav1.KubeconfigEndpointSyncedCondition,
+				infrav1.EndpointSyncFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			return errors.Wrapf(err, "failed to update kubeconfig secret %s", kubeconfig.Name)
+		}
+		ctx.Logger.Info("pointed kubeconfig secret at control plane endpoint",
+			"secret", kubeconfig.Name, "server", server)
+	}
+	conditions.MarkTrue(ctx.ICSCluster, infrav1.KubeconfigEndpointSyncedCondition)
+	return nil
+}
+
+// reconcileWorkloadClusterEndpoint points the cluster-info, kubeadm-config
+// and kube-proxy ConfigMaps of the workload cluster at the control plane
+// endpoint, so that joining nodes and kube-proxy follow endpoint changes.
+func (r clusterReconciler) reconcileWorkloadClusterEndpoint(ctx *context.ClusterContext) error {
+	kubeClient, err := infrautilv1.NewKubeClient(ctx, ctx.Client, ctx.Cluster)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create client for workload cluster of %s", ctx)
+	}
+
+	endpoint := ctx.ICSCluster.Spec.ControlPlaneEndpoint
+	server := infrautilv1.EndpointURL(endpoint)
+	setServer := func(kubeconfig string) (string, bool, error) {
+		data, changed, err := infrautilv1.SetKubeconfigServer([]byte(kubeconfig), server)
+		return string(data), changed, err
+	}
+	setEndpoint := func(clusterConfiguration string) (string, bool, error) {
+		return infrautilv1.SetClusterConfigurationEndpoint(clusterConfiguration, infrautilv1.EndpointAddress(endpoint))
+	}
+
+	var errs []error
+	for _, step := range []struct {
+		condition clusterv1.ConditionType
+		namespace string
+		name      string
+		key       string
+		rewrite   func(string) (string, bool, error)
+	}{
+		{infrav1.ClusterInfoEndpointSyncedCondition, metav1.NamespacePublic, "cluster-info", "kubeconfig", setServer},
+		{infrav1.KubeadmConfigEndpointSyncedCondition, metav1.NamespaceSystem, "kubeadm-config", "ClusterConfiguration", setEndpoint},
+		{infrav1.KubeProxyEndpointSyncedCondition, metav1.NamespaceSystem, "kube-proxy", "kubeconfig.conf", setServer},
+	} {
+		configMap, err := kubeClient.CoreV1().ConfigMaps(step.namespace).Get(ctx, step.name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// The ConfigMap is not deployed, e.g. kube-proxy is disabled.
+				conditions.Delete(ctx.ICSCluster, step.condition)
+				continue
 			}
-			if syncCustom {
-				klog.Infof("Sync custom cluster %s/%s apiServer Endpoint to %s", ctx.ICSCluster.Namespace,
-					ctx.ICSCluster.Name, apiServer)
+			conditions.MarkFalse(ctx.ICSCluster, step.condition,
+				infrav1.EndpointSyncFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			errs = append(errs, errors.Wrapf(err, "failed to get ConfigMap %s/%s", step.namespace, step.name))
+			continue
+		}
+		data, changed, err := step.rewrite(configMap.Data[step.key])
+		if err != nil {
+			conditions.MarkFalse(ctx.ICSCluster, step.condition,
+				infrav1.EndpointSyncFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			errs = append(errs, errors.Wrapf(err, "failed to rewrite %s of ConfigMap %s/%s", step.key, step.namespace, step.name))
+			continue
+		}
+		if changed {
+			configMap.Data[step.key] = data
+			if _, err := kubeClient.CoreV1().ConfigMaps(step.namespace).Update(ctx, configMap, metav1.UpdateOptions{}); err != nil {
+				conditions.MarkFalse(ctx.ICSCluster, step.condition,
+					infrav1.EndpointSyncFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+				errs = append(errs, errors.Wrapf(err, "failed to update ConfigMap %s/%s", step.namespace, step.name))
+				continue
 			}
-		} else {
-			klog.Errorf("failed to get customer cluster info %s/%s cluster-info configMap", ctx.ICSCluster.Namespace, ctx.ICSCluster.Name)
+			ctx.Logger.Info("pointed workload cluster ConfigMap at control plane endpoint",
+				"configMap", step.namespace+"/"+step.name, "endpoint", endpoint.String())
 		}
-		return
+		conditions.MarkTrue(ctx.ICSCluster, step.condition)
 	}
-	klog.Errorf("failed to connect customer cluster %s/%s", ctx.ICSCluster.Namespace, ctx.ICSCluster.Name)
+	return kerrors.NewAggregate(errs)
 }
 
 func (r clusterReconciler) isControlPlaneInitialized(ctx *context.ClusterContext) bool {
@@ -825,14 +1477,6 @@ func (r clusterReconciler) getControlPlaneMachineToClusterReq(o client.Object) [
 		return nil
 	}
 
-	if conditions.IsTrue(cluster, clusterv1.ControlPlaneInitializedCondition) {
-		return nil
-	}
-
-	if !cluster.Spec.ControlPlaneEndpoint.IsZero() {
-		return nil
-	}
-
 	// Fetch the ICSCluster
 	icsCluster := &infrav1.ICSCluster{}
 	icsClusterKey := client.ObjectKey{
@@ -845,8 +1489,20 @@ func (r clusterReconciler) getControlPlaneMachineToClusterReq(o client.Object) [
 		return nil
 	}
 
-	if !icsCluster.Spec.ControlPlaneEndpoint.IsZero() {
-		return nil
+	// The backend pool of a managed load balancer follows the control plane
+	// machines for the whole life of the cluster.
+	if icsCluster.Status.LoadBalancer == nil {
+		if conditions.IsTrue(cluster, clusterv1.ControlPlaneInitializedCondition) {
+			return nil
+		}
+
+		if !cluster.Spec.ControlPlaneEndpoint.IsZero() {
+			return nil
+		}
+
+		if !icsCluster.Spec.ControlPlaneEndpoint.IsZero() {
+			return nil
+		}
 	}
 	requests := []reconcile.Request{}
 	req := reconcile.Request{
@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	goctx "context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgorecord "k8s.io/client-go/tools/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/record"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/sdn"
+)
+
+func TestClusterReconcileDeleteWaitsForICSVMs(t *testing.T) {
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"}}
+	icsCluster := &infrav1.ICSCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+		Spec: infrav1.ICSClusterSpec{
+			Network: &infrav1.ClusterNetworkSpec{CIDR: "10.6.0.0/24"},
+		},
+	}
+
+	testCases := []struct {
+		name string
+		vm   *infrav1.ICSVM
+	}{
+		{
+			name: "vm of a machine pool",
+			vm: &infrav1.ICSVM{ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "pool-vm",
+				Labels:    map[string]string{clusterv1.ClusterLabelName: "test", infrav1.MachinePoolNameLabel: "pool"},
+			}},
+		},
+		{
+			name: "vm of a load balancer",
+			vm: &infrav1.ICSVM{ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "lb-vm",
+				Labels:    map[string]string{clusterv1.ClusterLabelName: "test"},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+			controllerContext := &context.ControllerContext{
+				ControllerManagerContext: &context.ControllerManagerContext{
+					Context: goctx.Background(),
+					Client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.vm).Build(),
+					Logger:  log.Log,
+				},
+				Logger:   log.Log,
+				Recorder: record.New(clientgorecord.NewFakeRecorder(10)),
+			}
+			r := clusterReconciler{ControllerContext: controllerContext}
+			ctx := &context.ClusterContext{
+				ControllerContext: controllerContext,
+				Cluster:           cluster.DeepCopy(),
+				ICSCluster:        icsCluster.DeepCopy(),
+				Logger:            log.Log,
+			}
+
+			result, err := r.reconcileDelete(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.RequeueAfter == 0 {
+				t.Error("expected the deletion to be requeued")
+			}
+			// The cluster network is not touched while a vm is left.
+			if conditions.Has(ctx.ICSCluster, infrav1.ClusterNetworkReadyCondition) {
+				t.Error("expected the cluster network not to be deleted")
+			}
+		})
+	}
+}
+
+func TestSecurityGroupRules(t *testing.T) {
+	egress := sdn.SecurityGroupRule{Description: "Egress", Direction: "egress"}
+	apiServer := sdn.SecurityGroupRule{Description: "Kubernetes API server", Direction: "ingress", Protocol: "tcp", PortRangeMin: 6443, PortRangeMax: 6443}
+	etcd := sdn.SecurityGroupRule{Description: "etcd", Direction: "ingress", Protocol: "tcp", PortRangeMin: 2379, PortRangeMax: 2380, RemoteGroupID: "cp"}
+	kubelet := sdn.SecurityGroupRule{Description: "Kubelet API", Direction: "ingress", Protocol: "tcp", PortRangeMin: 10250, PortRangeMax: 10250, RemoteGroupID: "cp"}
+	nodePort := sdn.SecurityGroupRule{Description: "NodePort services", Direction: "ingress", Protocol: "tcp", PortRangeMin: 30000, PortRangeMax: 32767}
+	ssh := infrav1.SecurityGroupRule{Description: "SSH", PortRangeMin: 22, RemoteIPPrefix: "10.0.0.0/8"}
+	sshRule := sdn.SecurityGroupRule{Description: "SSH", Direction: "ingress", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22, RemoteIPPrefix: "10.0.0.0/8"}
+
+	testCases := []struct {
+		name     string
+		rules    func(string, []infrav1.SecurityGroupRule) []sdn.SecurityGroupRule
+		extra    []infrav1.SecurityGroupRule
+		expected []sdn.SecurityGroupRule
+	}{
+		{
+			name:     "control plane defaults",
+			rules:    controlPlaneSecurityGroupRules,
+			expected: []sdn.SecurityGroupRule{egress, apiServer, etcd, kubelet},
+		},
+		{
+			name:     "worker defaults",
+			rules:    workerSecurityGroupRules,
+			expected: []sdn.SecurityGroupRule{egress, kubelet, nodePort},
+		},
+		{
+			name:     "control plane with extra rules",
+			rules:    controlPlaneSecurityGroupRules,
+			extra:    []infrav1.SecurityGroupRule{ssh},
+			expected: []sdn.SecurityGroupRule{egress, apiServer, etcd, kubelet, sshRule},
+		},
+		{
+			name:     "worker with extra rules",
+			rules:    workerSecurityGroupRules,
+			extra:    []infrav1.SecurityGroupRule{ssh},
+			expected: []sdn.SecurityGroupRule{egress, kubelet, nodePort, sshRule},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.rules("cp", tc.extra)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("got rules %+v, want %+v", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestSecurityGroupRulesFromSpec(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     infrav1.SecurityGroupRule
+		expected sdn.SecurityGroupRule
+	}{
+		{
+			name:     "defaults to tcp ingress on a single port",
+			spec:     infrav1.SecurityGroupRule{PortRangeMin: 22},
+			expected: sdn.SecurityGroupRule{Direction: "ingress", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22},
+		},
+		{
+			name:     "keeps the port range",
+			spec:     infrav1.SecurityGroupRule{Protocol: "udp", PortRangeMin: 8000, PortRangeMax: 8100},
+			expected: sdn.SecurityGroupRule{Direction: "ingress", Protocol: "udp", PortRangeMin: 8000, PortRangeMax: 8100},
+		},
+		{
+			name:     "any protocol",
+			spec:     infrav1.SecurityGroupRule{Direction: "egress", Protocol: "any", RemoteIPPrefix: "0.0.0.0/0"},
+			expected: sdn.SecurityGroupRule{Direction: "egress", RemoteIPPrefix: "0.0.0.0/0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual := securityGroupRules([]infrav1.SecurityGroupRule{tc.spec})
+			if !reflect.DeepEqual(actual, []sdn.SecurityGroupRule{tc.expected}) {
+				t.Errorf("got rules %+v, want %+v", actual, tc.expected)
+			}
+		})
+	}
+}
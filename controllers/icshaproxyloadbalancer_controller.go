@@ -0,0 +1,415 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	goctx "context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	clusterutilv1 "sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/record"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/haproxy"
+	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
+)
+
+const (
+	// haproxyDataPlaneUsername is the user of the data plane API of the
+	// load balancer VMs.
+	haproxyDataPlaneUsername = "capics"
+)
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icshaproxyloadbalancers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icshaproxyloadbalancers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsvms,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+
+// AddHAProxyLoadBalancerControllerToManager adds the HAProxy load balancer
+// controller to the provided manager.
+func AddHAProxyLoadBalancerControllerToManager(ctx *context.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType      = &infrav1.ICSHAProxyLoadBalancer{}
+		controlledTypeName  = reflect.TypeOf(controlledType).Elem().Name()
+		controlledTypeGVK   = infrav1.GroupVersion.WithKind(controlledTypeName)
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	// Build the controller context.
+	controllerContext := &context.ControllerContext{
+		ControllerManagerContext: ctx,
+		Name:                     controllerNameShort,
+		Recorder:                 record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		Logger:                   ctx.Logger.WithName(controllerNameShort),
+	}
+	r := haproxyLoadBalancerReconciler{ControllerContext: controllerContext}
+	_, err := ctrl.NewControllerManagedBy(mgr).
+		// Watch the controlled, infrastructure resource.
+		For(controlledType).
+		// Watch the VM of the load balancer.
+		Owns(&infrav1.ICSVM{}).
+		// Watch the control plane machines to keep the backends in sync
+		// with their addresses.
+		Watches(
+			&source.Kind{Type: &infrav1.ICSMachine{}},
+			handler.EnqueueRequestsFromMapFunc(r.getControlPlaneMachineToLoadBalancersReq),
+		).
+		// Watch a GenericEvent channel for the controlled resource.
+		//
+		// This is useful when there are events outside of Kubernetes that
+		// should cause a resource to be synchronized, such as a goroutine
+		// waiting on some asynchronous, external task to complete.
+		Watches(
+			&source.Channel{Source: ctx.GetGenericEventChannelFor(controlledTypeGVK)},
+			&handler.EnqueueRequestForObject{},
+		).
+		WithOptions(controller.Options{MaxConcurrentReconciles: ctx.MaxConcurrentReconciles}).
+		Build(r)
+	return err
+}
+
+type haproxyLoadBalancerReconciler struct {
+	*context.ControllerContext
+}
+
+// Reconcile ensures the back-end state reflects the Kubernetes resource state intent.
+func (r haproxyLoadBalancerReconciler) Reconcile(ctx goctx.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	// Get the ICSHAProxyLoadBalancer resource for this request.
+	lb := &infrav1.ICSHAProxyLoadBalancer{}
+	if err := r.Client.Get(r, req.NamespacedName, lb); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Logger.Info("ICSHAProxyLoadBalancer not found, won't reconcile", "key", req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	// The ICSCluster referencing the load balancer labels it with the name
+	// of its cluster.
+	cluster, err := clusterutilv1.GetClusterFromMetadata(r, r.Client, lb.ObjectMeta)
+	if err != nil {
+		r.Logger.Info("Waiting for the ICSCluster to claim the load balancer", "key", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	if annotations.IsPaused(cluster, lb) {
+		r.Logger.V(4).Info("ICSHAProxyLoadBalancer linked to a cluster that is paused", "key", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	if cluster.Spec.InfrastructureRef == nil {
+		r.Logger.Info("Cluster has no infrastructure reference, won't reconcile", "key", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	icsCluster := &infrav1.ICSCluster{}
+	icsClusterKey := ctrlclient.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.InfrastructureRef.Name}
+	if err := r.Client.Get(r, icsClusterKey, icsCluster); err != nil {
+		r.Logger.Info("ICSCluster not found, won't reconcile", "key", icsClusterKey)
+		return reconcile.Result{}, nil
+	}
+
+	// Create the patch helper.
+	patchHelper, err := patch.NewHelper(lb, r.Client)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(
+			err,
+			"failed to init patch helper for %s %s/%s",
+			lb.GroupVersionKind(),
+			lb.Namespace,
+			lb.Name)
+	}
+
+	// Create the load balancer context for this request.
+	lbContext := &context.HAProxyLoadBalancerContext{
+		ControllerContext:   r.ControllerContext,
+		Cluster:             cluster,
+		ICSCluster:          icsCluster,
+		HAProxyLoadBalancer: lb,
+		Logger:              r.Logger.WithName(req.Namespace).WithName(req.Name),
+		PatchHelper:         patchHelper,
+	}
+
+	// Always issue a patch when exiting this function so changes to the
+	// resource are patched back to the API server.
+	defer func() {
+		conditions.SetSummary(lbContext.HAProxyLoadBalancer,
+			conditions.WithConditions(
+				infrav1.VMProvisionedCondition,
+				infrav1.LoadBalancerBackendsSyncedCondition,
+			),
+		)
+
+		// Patch the ICSHAProxyLoadBalancer resource.
+		if err := lbContext.Patch(); err != nil {
+			if !infrautilv1.IsNotFoundError(err) {
+				if reterr == nil {
+					reterr = err
+				}
+				lbContext.Logger.Error(err, "patch failed", "loadbalancer", lbContext.String())
+			}
+		}
+	}()
+
+	// Handle deleted load balancers
+	if !lb.ObjectMeta.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(lbContext)
+	}
+
+	// Handle non-deleted load balancers
+	return r.reconcileNormal(lbContext)
+}
+
+func (r haproxyLoadBalancerReconciler) reconcileDelete(ctx *context.HAProxyLoadBalancerContext) (reconcile.Result, error) {
+	ctx.Logger.Info("Handling deleted ICSHAProxyLoadBalancer")
+
+	// Wait for the VM to be destroyed before letting go of the credentials
+	// and bootstrap data it was created with.
+	vm := &infrav1.ICSVM{}
+	vmKey := ctrlclient.ObjectKey{Namespace: ctx.HAProxyLoadBalancer.Namespace, Name: ctx.HAProxyLoadBalancer.Name}
+	if err := ctx.Client.Get(ctx, vmKey, vm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, errors.Wrapf(err, "failed to get ICSVM %s", vmKey)
+		}
+		ctrlutil.RemoveFinalizer(ctx.HAProxyLoadBalancer, infrav1.HAProxyLoadBalancerFinalizer)
+		return reconcile.Result{}, nil
+	}
+	if vm.DeletionTimestamp.IsZero() {
+		if err := ctx.Client.Delete(ctx, vm); err != nil && !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, errors.Wrapf(err, "failed to delete ICSVM %s", vmKey)
+		}
+	}
+	conditions.MarkFalse(ctx.HAProxyLoadBalancer, infrav1.VMProvisionedCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+	ctx.Logger.Info("Waiting for the load balancer VM to be deleted")
+	return reconcile.Result{}, nil
+}
+
+func (r haproxyLoadBalancerReconciler) reconcileNormal(ctx *context.HAProxyLoadBalancerContext) (reconcile.Result, error) {
+	// If the ICSHAProxyLoadBalancer doesn't have our finalizer, add it.
+	ctrlutil.AddFinalizer(ctx.HAProxyLoadBalancer, infrav1.HAProxyLoadBalancerFinalizer)
+
+	secret, err := r.reconcileBootstrapSecret(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	vm, err := r.reconcileVM(ctx, secret)
+	if err != nil {
+		conditions.MarkFalse(ctx.HAProxyLoadBalancer, infrav1.VMProvisionedCondition, infrav1.CloningFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return reconcile.Result{}, err
+	}
+	if !vm.Status.Ready || len(vm.Status.Addresses) == 0 {
+		conditions.MarkFalse(ctx.HAProxyLoadBalancer, infrav1.VMProvisionedCondition, infrav1.WaitingForLoadBalancerVMReason, clusterv1.ConditionSeverityInfo, "")
+		ctx.Logger.Info("Waiting for the load balancer VM to be ready")
+		return reconcile.Result{}, nil
+	}
+	conditions.MarkTrue(ctx.HAProxyLoadBalancer, infrav1.VMProvisionedCondition)
+	ctx.HAProxyLoadBalancer.Status.Address = vm.Status.Addresses[0]
+
+	// Keep the backends in sync with the control plane machines. The load
+	// balancer is ready before the first control plane machine exists as
+	// its address is the control plane endpoint of the machine.
+	addresses, err := controlPlaneAddresses(ctx, ctx.Client, ctx.Cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	dataPlane, err := haproxy.NewClient(
+		ctx.HAProxyLoadBalancer.Status.Address,
+		ctx.HAProxyLoadBalancer.Spec.DataPlaneAPIPort,
+		string(secret.Data["username"]),
+		string(secret.Data["password"]),
+		secret.Data["ca.crt"])
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "invalid bootstrap secret %s/%s", secret.Namespace, secret.Name)
+	}
+	changed, err := dataPlane.ReconcileServers(ctx, haproxy.BackendName, addresses, ctx.HAProxyLoadBalancer.Spec.Port)
+	if err != nil {
+		conditions.MarkFalse(ctx.HAProxyLoadBalancer, infrav1.LoadBalancerBackendsSyncedCondition, infrav1.LoadBalancerBackendsSyncFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		ctx.Logger.Info("Failed to sync load balancer backends, the data plane API may still be starting", "error", err.Error())
+		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+	if changed {
+		r.Recorder.Eventf(ctx.HAProxyLoadBalancer, "BackendsUpdated", "Load balancer backends set to %v", addresses)
+	}
+	conditions.MarkTrue(ctx.HAProxyLoadBalancer, infrav1.LoadBalancerBackendsSyncedCondition)
+	ctx.HAProxyLoadBalancer.Status.Backends = addresses
+	ctx.HAProxyLoadBalancer.Status.Ready = true
+
+	return reconcile.Result{}, nil
+}
+
+// reconcileBootstrapSecret returns the secret holding the credentials and the
+// CA certificate of the data plane API and the cloud-config of the load
+// balancer VM, creating it with a random password and new certificates if it
+// does not exist. The secret is never updated, as the VM is only configured
+// when it is created and the spec of the load balancer cannot be modified.
+func (r haproxyLoadBalancerReconciler) reconcileBootstrapSecret(ctx *context.HAProxyLoadBalancerContext) (*corev1.Secret, error) {
+	lb := ctx.HAProxyLoadBalancer
+	secret := &corev1.Secret{}
+	secretKey := ctrlclient.ObjectKey{Namespace: lb.Namespace, Name: fmt.Sprintf("%s-haproxy", lb.Name)}
+	if err := ctx.Client.Get(ctx, secretKey, secret); err == nil {
+		return secret, nil
+	} else if !apierrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "failed to get secret %s", secretKey)
+	}
+
+	password := make([]byte, 16)
+	if _, err := rand.Read(password); err != nil {
+		return nil, errors.Wrap(err, "failed to generate data plane API password")
+	}
+	certificates, err := haproxy.NewCertificates()
+	if err != nil {
+		return nil, err
+	}
+	config := haproxy.BootstrapConfig{
+		Port:             lb.Spec.Port,
+		DataPlaneAPIPort: lb.Spec.DataPlaneAPIPort,
+		Username:         haproxyDataPlaneUsername,
+		Password:         hex.EncodeToString(password),
+		Cert:             certificates.Cert,
+		Key:              certificates.Key,
+	}
+	cloudConfig, err := haproxy.CloudConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: secretKey.Namespace,
+			Name:      secretKey.Name,
+			Labels:    map[string]string{clusterv1.ClusterLabelName: ctx.Cluster.Name},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: infrav1.GroupVersion.String(),
+				Kind:       "ICSHAProxyLoadBalancer",
+				Name:       lb.Name,
+				UID:        lb.UID,
+				Controller: pointer.Bool(true),
+			}},
+		},
+		Type: clusterv1.ClusterSecretType,
+		Data: map[string][]byte{
+			"username": []byte(config.Username),
+			"password": []byte(config.Password),
+			"ca.crt":   certificates.CACert,
+			"value":    cloudConfig,
+			"format":   []byte("cloud-config"),
+		},
+	}
+	if err := ctx.Client.Create(ctx, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to create secret %s", secretKey)
+	}
+	ctx.Logger.Info("created load balancer bootstrap secret", "secret", secretKey)
+	return secret, nil
+}
+
+// reconcileVM creates or updates the ICSVM of the load balancer, which is
+// cloned and powered on by the ICSVM controller like any machine.
+func (r haproxyLoadBalancerReconciler) reconcileVM(ctx *context.HAProxyLoadBalancerContext, secret *corev1.Secret) (*infrav1.ICSVM, error) {
+	lb := ctx.HAProxyLoadBalancer
+	vm := &infrav1.ICSVM{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: lb.Namespace,
+			Name:      lb.Name,
+		},
+	}
+	mutateFn := func() error {
+		vm.SetOwnerReferences(clusterutilv1.EnsureOwnerRef(
+			vm.OwnerReferences,
+			metav1.OwnerReference{
+				APIVersion: infrav1.GroupVersion.String(),
+				Kind:       "ICSHAProxyLoadBalancer",
+				Name:       lb.Name,
+				UID:        lb.UID,
+				Controller: pointer.Bool(true),
+			}))
+		if vm.Labels == nil {
+			vm.Labels = map[string]string{}
+		}
+		vm.Labels[clusterv1.ClusterLabelName] = ctx.Cluster.Name
+
+		vm.Spec.BootstrapRef = &corev1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Name:       secret.Name,
+			Namespace:  secret.Namespace,
+		}
+
+		lb.Spec.VirtualMachineConfiguration.DeepCopyInto(&vm.Spec.VirtualMachineCloneSpec)
+		if vm.Spec.CloudName == "" {
+			vm.Spec.CloudName = ctx.ICSCluster.Spec.CloudName
+		}
+		if vm.Spec.IdentityRef == nil {
+			vm.Spec.IdentityRef = ctx.ICSCluster.Spec.IdentityRef
+		}
+		return nil
+	}
+	if _, err := ctrlutil.CreateOrPatch(ctx, ctx.Client, vm, mutateFn); err != nil {
+		return nil, errors.Wrapf(err, "failed to create or patch ICSVM %s/%s", vm.Namespace, vm.Name)
+	}
+	return vm, nil
+}
+
+// getControlPlaneMachineToLoadBalancersReq maps a control plane ICSMachine
+// to the load balancers of its cluster.
+func (r haproxyLoadBalancerReconciler) getControlPlaneMachineToLoadBalancersReq(o ctrlclient.Object) []reconcile.Request {
+	icsMachine, ok := o.(*infrav1.ICSMachine)
+	if !ok || !infrautilv1.IsControlPlaneMachine(icsMachine) {
+		return nil
+	}
+	clusterName, ok := icsMachine.Labels[clusterv1.ClusterLabelName]
+	if !ok {
+		return nil
+	}
+
+	lbs := &infrav1.ICSHAProxyLoadBalancerList{}
+	if err := r.Client.List(goctx.Background(), lbs,
+		ctrlclient.InNamespace(icsMachine.Namespace),
+		ctrlclient.MatchingLabels{clusterv1.ClusterLabelName: clusterName}); err != nil {
+		return nil
+	}
+	requests := []reconcile.Request{}
+	for _, lb := range lbs.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: apitypes.NamespacedName{Namespace: lb.Namespace, Name: lb.Name},
+		})
+	}
+	return requests
+}
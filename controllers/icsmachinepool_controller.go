@@ -0,0 +1,543 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	goctx "context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	exputil "sigs.k8s.io/cluster-api/exp/util"
+	clusterutilv1 "sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/record"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services"
+	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
+)
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsmachinepools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsmachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsvms,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+
+// AddMachinePoolControllerToManager adds the machine pool controller to the
+// provided manager.
+func AddMachinePoolControllerToManager(ctx *context.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType      = &infrav1.ICSMachinePool{}
+		controlledTypeName  = reflect.TypeOf(controlledType).Elem().Name()
+		controlledTypeGVK   = infrav1.GroupVersion.WithKind(controlledTypeName)
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	// Build the controller context.
+	controllerContext := &context.ControllerContext{
+		ControllerManagerContext: ctx,
+		Name:                     controllerNameShort,
+		Recorder:                 record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		Logger:                   ctx.Logger.WithName(controllerNameShort),
+	}
+	r := machinePoolReconciler{ControllerContext: controllerContext}
+	_, err := ctrl.NewControllerManagedBy(mgr).
+		// Watch the controlled, infrastructure resource.
+		For(controlledType).
+		// Watch the VMs of the pool.
+		Owns(&infrav1.ICSVM{}).
+		// Watch the CAPI resource that owns this infrastructure resource to
+		// follow the changes of its replicas and bootstrap data.
+		Watches(
+			&source.Kind{Type: &expv1.MachinePool{}},
+			handler.EnqueueRequestsFromMapFunc(exputil.MachinePoolToInfrastructureMapFunc(controlledTypeGVK, r.Logger)),
+		).
+		// Watch a GenericEvent channel for the controlled resource.
+		//
+		// This is useful when there are events outside of Kubernetes that
+		// should cause a resource to be synchronized, such as a goroutine
+		// waiting on some asynchronous, external task to complete.
+		Watches(
+			&source.Channel{Source: ctx.GetGenericEventChannelFor(controlledTypeGVK)},
+			&handler.EnqueueRequestForObject{},
+		).
+		WithOptions(controller.Options{MaxConcurrentReconciles: ctx.MaxConcurrentReconciles}).
+		Build(r)
+	return err
+}
+
+type machinePoolReconciler struct {
+	*context.ControllerContext
+}
+
+// Reconcile ensures the back-end state reflects the Kubernetes resource state intent.
+func (r machinePoolReconciler) Reconcile(ctx goctx.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	// Get the ICSMachinePool resource for this request.
+	icsMachinePool := &infrav1.ICSMachinePool{}
+	if err := r.Client.Get(r, req.NamespacedName, icsMachinePool); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Logger.Info("ICSMachinePool not found, won't reconcile", "key", req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	// Fetch the CAPI MachinePool.
+	machinePool, err := exputil.GetOwnerMachinePool(r, r.Client, icsMachinePool.ObjectMeta)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if machinePool == nil {
+		r.Logger.Info("Waiting for MachinePool Controller to set OwnerRef on ICSMachinePool", "key", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	// Fetch the CAPI Cluster.
+	cluster, err := clusterutilv1.GetClusterFromMetadata(r, r.Client, machinePool.ObjectMeta)
+	if err != nil {
+		r.Logger.Info("MachinePool is missing cluster label or cluster does not exist", "key", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	if annotations.IsPaused(cluster, icsMachinePool) {
+		r.Logger.V(4).Info("ICSMachinePool linked to a cluster that is paused", "key", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	if cluster.Spec.InfrastructureRef == nil {
+		r.Logger.Info("Cluster has no infrastructure reference, won't reconcile", "key", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	icsCluster := &infrav1.ICSCluster{}
+	icsClusterKey := ctrlclient.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.InfrastructureRef.Name}
+	if err := r.Client.Get(r, icsClusterKey, icsCluster); err != nil {
+		r.Logger.Info("ICSCluster not found, won't reconcile", "key", icsClusterKey)
+		return reconcile.Result{}, nil
+	}
+
+	// Create the patch helper.
+	patchHelper, err := patch.NewHelper(icsMachinePool, r.Client)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(
+			err,
+			"failed to init patch helper for %s %s/%s",
+			icsMachinePool.GroupVersionKind(),
+			icsMachinePool.Namespace,
+			icsMachinePool.Name)
+	}
+
+	// Create the machine pool context for this request.
+	poolContext := &context.MachinePoolContext{
+		ControllerContext: r.ControllerContext,
+		Cluster:           cluster,
+		MachinePool:       machinePool,
+		ICSCluster:        icsCluster,
+		ICSMachinePool:    icsMachinePool,
+		Logger:            r.Logger.WithName(req.Namespace).WithName(req.Name),
+		PatchHelper:       patchHelper,
+	}
+
+	// Always issue a patch when exiting this function so changes to the
+	// resource are patched back to the API server.
+	defer func() {
+		conditions.SetSummary(poolContext.ICSMachinePool,
+			conditions.WithConditions(
+				infrav1.ReplicasReadyCondition,
+			),
+		)
+
+		// Patch the ICSMachinePool resource.
+		if err := poolContext.Patch(); err != nil {
+			if !infrautilv1.IsNotFoundError(err) {
+				if reterr == nil {
+					reterr = err
+				}
+				poolContext.Logger.Error(err, "patch failed", "machinepool", poolContext.String())
+			}
+		}
+	}()
+
+	// Handle deleted machine pools
+	if !icsMachinePool.ObjectMeta.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(poolContext)
+	}
+
+	// Handle non-deleted machine pools
+	return r.reconcileNormal(poolContext)
+}
+
+func (r machinePoolReconciler) reconcileDelete(ctx *context.MachinePoolContext) (reconcile.Result, error) {
+	ctx.Logger.Info("Handling deleted ICSMachinePool")
+
+	vms, err := r.getVMs(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(vms) == 0 {
+		ctrlutil.RemoveFinalizer(ctx.ICSMachinePool, infrav1.MachinePoolFinalizer)
+		return reconcile.Result{}, nil
+	}
+	for i := range vms {
+		if err := r.deleteVM(ctx, &vms[i]); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	conditions.MarkFalse(ctx.ICSMachinePool, infrav1.ReplicasReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+	ctx.Logger.Info("Waiting for the VMs of the pool to be deleted", "count", len(vms))
+	return reconcile.Result{}, nil
+}
+
+func (r machinePoolReconciler) reconcileNormal(ctx *context.MachinePoolContext) (reconcile.Result, error) {
+	// If the ICSMachinePool doesn't have our finalizer, add it.
+	ctrlutil.AddFinalizer(ctx.ICSMachinePool, infrav1.MachinePoolFinalizer)
+
+	if !ctx.Cluster.Status.InfrastructureReady {
+		conditions.MarkFalse(ctx.ICSMachinePool, infrav1.ReplicasReadyCondition, infrav1.WaitingForClusterInfrastructureReason, clusterv1.ConditionSeverityInfo, "")
+		ctx.Logger.Info("Cluster infrastructure is not ready yet")
+		return reconcile.Result{}, nil
+	}
+
+	// Make sure bootstrap data is available and populated.
+	if ctx.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
+		conditions.MarkFalse(ctx.ICSMachinePool, infrav1.ReplicasReadyCondition, infrav1.WaitingForBootstrapDataReason, clusterv1.ConditionSeverityInfo, "")
+		ctx.Logger.Info("Waiting for bootstrap data to be available")
+		return reconcile.Result{}, nil
+	}
+
+	vms, err := r.getVMs(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	hash, err := templateHash(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Split the VMs in the ones created from the current template and
+	// bootstrap data and the outdated ones. The VMs being deleted are gone
+	// as far as the pool is concerned.
+	var current, outdated []infrav1.ICSVM
+	for _, vm := range vms {
+		if !vm.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if vm.Annotations[infrav1.MachinePoolTemplateHashAnnotation] == hash {
+			current = append(current, vm)
+		} else {
+			outdated = append(outdated, vm)
+		}
+	}
+
+	desired := int32(1)
+	if ctx.MachinePool.Spec.Replicas != nil {
+		desired = *ctx.MachinePool.Spec.Replicas
+	}
+	plan := planRollout(ctx.ICSMachinePool, desired, current, outdated)
+
+	current = plan.keepCurrent
+	for i := int32(0); i < plan.create; i++ {
+		vm, err := r.createVM(ctx, hash)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		current = append(current, *vm)
+	}
+	for i := range plan.deleteOutdated {
+		if err := r.deleteVM(ctx, &plan.deleteOutdated[i]); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	for i := range plan.deleteCurrent {
+		if err := r.deleteVM(ctx, &plan.deleteCurrent[i]); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	r.reconcileStatus(ctx, current, plan.keepOutdated, desired)
+	return reconcile.Result{}, nil
+}
+
+// reconcileStatus sets the status of the pool from its VMs that are not
+// being deleted.
+func (r machinePoolReconciler) reconcileStatus(ctx *context.MachinePoolContext, current, outdated []infrav1.ICSVM, desired int32) {
+	pool := ctx.ICSMachinePool
+	providerIDs := []string{}
+	instances := []infrav1.ICSMachinePoolInstanceStatus{}
+	addInstances := func(vms []infrav1.ICSVM, upToDate bool) {
+		for _, vm := range vms {
+			instance := infrav1.ICSMachinePoolInstanceStatus{
+				Name:     vm.Name,
+				Host:     vm.Status.Host,
+				Ready:    vm.Status.Ready,
+				UpToDate: upToDate,
+			}
+			if vm.Spec.BiosUUID != "" {
+				instance.ProviderID = infrautilv1.ConvertUUIDToProviderID(vm.Spec.BiosUUID)
+			}
+			if instance.Ready && instance.ProviderID != "" {
+				providerIDs = append(providerIDs, instance.ProviderID)
+			}
+			instances = append(instances, instance)
+		}
+	}
+	addInstances(current, true)
+	addInstances(outdated, false)
+	sort.Strings(providerIDs)
+	sort.Slice(instances, func(i, j int) bool { return instances[i].Name < instances[j].Name })
+
+	pool.Spec.ProviderIDList = providerIDs
+	pool.Status.Instances = instances
+	pool.Status.Replicas = int32(len(providerIDs))
+
+	readyCurrent := countReady(current)
+	switch {
+	case len(outdated) > 0:
+		conditions.MarkFalse(pool, infrav1.ReplicasReadyCondition, infrav1.RollingUpdateReason, clusterv1.ConditionSeverityInfo,
+			"%d of %d VMs are outdated", len(outdated), len(current)+len(outdated))
+	case readyCurrent != desired || int32(len(current)) != desired:
+		conditions.MarkFalse(pool, infrav1.ReplicasReadyCondition, infrav1.ScalingReason, clusterv1.ConditionSeverityInfo,
+			"%d of %d VMs are ready", readyCurrent, desired)
+	default:
+		conditions.MarkTrue(pool, infrav1.ReplicasReadyCondition)
+	}
+	pool.Status.Ready = conditions.IsTrue(pool, infrav1.ReplicasReadyCondition)
+}
+
+// getVMs returns the ICSVMs of the pool.
+func (r machinePoolReconciler) getVMs(ctx *context.MachinePoolContext) ([]infrav1.ICSVM, error) {
+	vmList := &infrav1.ICSVMList{}
+	if err := ctx.Client.List(ctx, vmList,
+		ctrlclient.InNamespace(ctx.ICSMachinePool.Namespace),
+		ctrlclient.MatchingLabels{infrav1.MachinePoolNameLabel: ctx.ICSMachinePool.Name}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list ICSVMs of %s", ctx)
+	}
+	vms := []infrav1.ICSVM{}
+	for _, vm := range vmList.Items {
+		if metav1.IsControlledBy(&vm, ctx.ICSMachinePool) {
+			vms = append(vms, vm)
+		}
+	}
+	return vms, nil
+}
+
+// createVM creates an ICSVM of the pool from its template, which is cloned
+// and powered on by the ICSVM controller like any machine.
+func (r machinePoolReconciler) createVM(ctx *context.MachinePoolContext, hash string) (*infrav1.ICSVM, error) {
+	pool := ctx.ICSMachinePool
+	vm := &infrav1.ICSVM{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    pool.Namespace,
+			GenerateName: pool.Name + "-",
+			Labels: map[string]string{
+				clusterv1.ClusterLabelName:   ctx.Cluster.Name,
+				infrav1.MachinePoolNameLabel: pool.Name,
+			},
+			Annotations: map[string]string{
+				infrav1.MachinePoolTemplateHashAnnotation: hash,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: infrav1.GroupVersion.String(),
+				Kind:       "ICSMachinePool",
+				Name:       pool.Name,
+				UID:        pool.UID,
+				Controller: pointer.Bool(true),
+			}},
+		},
+	}
+	vm.Spec.BootstrapRef = &corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Name:       *ctx.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName,
+		Namespace:  pool.Namespace,
+	}
+	pool.Spec.Template.DeepCopyInto(&vm.Spec.VirtualMachineCloneSpec)
+	services.SetClusterDefaults(&vm.Spec.VirtualMachineCloneSpec, ctx.ICSCluster, false)
+
+	if err := ctx.Client.Create(ctx, vm); err != nil {
+		conditions.MarkFalse(pool, infrav1.ReplicasReadyCondition, infrav1.CloningFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return nil, errors.Wrapf(err, "failed to create ICSVM for %s", ctx)
+	}
+	ctx.Logger.Info("created vm", "vm", ctrlclient.ObjectKeyFromObject(vm))
+	r.Recorder.Eventf(pool, "VMCreated", "Created VM %s", vm.Name)
+	return vm, nil
+}
+
+// deleteVM deletes an ICSVM of the pool unless it is already being deleted.
+func (r machinePoolReconciler) deleteVM(ctx *context.MachinePoolContext, vm *infrav1.ICSVM) error {
+	if !vm.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	if err := ctx.Client.Delete(ctx, vm); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete ICSVM %s/%s", vm.Namespace, vm.Name)
+	}
+	ctx.Logger.Info("deleted vm", "vm", ctrlclient.ObjectKeyFromObject(vm))
+	r.Recorder.Eventf(ctx.ICSMachinePool, "VMDeleted", "Deleted VM %s", vm.Name)
+	return nil
+}
+
+// templateHash returns the hash of what the VMs of the pool are created
+// from. VMs with another hash are replaced.
+func templateHash(ctx *context.MachinePoolContext) (string, error) {
+	data, err := json.Marshal(struct {
+		Template      infrav1.VirtualMachineCloneSpec `json:"template"`
+		Version       *string                         `json:"version,omitempty"`
+		BootstrapData string                          `json:"bootstrapData"`
+	}{
+		Template:      ctx.ICSMachinePool.Spec.Template,
+		Version:       ctx.MachinePool.Spec.Template.Spec.Version,
+		BootstrapData: *ctx.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to encode the template of %s", ctx)
+	}
+	hasher := fnv.New32a()
+	_, _ = hasher.Write(data)
+	return fmt.Sprintf("%x", hasher.Sum32()), nil
+}
+
+// rollout is what a reconcile changes to the VMs of a pool.
+type rollout struct {
+	// create is the number of up to date VMs to create.
+	create int32
+
+	// deleteOutdated are the outdated VMs to delete, keepOutdated the ones
+	// that remain.
+	deleteOutdated []infrav1.ICSVM
+	keepOutdated   []infrav1.ICSVM
+
+	// deleteCurrent are the up to date VMs above the desired replicas,
+	// keepCurrent the ones that remain.
+	deleteCurrent []infrav1.ICSVM
+	keepCurrent   []infrav1.ICSVM
+}
+
+// planRollout returns the VMs to create and delete to bring the pool to the
+// desired replicas of the current template. Up to date VMs are created,
+// surging above the desired replicas while outdated VMs remain. Outdated VMs
+// are deleted as long as enough VMs remain ready, the ones that are not
+// ready first. The VMs that are created are not ready yet.
+func planRollout(pool *infrav1.ICSMachinePool, desired int32, current, outdated []infrav1.ICSVM) rollout {
+	maxSurge, maxUnavailable := rollingUpdateLimits(pool)
+	policy := pool.Spec.ScaleDownPolicy
+
+	plan := rollout{keepCurrent: current}
+	maxTotal := desired
+	if len(outdated) > 0 {
+		maxTotal += maxSurge
+	}
+	plan.create = desired - int32(len(current))
+	if room := maxTotal - int32(len(current)+len(outdated)); plan.create > room {
+		plan.create = room
+	}
+	if plan.create < 0 {
+		plan.create = 0
+	}
+
+	ready := countReady(current) + countReady(outdated)
+	minReady := desired - maxUnavailable
+	plan.keepOutdated = sortVictims(outdated, policy)
+	for len(plan.keepOutdated) > 0 {
+		vm := plan.keepOutdated[0]
+		if vm.Status.Ready {
+			if ready-1 < minReady {
+				break
+			}
+			ready--
+		}
+		plan.deleteOutdated = append(plan.deleteOutdated, vm)
+		plan.keepOutdated = plan.keepOutdated[1:]
+	}
+
+	if excess := int32(len(current)) - desired; excess > 0 {
+		sorted := sortVictims(current, policy)
+		plan.deleteCurrent, plan.keepCurrent = sorted[:excess], sorted[excess:]
+	}
+	return plan
+}
+
+// rollingUpdateLimits returns the max surge and max unavailable VMs of the
+// pool.
+func rollingUpdateLimits(pool *infrav1.ICSMachinePool) (int32, int32) {
+	maxSurge, maxUnavailable := int32(1), int32(0)
+	if rollingUpdate := pool.Spec.RollingUpdate; rollingUpdate != nil {
+		if rollingUpdate.MaxSurge != nil {
+			maxSurge = *rollingUpdate.MaxSurge
+		}
+		if rollingUpdate.MaxUnavailable != nil {
+			maxUnavailable = *rollingUpdate.MaxUnavailable
+		}
+	}
+	return maxSurge, maxUnavailable
+}
+
+// countReady returns the number of ready VMs.
+func countReady(vms []infrav1.ICSVM) int32 {
+	count := int32(0)
+	for _, vm := range vms {
+		if vm.Status.Ready {
+			count++
+		}
+	}
+	return count
+}
+
+// sortVictims returns the VMs in the order they are deleted in: the VMs
+// that are not ready first, then following the scale down policy.
+func sortVictims(vms []infrav1.ICSVM, policy infrav1.MachinePoolScaleDownPolicy) []infrav1.ICSVM {
+	perHost := map[string]int{}
+	for _, vm := range vms {
+		perHost[vm.Status.Host]++
+	}
+	sorted := append([]infrav1.ICSVM{}, vms...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Status.Ready != b.Status.Ready {
+			return !a.Status.Ready
+		}
+		switch policy {
+		case infrav1.ScaleDownNewestFirst:
+			return b.CreationTimestamp.Before(&a.CreationTimestamp)
+		case infrav1.ScaleDownPlacement:
+			if perHost[a.Status.Host] != perHost[b.Status.Host] {
+				return perHost[a.Status.Host] > perHost[b.Status.Host]
+			}
+		}
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	})
+	return sorted
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+// newPoolVM returns a VM of a pool created the given number of minutes after
+// a fixed time.
+func newPoolVM(name string, minute int, host string, ready bool) infrav1.ICSVM {
+	created := time.Date(2024, 1, 1, 0, minute, 0, 0, time.UTC)
+	return infrav1.ICSVM{
+		ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+		Status:     infrav1.ICSVMStatus{Host: host, Ready: ready},
+	}
+}
+
+func poolVMNames(vms []infrav1.ICSVM) []string {
+	names := []string{}
+	for _, vm := range vms {
+		names = append(names, vm.Name)
+	}
+	return names
+}
+
+func TestRollingUpdateLimits(t *testing.T) {
+	testCases := []struct {
+		name               string
+		rollingUpdate      *infrav1.MachinePoolRollingUpdate
+		wantMaxSurge       int32
+		wantMaxUnavailable int32
+	}{
+		{
+			name:         "no rolling update",
+			wantMaxSurge: 1,
+		},
+		{
+			name:          "empty rolling update",
+			rollingUpdate: &infrav1.MachinePoolRollingUpdate{},
+			wantMaxSurge:  1,
+		},
+		{
+			name:               "max unavailable only",
+			rollingUpdate:      &infrav1.MachinePoolRollingUpdate{MaxUnavailable: pointer.Int32(2)},
+			wantMaxSurge:       1,
+			wantMaxUnavailable: 2,
+		},
+		{
+			name:               "both limits",
+			rollingUpdate:      &infrav1.MachinePoolRollingUpdate{MaxSurge: pointer.Int32(0), MaxUnavailable: pointer.Int32(1)},
+			wantMaxUnavailable: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := &infrav1.ICSMachinePool{Spec: infrav1.ICSMachinePoolSpec{RollingUpdate: tc.rollingUpdate}}
+			maxSurge, maxUnavailable := rollingUpdateLimits(pool)
+			if maxSurge != tc.wantMaxSurge || maxUnavailable != tc.wantMaxUnavailable {
+				t.Errorf("got %d, %d, want %d, %d", maxSurge, maxUnavailable, tc.wantMaxSurge, tc.wantMaxUnavailable)
+			}
+		})
+	}
+}
+
+func TestSortVictims(t *testing.T) {
+	vms := []infrav1.ICSVM{
+		newPoolVM("a", 1, "host-1", true),
+		newPoolVM("b", 2, "host-2", true),
+		newPoolVM("c", 3, "host-2", true),
+		newPoolVM("d", 4, "host-1", false),
+		newPoolVM("e", 5, "host-2", true),
+	}
+
+	testCases := []struct {
+		policy infrav1.MachinePoolScaleDownPolicy
+		want   []string
+	}{
+		{policy: "", want: []string{"d", "a", "b", "c", "e"}},
+		{policy: infrav1.ScaleDownOldestFirst, want: []string{"d", "a", "b", "c", "e"}},
+		{policy: infrav1.ScaleDownNewestFirst, want: []string{"d", "e", "c", "b", "a"}},
+		{policy: infrav1.ScaleDownPlacement, want: []string{"d", "b", "c", "e", "a"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(string(tc.policy), func(t *testing.T) {
+			got := poolVMNames(sortVictims(vms, tc.policy))
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+	if got := poolVMNames(vms); !reflect.DeepEqual(got, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("the VMs were reordered in place: %v", got)
+	}
+}
+
+func TestPlanRollout(t *testing.T) {
+	testCases := []struct {
+		name               string
+		rollingUpdate      *infrav1.MachinePoolRollingUpdate
+		desired            int32
+		current            []infrav1.ICSVM
+		outdated           []infrav1.ICSVM
+		wantCreate         int32
+		wantDeleteOutdated []string
+		wantDeleteCurrent  []string
+	}{
+		{
+			name:       "scale up",
+			desired:    3,
+			current:    []infrav1.ICSVM{newPoolVM("a", 1, "", true)},
+			wantCreate: 2,
+		},
+		{
+			name:              "scale down",
+			desired:           1,
+			current:           []infrav1.ICSVM{newPoolVM("a", 1, "", true), newPoolVM("b", 2, "", false), newPoolVM("c", 3, "", true)},
+			wantDeleteCurrent: []string{"b", "a"},
+		},
+		{
+			name:       "rollout starts with a surge",
+			desired:    2,
+			outdated:   []infrav1.ICSVM{newPoolVM("a", 1, "", true), newPoolVM("b", 2, "", true)},
+			wantCreate: 1,
+		},
+		{
+			name:               "rollout replaces an outdated VM once the new one is ready",
+			desired:            2,
+			current:            []infrav1.ICSVM{newPoolVM("c", 3, "", true)},
+			outdated:           []infrav1.ICSVM{newPoolVM("a", 1, "", true), newPoolVM("b", 2, "", true)},
+			wantDeleteOutdated: []string{"a"},
+		},
+		{
+			name:     "rollout waits for the new VM to be ready",
+			desired:  2,
+			current:  []infrav1.ICSVM{newPoolVM("c", 3, "", false)},
+			outdated: []infrav1.ICSVM{newPoolVM("a", 1, "", true), newPoolVM("b", 2, "", true)},
+		},
+		{
+			name:               "outdated VMs that are not ready are deleted first",
+			desired:            2,
+			current:            []infrav1.ICSVM{newPoolVM("c", 3, "", false)},
+			outdated:           []infrav1.ICSVM{newPoolVM("a", 1, "", true), newPoolVM("b", 2, "", false)},
+			wantDeleteOutdated: []string{"b"},
+		},
+		{
+			name:               "rollout without surge",
+			rollingUpdate:      &infrav1.MachinePoolRollingUpdate{MaxSurge: pointer.Int32(0), MaxUnavailable: pointer.Int32(1)},
+			desired:            2,
+			outdated:           []infrav1.ICSVM{newPoolVM("a", 1, "", true), newPoolVM("b", 2, "", true)},
+			wantDeleteOutdated: []string{"a"},
+		},
+		{
+			name:               "rollout with a larger surge",
+			rollingUpdate:      &infrav1.MachinePoolRollingUpdate{MaxSurge: pointer.Int32(3), MaxUnavailable: pointer.Int32(1)},
+			desired:            3,
+			outdated:           []infrav1.ICSVM{newPoolVM("a", 1, "", true), newPoolVM("b", 2, "", true), newPoolVM("c", 3, "", true)},
+			wantCreate:         3,
+			wantDeleteOutdated: []string{"a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := &infrav1.ICSMachinePool{Spec: infrav1.ICSMachinePoolSpec{RollingUpdate: tc.rollingUpdate}}
+			plan := planRollout(pool, tc.desired, tc.current, tc.outdated)
+			if plan.create != tc.wantCreate {
+				t.Errorf("got %d VMs to create, want %d", plan.create, tc.wantCreate)
+			}
+			if got := poolVMNames(plan.deleteOutdated); !reflect.DeepEqual(got, append([]string{}, tc.wantDeleteOutdated...)) {
+				t.Errorf("got outdated VMs to delete %v, want %v", got, tc.wantDeleteOutdated)
+			}
+			if got := poolVMNames(plan.deleteCurrent); !reflect.DeepEqual(got, append([]string{}, tc.wantDeleteCurrent...)) {
+				t.Errorf("got up to date VMs to delete %v, want %v", got, tc.wantDeleteCurrent)
+			}
+			if kept := len(plan.keepOutdated) + len(plan.deleteOutdated); kept != len(tc.outdated) {
+				t.Errorf("got %d outdated VMs, want %d", kept, len(tc.outdated))
+			}
+			if kept := len(plan.keepCurrent) + len(plan.deleteCurrent); kept != len(tc.current) {
+				t.Errorf("got %d up to date VMs, want %d", kept, len(tc.current))
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	goctx "context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	clusterutilv1 "sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/identity"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/record"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/template"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
+	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
+)
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsmachinetemplates,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsmachinetemplates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsvmimages,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// AddMachineTemplateControllerToManager adds the machine template controller
+// to the provided manager.
+func AddMachineTemplateControllerToManager(ctx *context.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType      = &infrav1.ICSMachineTemplate{}
+		controlledTypeName  = reflect.TypeOf(controlledType).Elem().Name()
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	// Build the controller context.
+	controllerContext := &context.ControllerContext{
+		ControllerManagerContext: ctx,
+		Name:                     controllerNameShort,
+		Recorder:                 record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		Logger:                   ctx.Logger.WithName(controllerNameShort),
+	}
+	r := machineTemplateReconciler{ControllerContext: controllerContext}
+	_, err := ctrl.NewControllerManagedBy(mgr).
+		// Watch the controlled, infrastructure resource.
+		For(controlledType).
+		WithOptions(controller.Options{MaxConcurrentReconciles: ctx.MaxConcurrentReconciles}).
+		Build(r)
+	return err
+}
+
+type machineTemplateReconciler struct {
+	*context.ControllerContext
+}
+
+// Reconcile publishes the capacity of the machines created from the template
+// in its status, which lets the cluster autoscaler scale their node groups
+// up from zero.
+func (r machineTemplateReconciler) Reconcile(ctx goctx.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	// Get the ICSMachineTemplate resource for this request.
+	icsMachineTemplate := &infrav1.ICSMachineTemplate{}
+	if err := r.Client.Get(r, req.NamespacedName, icsMachineTemplate); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Logger.Info("ICSMachineTemplate not found, won't reconcile", "key", req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if !icsMachineTemplate.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+	if annotations.HasPaused(icsMachineTemplate) {
+		r.Logger.V(4).Info("ICSMachineTemplate is paused", "key", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	// Create the patch helper.
+	patchHelper, err := patch.NewHelper(icsMachineTemplate, r.Client)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(
+			err,
+			"failed to init patch helper for %s %s/%s",
+			icsMachineTemplate.GroupVersionKind(),
+			icsMachineTemplate.Namespace,
+			icsMachineTemplate.Name)
+	}
+
+	// Create the machine template context for this request.
+	templateContext := &context.MachineTemplateContext{
+		ControllerContext:  r.ControllerContext,
+		ICSMachineTemplate: icsMachineTemplate,
+		Logger:             r.Logger.WithName(req.Namespace).WithName(req.Name),
+		PatchHelper:        patchHelper,
+	}
+
+	// Always issue a patch when exiting this function so changes to the
+	// resource are patched back to the API server.
+	defer func() {
+		if err := templateContext.Patch(); err != nil {
+			if !infrautilv1.IsNotFoundError(err) {
+				if reterr == nil {
+					reterr = err
+				}
+				templateContext.Logger.Error(err, "patch failed", "machinetemplate", templateContext.String())
+			}
+		}
+	}()
+
+	return reconcile.Result{}, r.reconcileCapacity(templateContext)
+}
+
+// reconcileCapacity sets the capacity and node info of the template. The
+// properties the template leaves to the source of the clone are read from
+// the template or OVA image in ICS.
+func (r machineTemplateReconciler) reconcileCapacity(ctx *context.MachineTemplateContext) error {
+	spec := &ctx.ICSMachineTemplate.Spec.Template.Spec
+	status := &ctx.ICSMachineTemplate.Status
+
+	capacity := corev1.ResourceList{}
+	if spec.NumCPUs > 0 {
+		capacity[corev1.ResourceCPU] = *resource.NewQuantity(int64(spec.NumCPUs), resource.DecimalSI)
+	}
+	if spec.MemoryMiB > 0 {
+		capacity[corev1.ResourceMemory] = *resource.NewQuantity(spec.MemoryMiB*1024*1024, resource.BinarySI)
+	}
+	if len(spec.Disks) > 0 && spec.Disks[0].DiskSize > 0 {
+		capacity[corev1.ResourceEphemeralStorage] = *resource.NewQuantity(int64(spec.Disks[0].DiskSize)*1024*1024*1024, resource.BinarySI)
+	}
+	status.Capacity = capacity
+
+	// Templates are immutable, the source has to be read only once.
+	if len(capacity) == 3 && status.NodeInfo != nil {
+		return nil
+	}
+
+	icsSession, err := r.reconcileICenterConnectivity(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get session for %s", ctx)
+	}
+	ctx.Session = icsSession
+	cloneSpec := &spec.VirtualMachineCloneSpec
+	if cloneSpec.Template == "" && cloneSpec.ImageRef != nil {
+		image, err := services.GetVMImage(ctx, r.Client, ctx.ICSMachineTemplate.Namespace, cloneSpec)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get ICSVMImage %s for %s", cloneSpec.ImageRef.Name, ctx)
+		}
+		if !image.Status.Ready {
+			return errors.Errorf("ICSVMImage %s of %s is not ready", image.Name, ctx)
+		}
+		cloneSpec = cloneSpec.DeepCopy()
+		services.SetVMImageSource(cloneSpec, image)
+	}
+	source, err := template.FindSourceVM(ctx, cloneSpec)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the source of %s", ctx)
+	}
+
+	if _, ok := capacity[corev1.ResourceCPU]; !ok && source.CPUNum > 0 {
+		capacity[corev1.ResourceCPU] = *resource.NewQuantity(int64(source.CPUNum), resource.DecimalSI)
+	}
+	if _, ok := capacity[corev1.ResourceMemory]; !ok && source.Memory > 0 {
+		capacity[corev1.ResourceMemory] = *resource.NewQuantity(int64(source.Memory)*1024*1024, resource.BinarySI)
+	}
+	if _, ok := capacity[corev1.ResourceEphemeralStorage]; !ok && len(source.Disks) > 0 {
+		if size := diskSizeInByte(source.Disks[0]); size > 0 {
+			capacity[corev1.ResourceEphemeralStorage] = *resource.NewQuantity(size, resource.BinarySI)
+		}
+	}
+	status.NodeInfo = &infrav1.NodeInfo{
+		Architecture:    nodeArchitecture(source.CpuArchType),
+		OperatingSystem: nodeOperatingSystem(source.GuestosType),
+	}
+	ctx.Logger.V(4).Info("reconciled capacity", "capacity", capacity, "nodeInfo", status.NodeInfo)
+	return nil
+}
+
+// reconcileICenterConnectivity returns a session for the cloud of the
+// template. Templates leaving the cloud or identity empty are connected like
+// the cluster they belong to.
+func (r machineTemplateReconciler) reconcileICenterConnectivity(ctx *context.MachineTemplateContext) (*session.Session, error) {
+	cloudName := ctx.ICSMachineTemplate.Spec.Template.Spec.CloudName
+	identityRef := ctx.ICSMachineTemplate.Spec.Template.Spec.IdentityRef
+	if cloudName == "" || identityRef == nil {
+		icsCluster, err := r.getICSCluster(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if cloudName == "" {
+			cloudName = icsCluster.Spec.CloudName
+		}
+		if identityRef == nil {
+			identityRef = icsCluster.Spec.IdentityRef
+		}
+	}
+
+	iCenter, err := identity.NewClientFromMachine(ctx, r.Client, ctx.ICSMachineTemplate.Namespace, cloudName, identityRef)
+	if err != nil {
+		if infrautilv1.IsNotFoundError(err) {
+			return session.Get(ctx, cloudName)
+		}
+		return nil, err
+	}
+	if iCenter.AuthInfo == nil {
+		return session.Get(ctx, cloudName)
+	}
+
+	params := session.NewParams().
+		WithCloudName(cloudName).
+		WithServer(iCenter.ICenterURL).
+		WithUserInfo(iCenter.AuthInfo.Username, iCenter.AuthInfo.Password).
+		WithAPIVersion(iCenter.APIVersion).
+		WithFeatures(session.Feature{
+			KeepAliveDuration: r.KeepAliveDuration,
+		})
+	return session.GetOrCreate(ctx, params)
+}
+
+// getICSCluster returns the ICSCluster of the cluster the template is
+// labeled with or owned by.
+func (r machineTemplateReconciler) getICSCluster(ctx *context.MachineTemplateContext) (*infrav1.ICSCluster, error) {
+	var (
+		cluster *clusterv1.Cluster
+		err     error
+	)
+	if _, ok := ctx.ICSMachineTemplate.Labels[clusterv1.ClusterLabelName]; ok {
+		cluster, err = clusterutilv1.GetClusterFromMetadata(ctx, r.Client, ctx.ICSMachineTemplate.ObjectMeta)
+	} else {
+		cluster, err = clusterutilv1.GetOwnerCluster(ctx, r.Client, ctx.ICSMachineTemplate.ObjectMeta)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil || cluster.Spec.InfrastructureRef == nil {
+		return nil, errors.New("the template has no cloud and belongs to no cluster")
+	}
+	icsCluster := &infrav1.ICSCluster{}
+	icsClusterKey := ctrlclient.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.InfrastructureRef.Name}
+	if err := r.Client.Get(ctx, icsClusterKey, icsCluster); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ICSCluster %s", icsClusterKey)
+	}
+	return icsCluster, nil
+}
+
+// diskSizeInByte returns the size of a disk of the source of a clone.
+func diskSizeInByte(disk basetypv1.Disk) int64 {
+	if disk.Volume.SizeInByte > 0 {
+		return int64(disk.Volume.SizeInByte)
+	}
+	return int64(disk.Volume.Size * 1024 * 1024 * 1024)
+}
+
+// nodeArchitecture returns the kubernetes.io/arch label value of the CPU
+// architecture reported by ICS. Sources without one are x86 ones.
+func nodeArchitecture(cpuArchType string) string {
+	arch := strings.ToLower(cpuArchType)
+	switch {
+	case arch == "", strings.Contains(arch, "x86"), strings.Contains(arch, "amd64"):
+		return "amd64"
+	case strings.Contains(arch, "arm"), strings.Contains(arch, "aarch64"):
+		return "arm64"
+	}
+	return arch
+}
+
+// nodeOperatingSystem returns the kubernetes.io/os label value of the guest
+// OS type reported by ICS. Sources without one are Linux ones.
+func nodeOperatingSystem(guestOSType string) string {
+	if strings.Contains(strings.ToLower(guestOSType), "windows") {
+		return "windows"
+	}
+	return "linux"
+}
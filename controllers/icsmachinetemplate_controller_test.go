@@ -0,0 +1,181 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	goctx "context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+)
+
+func newMachineTemplateContext(spec infrav1.ICSMachineSpec, nodeInfo *infrav1.NodeInfo) (machineTemplateReconciler, *context.MachineTemplateContext) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+	controllerContext := &context.ControllerContext{
+		ControllerManagerContext: &context.ControllerManagerContext{
+			Context: goctx.Background(),
+			Client:  fake.NewClientBuilder().WithScheme(scheme).Build(),
+			Logger:  log.Log,
+		},
+		Logger: log.Log,
+	}
+	icsMachineTemplate := &infrav1.ICSMachineTemplate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "workers"},
+		Spec: infrav1.ICSMachineTemplateSpec{
+			Template: infrav1.ICSMachineTemplateResource{Spec: spec},
+		},
+		Status: infrav1.ICSMachineTemplateStatus{NodeInfo: nodeInfo},
+	}
+	return machineTemplateReconciler{ControllerContext: controllerContext}, &context.MachineTemplateContext{
+		ControllerContext:  controllerContext,
+		ICSMachineTemplate: icsMachineTemplate,
+		Logger:             log.Log,
+	}
+}
+
+func TestReconcileCapacity(t *testing.T) {
+	fullSpec := infrav1.ICSMachineSpec{
+		VirtualMachineCloneSpec: infrav1.VirtualMachineCloneSpec{
+			NumCPUs:   4,
+			MemoryMiB: 8192,
+			Disks:     []infrav1.DiskSpec{{DiskSize: 40}, {DiskSize: 100}},
+		},
+	}
+	expected := corev1.ResourceList{
+		corev1.ResourceCPU:              resource.MustParse("4"),
+		corev1.ResourceMemory:           resource.MustParse("8Gi"),
+		corev1.ResourceEphemeralStorage: resource.MustParse("40Gi"),
+	}
+
+	t.Run("spec with the full capacity", func(t *testing.T) {
+		nodeInfo := &infrav1.NodeInfo{Architecture: "amd64", OperatingSystem: "linux"}
+		r, ctx := newMachineTemplateContext(fullSpec, nodeInfo)
+		if err := r.reconcileCapacity(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertCapacity(t, ctx.ICSMachineTemplate.Status.Capacity, expected)
+		if ctx.Session != nil {
+			t.Error("expected the source not to be read again")
+		}
+	})
+
+	t.Run("spec leaving the memory to the source", func(t *testing.T) {
+		spec := *fullSpec.DeepCopy()
+		spec.MemoryMiB = 0
+		r, ctx := newMachineTemplateContext(spec, &infrav1.NodeInfo{})
+		// The template belongs to no cluster, so the source cannot be read.
+		if err := r.reconcileCapacity(ctx); err == nil {
+			t.Fatal("expected an error reading the source")
+		}
+		assertCapacity(t, ctx.ICSMachineTemplate.Status.Capacity, corev1.ResourceList{
+			corev1.ResourceCPU:              expected[corev1.ResourceCPU],
+			corev1.ResourceEphemeralStorage: expected[corev1.ResourceEphemeralStorage],
+		})
+	})
+
+	t.Run("node info not read yet", func(t *testing.T) {
+		r, ctx := newMachineTemplateContext(fullSpec, nil)
+		if err := r.reconcileCapacity(ctx); err == nil {
+			t.Fatal("expected an error reading the source")
+		}
+		assertCapacity(t, ctx.ICSMachineTemplate.Status.Capacity, expected)
+	})
+}
+
+func assertCapacity(t *testing.T, actual, expected corev1.ResourceList) {
+	t.Helper()
+	if len(actual) != len(expected) {
+		t.Errorf("got capacity %v, want %v", actual, expected)
+		return
+	}
+	for name, quantity := range expected {
+		if got, ok := actual[name]; !ok || got.Cmp(quantity) != 0 {
+			t.Errorf("got %s %s, want %s", name, got.String(), quantity.String())
+		}
+	}
+}
+
+func TestDiskSizeInByte(t *testing.T) {
+	testCases := []struct {
+		name     string
+		volume   basetypv1.Volume
+		expected int64
+	}{
+		{
+			name:     "size in byte",
+			volume:   basetypv1.Volume{Size: 1, SizeInByte: 1536 * 1024 * 1024},
+			expected: 1536 * 1024 * 1024,
+		},
+		{
+			name:     "size in GiB",
+			volume:   basetypv1.Volume{Size: 40},
+			expected: 40 * 1024 * 1024 * 1024,
+		},
+		{
+			name: "no size",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := diskSizeInByte(basetypv1.Disk{Volume: tc.volume}); actual != tc.expected {
+				t.Errorf("got %d, want %d", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNodeInfoLabels(t *testing.T) {
+	architectures := map[string]string{
+		"":        "amd64",
+		"X86_64":  "amd64",
+		"amd64":   "amd64",
+		"ARM":     "arm64",
+		"aarch64": "arm64",
+		"MIPS64":  "mips64",
+	}
+	for cpuArchType, expected := range architectures {
+		if actual := nodeArchitecture(cpuArchType); actual != expected {
+			t.Errorf("got architecture %q for %q, want %q", actual, cpuArchType, expected)
+		}
+	}
+
+	operatingSystems := map[string]string{
+		"":           "linux",
+		"CentOS_7":   "linux",
+		"Windows_10": "windows",
+	}
+	for guestOSType, expected := range operatingSystems {
+		if actual := nodeOperatingSystem(guestOSType); actual != expected {
+			t.Errorf("got operating system %q for %q, want %q", actual, guestOSType, expected)
+		}
+	}
+}
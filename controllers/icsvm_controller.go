@@ -57,6 +57,7 @@ import (
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsvms,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsvms/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsvmimages,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments;machinesets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=kubeadmcontrolplanes,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
@@ -118,6 +119,23 @@ func AddVMControllerToManager(ctx *context.ControllerManagerContext, mgr manager
 		return err
 	}
 
+	err = controller.Watch(
+		&source.Kind{Type: &infrav1.ICSVMImage{}},
+		handler.EnqueueRequestsFromMapFunc(r.getICSVMImageToICSVMsReq),
+		predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldImage := e.ObjectOld.(*infrav1.ICSVMImage)
+				newImage := e.ObjectNew.(*infrav1.ICSVMImage)
+				return !oldImage.Status.Ready && newImage.Status.Ready
+			},
+			CreateFunc:  func(e event.CreateEvent) bool { return false },
+			DeleteFunc:  func(e event.DeleteEvent) bool { return false },
+			GenericFunc: func(e event.GenericEvent) bool { return false },
+		})
+	if err != nil {
+		return err
+	}
+
 	err = controller.Watch(
 		&source.Kind{Type: &infrav1.ICSCluster{}},
 		handler.EnqueueRequestsFromMapFunc(r.getICSClusterToICSVMsReq),
@@ -170,30 +188,37 @@ func (r vmReconciler) Reconcile(ctx goctx.Context, req ctrl.Request) (_ ctrl.Res
 	// moves the resources without ownerreferences set
 	// in that case nil icsMachine can cause panic and CrashLoopBackOff the pod
 	// preventing icsmachine_controller from setting the ownerref
+	//
+	// The VMs of load balancers and machine pools are owned by an
+	// ICSHAProxyLoadBalancer or ICSMachinePool instead and are not part of
+	// a cluster module.
+	var clusterModule *string
 	if err != nil || icsMachine == nil {
-		r.Logger.Info("Owner ICSMachine not found, won't reconcile", "key", req.NamespacedName)
-		return reconcile.Result{}, nil
-	}
-
-	icsCluster, err := infrautilv1.GetICSClusterFromICSMachine(r, r.Client, icsMachine)
-	if err != nil || icsCluster == nil {
-		r.Logger.Info("ICSCluster not found, won't reconcile", "key", ctrlclient.ObjectKeyFromObject(icsMachine))
-		return reconcile.Result{}, nil
-	}
+		if !infrautilv1.IsLoadBalancerVM(icsVM) && !infrautilv1.IsMachinePoolVM(icsVM) {
+			r.Logger.Info("Owner ICSMachine not found, won't reconcile", "key", req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+	} else {
+		icsCluster, err := infrautilv1.GetICSClusterFromICSMachine(r, r.Client, icsMachine)
+		if err != nil || icsCluster == nil {
+			r.Logger.Info("ICSCluster not found, won't reconcile", "key", ctrlclient.ObjectKeyFromObject(icsMachine))
+			return reconcile.Result{}, nil
+		}
 
-	// Fetch the CAPI Machine.
-	machine, err := clusterutilv1.GetOwnerMachine(r, r.Client, icsMachine.ObjectMeta)
-	if err != nil {
-		return reconcile.Result{}, err
-	}
-	if machine == nil {
-		r.Logger.Info("Waiting for OwnerRef to be set on ICSMachine", "key", icsMachine.Name)
-		return reconcile.Result{}, nil
-	}
+		// Fetch the CAPI Machine.
+		machine, err := clusterutilv1.GetOwnerMachine(r, r.Client, icsMachine.ObjectMeta)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if machine == nil {
+			r.Logger.Info("Waiting for OwnerRef to be set on ICSMachine", "key", icsMachine.Name)
+			return reconcile.Result{}, nil
+		}
 
-	clusterModule, err := r.fetchClusterModuleInfo(icsCluster, machine)
-	if err != nil {
-		return reconcile.Result{}, err
+		clusterModule, err = r.fetchClusterModuleInfo(icsCluster, machine)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
 	}
 
 	// Create the VM context for this request.
@@ -223,6 +248,7 @@ func (r vmReconciler) Reconcile(ctx goctx.Context, req ctrl.Request) (_ ctrl.Res
 		conditions.SetSummary(vmContext.ICSVM,
 			conditions.WithConditions(
 				infrav1.VMProvisionedCondition,
+				infrav1.VMAdoptedCondition,
 				infrav1.ICenterAvailableCondition,
 			),
 		)
@@ -318,7 +344,9 @@ func (r vmReconciler) reconcileNormal(ctx *context.VMContext, icsMachine *infrav
 	ctrlutil.AddFinalizer(ctx.ICSVM, infrav1.VMFinalizer)
 
 	if err := r.reconcileIdentitySecret(ctx); err != nil {
-		conditions.MarkFalse(icsMachine, infrav1.ICenterAvailableCondition, infrav1.ICenterUnreachableReason, clusterv1.ConditionSeverityError, err.Error())
+		if icsMachine != nil {
+			conditions.MarkFalse(icsMachine, infrav1.ICenterAvailableCondition, infrav1.ICenterUnreachableReason, clusterv1.ConditionSeverityError, err.Error())
+		}
 		return reconcile.Result{}, err
 	}
 
@@ -334,6 +362,10 @@ func (r vmReconciler) reconcileNormal(ctx *context.VMContext, icsMachine *infrav
 	// Implement selection of VM service based on ICS version
 	var vmService services.VirtualMachineService = &basev1.VMService{}
 
+	if ok, err := r.reconcileVMImage(ctx); !ok {
+		return reconcile.Result{}, err
+	}
+
 	if r.isWaitingForStaticIPAllocation(ctx) {
 		conditions.MarkFalse(ctx.ICSVM, infrav1.VMProvisionedCondition, infrav1.WaitingForStaticIPAllocationReason, clusterv1.ConditionSeverityInfo, "")
 		ctx.Logger.Info("vm is waiting for static ip to be available")
@@ -464,6 +496,55 @@ func (r vmReconciler) getICSClusterToICSVMsReq(a ctrlclient.Object) []reconcile.
 	return requests
 }
 
+// getICSVMImageToICSVMsReq returns the requests of the ICSVMs waiting for
+// the image to be ready.
+func (r vmReconciler) getICSVMImageToICSVMsReq(a ctrlclient.Object) []reconcile.Request {
+	requests := []reconcile.Request{}
+	vms := &infrav1.ICSVMList{}
+	if err := r.Client.List(goctx.Background(), vms, ctrlclient.InNamespace(a.GetNamespace())); err != nil {
+		return requests
+	}
+	for _, vm := range vms.Items {
+		if vm.Spec.Template != "" || vm.Spec.ImageRef == nil || vm.Spec.ImageRef.Name != a.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: apitypes.NamespacedName{
+				Name:      vm.Name,
+				Namespace: vm.Namespace,
+			},
+		})
+	}
+	return requests
+}
+
+// reconcileVMImage sets the template of an ICSVM created from an image once
+// the image is ready. It returns false while the VM waits for the image.
+func (r vmReconciler) reconcileVMImage(ctx *context.VMContext) (bool, error) {
+	spec := &ctx.ICSVM.Spec.VirtualMachineCloneSpec
+	if spec.Template != "" || spec.ImageRef == nil {
+		return true, nil
+	}
+	image, err := services.GetVMImage(ctx, r.Client, ctx.ICSVM.Namespace, spec)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(ctx.ICSVM, infrav1.VMProvisionedCondition, infrav1.WaitingForVMImageReason, clusterv1.ConditionSeverityInfo,
+				"ICSVMImage %s not found", spec.ImageRef.Name)
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get ICSVMImage %s for %s", spec.ImageRef.Name, ctx)
+	}
+	if !image.Status.Ready {
+		conditions.MarkFalse(ctx.ICSVM, infrav1.VMProvisionedCondition, infrav1.WaitingForVMImageReason, clusterv1.ConditionSeverityInfo,
+			"ICSVMImage %s is not ready", image.Name)
+		ctx.Logger.Info("vm is waiting for its image to be ready", "image", image.Name)
+		return false, nil
+	}
+	services.SetVMImageSource(spec, image)
+	ctx.Logger.Info("resolved the source of the vm from its image", "image", image.Name, "template", spec.Template, "clone-mode", spec.CloneMode)
+	return true, nil
+}
+
 func (r vmReconciler) reconcileIdentitySecret(ctx *context.VMContext) error {
 	icsVM := ctx.ICSVM
 	if identity.IsMachineSecretIdentity(icsVM.Spec.IdentityRef) {
@@ -0,0 +1,469 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	goctx "context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+	basetkv1 "github.com/ics-sigs/ics-go-sdk/task"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/identity"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/record"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/image"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/template"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
+	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
+)
+
+const (
+	// vmImageRequeueAfter is how often the downloads and imports of images
+	// are polled.
+	vmImageRequeueAfter = 30 * time.Second
+
+	// vmImageDownloadTimeout is how long a download is waited for before it
+	// is requested again.
+	vmImageDownloadTimeout = 2 * time.Hour
+)
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsvmimages,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsvmimages/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+
+// AddVMImageControllerToManager adds the VM image controller to the provided
+// manager.
+func AddVMImageControllerToManager(ctx *context.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType      = &infrav1.ICSVMImage{}
+		controlledTypeName  = reflect.TypeOf(controlledType).Elem().Name()
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	// Build the controller context.
+	controllerContext := &context.ControllerContext{
+		ControllerManagerContext: ctx,
+		Name:                     controllerNameShort,
+		Recorder:                 record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		Logger:                   ctx.Logger.WithName(controllerNameShort),
+	}
+	r := vmImageReconciler{ControllerContext: controllerContext}
+	_, err := ctrl.NewControllerManagedBy(mgr).
+		// Watch the controlled, infrastructure resource.
+		For(controlledType).
+		WithOptions(controller.Options{MaxConcurrentReconciles: ctx.MaxConcurrentReconciles}).
+		Build(r)
+	return err
+}
+
+type vmImageReconciler struct {
+	*context.ControllerContext
+}
+
+// Reconcile registers the OVA image of an ICSVMImage on its image datastores
+// and converts it into a template. The images and templates are left in
+// iCenter when the ICSVMImage is deleted, as VMs may still use them, so the
+// ICSVMImage has no finalizer and nothing is done on its deletion.
+func (r vmImageReconciler) Reconcile(ctx goctx.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	// Get the ICSVMImage resource for this request.
+	icsVMImage := &infrav1.ICSVMImage{}
+	if err := r.Client.Get(r, req.NamespacedName, icsVMImage); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Logger.Info("ICSVMImage not found, won't reconcile", "key", req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if !icsVMImage.DeletionTimestamp.IsZero() {
+		r.Logger.V(4).Info("ICSVMImage is being deleted, leaving its image and template in iCenter", "key", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	if annotations.HasPaused(icsVMImage) {
+		r.Logger.V(4).Info("ICSVMImage is paused", "key", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	// Create the patch helper.
+	patchHelper, err := patch.NewHelper(icsVMImage, r.Client)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(
+			err,
+			"failed to init patch helper for %s %s/%s",
+			icsVMImage.GroupVersionKind(),
+			icsVMImage.Namespace,
+			icsVMImage.Name)
+	}
+
+	// Create the VM image context for this request.
+	imageContext := &context.VMImageContext{
+		ControllerContext: r.ControllerContext,
+		ICSVMImage:        icsVMImage,
+		Logger:            r.Logger.WithName(req.Namespace).WithName(req.Name),
+		PatchHelper:       patchHelper,
+	}
+
+	// Always issue a patch when exiting this function so changes to the
+	// resource are patched back to the API server.
+	defer func() {
+		conditions.SetSummary(imageContext.ICSVMImage,
+			conditions.WithConditions(
+				infrav1.ICenterAvailableCondition,
+				infrav1.ImageAvailableCondition,
+				infrav1.TemplateAvailableCondition,
+			),
+		)
+		if err := imageContext.Patch(); err != nil {
+			if !infrautilv1.IsNotFoundError(err) {
+				if reterr == nil {
+					reterr = err
+				}
+				imageContext.Logger.Error(err, "patch failed", "vmimage", imageContext.String())
+			}
+		}
+	}()
+
+	return r.reconcileNormal(imageContext)
+}
+
+// reconcileNormal makes the image ready. Ready is only reset once the image
+// or the template is found missing, failures to reach iCenter leave it as it
+// is.
+func (r vmImageReconciler) reconcileNormal(ctx *context.VMImageContext) (reconcile.Result, error) {
+	status := &ctx.ICSVMImage.Status
+	wasReady := status.Ready
+
+	icsSession, err := r.reconcileICenterConnectivity(ctx)
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSVMImage, infrav1.ICenterAvailableCondition, infrav1.ICenterUnreachableReason, clusterv1.ConditionSeverityError, err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "failed to get session for %s", ctx)
+	}
+	conditions.MarkTrue(ctx.ICSVMImage, infrav1.ICenterAvailableCondition)
+	ctx.Session = icsSession
+
+	if ctx.ICSVMImage.Spec.Source.Template != "" {
+		return r.reconcileSourceTemplate(ctx)
+	}
+
+	ovaImage, err := r.reconcileImage(ctx)
+	if err != nil || ovaImage == nil {
+		return reconcile.Result{RequeueAfter: vmImageRequeueAfter}, err
+	}
+
+	if ctx.ICSVMImage.Spec.Template == nil {
+		status.TemplateName = ""
+	} else {
+		ok, err := r.reconcileTemplate(ctx, ovaImage)
+		if err != nil || !ok {
+			return reconcile.Result{RequeueAfter: vmImageRequeueAfter}, err
+		}
+	}
+
+	if !wasReady {
+		r.Recorder.Eventf(ctx.ICSVMImage, "ImageReady", "Image %s is ready", ctx.ICSVMImage.Name)
+	}
+	status.Ready = true
+	ctx.Logger.V(4).Info("ICSVMImage is ready", "image", status.ImageName, "template", status.TemplateName)
+	return reconcile.Result{}, nil
+}
+
+// reconcileSourceTemplate makes the image ready once the template it comes
+// from exists.
+func (r vmImageReconciler) reconcileSourceTemplate(ctx *context.VMImageContext) (reconcile.Result, error) {
+	name := ctx.ICSVMImage.Spec.Source.Template
+	tpl, err := template.FindTemplate(ctx, name)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to find template %q for %s", name, ctx)
+	}
+	if tpl == nil {
+		conditions.MarkFalse(ctx.ICSVMImage, infrav1.TemplateAvailableCondition, infrav1.TemplateNotFoundReason, clusterv1.ConditionSeverityWarning,
+			"template %s not found", name)
+		ctx.ICSVMImage.Status.Ready = false
+		return reconcile.Result{RequeueAfter: vmImageRequeueAfter}, nil
+	}
+	conditions.MarkTrue(ctx.ICSVMImage, infrav1.TemplateAvailableCondition)
+	ctx.ICSVMImage.Status.TemplateName = name
+	ctx.ICSVMImage.Status.Ready = true
+	return reconcile.Result{}, nil
+}
+
+// reconcileImage asks iCenter to download the OVA image into the image
+// datastores that do not have it yet. It returns the image on the first
+// datastore once it is registered on all of them with the expected checksum,
+// or nil until then.
+func (r vmImageReconciler) reconcileImage(ctx *context.VMImageContext) (*basetypv1.ImageFileInfo, error) {
+	spec := &ctx.ICSVMImage.Spec
+	status := &ctx.ICSVMImage.Status
+
+	imageName := spec.ImageName
+	if imageName == "" {
+		imageName = ctx.ICSVMImage.Name + ".ova"
+	}
+	status.ImageName = imageName
+
+	storages, err := r.imageStorages(ctx)
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSVMImage, infrav1.ImageAvailableCondition, infrav1.ImageUploadFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return nil, err
+	}
+
+	// Downloads that do not complete in time are requested again.
+	if c := conditions.Get(ctx.ICSVMImage, infrav1.ImageAvailableCondition); c != nil && c.Reason == infrav1.ImageUploadingReason &&
+		time.Since(c.LastTransitionTime.Time) > vmImageDownloadTimeout && len(status.Uploading) > 0 {
+		r.Recorder.Warnf(ctx.ICSVMImage, "ImageDownloadTimedOut", "Download of image %s into %s timed out", imageName, strings.Join(status.Uploading, ", "))
+		status.Uploading = nil
+	}
+
+	var (
+		first     *basetypv1.ImageFileInfo
+		sourceURL string
+		uploading []string
+	)
+	status.Datastores = nil
+	for i := range storages {
+		storage := &storages[i]
+		file, err := image.FindImageFile(ctx, storage.ID, imageName)
+		if err != nil {
+			return nil, err
+		}
+
+		// The checksum is reported once the download is complete.
+		if file == nil || file.Md5 == "" {
+			status.Ready = false
+			if file == nil && !containsString(status.Uploading, storage.Name) {
+				if sourceURL == "" {
+					if sourceURL, err = r.sourceURL(ctx); err != nil {
+						conditions.MarkFalse(ctx.ICSVMImage, infrav1.ImageAvailableCondition, infrav1.ImageUploadFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+						return nil, err
+					}
+				}
+				if err := image.DownloadImage(ctx, storage.ID, imageName, sourceURL); err != nil {
+					conditions.MarkFalse(ctx.ICSVMImage, infrav1.ImageAvailableCondition, infrav1.ImageUploadFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+					return nil, err
+				}
+				r.Recorder.Eventf(ctx.ICSVMImage, "ImageDownloading", "Downloading image %s into datastore %s", imageName, storage.Name)
+			}
+			uploading = append(uploading, storage.Name)
+			continue
+		}
+
+		if spec.Checksum != "" && !strings.EqualFold(file.Md5, spec.Checksum) {
+			conditions.MarkFalse(ctx.ICSVMImage, infrav1.ImageAvailableCondition, infrav1.ChecksumMismatchReason, clusterv1.ConditionSeverityError,
+				"image %s on datastore %s has checksum %s instead of %s", imageName, storage.Name, file.Md5, spec.Checksum)
+			r.Recorder.Warnf(ctx.ICSVMImage, "ChecksumMismatch", "Image %s on datastore %s has checksum %s instead of %s", imageName, storage.Name, file.Md5, spec.Checksum)
+			status.Uploading = uploading
+			status.Ready = false
+			return nil, nil
+		}
+		status.Datastores = append(status.Datastores, storage.Name)
+		if first == nil {
+			first = file
+			status.Checksum = file.Md5
+		}
+	}
+
+	status.Uploading = uploading
+	if len(uploading) > 0 {
+		conditions.MarkFalse(ctx.ICSVMImage, infrav1.ImageAvailableCondition, infrav1.ImageUploadingReason, clusterv1.ConditionSeverityInfo,
+			"downloading image %s", imageName)
+		return nil, nil
+	}
+	conditions.MarkTrue(ctx.ICSVMImage, infrav1.ImageAvailableCondition)
+	return first, nil
+}
+
+// imageStorages returns the image datastores of the image, or the first
+// image datastore when the image has none.
+func (r vmImageReconciler) imageStorages(ctx *context.VMImageContext) ([]basetypv1.Storage, error) {
+	storages, err := image.GetImageStorages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := ctx.ICSVMImage.Spec.Datastores
+	if len(names) == 0 {
+		if len(storages) == 0 {
+			return nil, errors.New("iCenter has no image datastore")
+		}
+		return storages[:1], nil
+	}
+
+	selected := make([]basetypv1.Storage, 0, len(names))
+	for _, name := range names {
+		found := false
+		for i := range storages {
+			if storages[i].Name == name {
+				selected = append(selected, storages[i])
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("image datastore %q not found", name)
+		}
+	}
+	return selected, nil
+}
+
+// sourceURL returns the URL iCenter downloads the image from.
+func (r vmImageReconciler) sourceURL(ctx *context.VMImageContext) (string, error) {
+	source := ctx.ICSVMImage.Spec.Source
+	if source.OCI == nil {
+		return source.URL, nil
+	}
+
+	var credentials *image.OCICredentials
+	if source.OCI.SecretRef != nil {
+		secret := &corev1.Secret{}
+		secretKey := ctrlclient.ObjectKey{Namespace: ctx.ICSVMImage.Namespace, Name: source.OCI.SecretRef.Name}
+		if err := r.Client.Get(ctx, secretKey, secret); err != nil {
+			return "", errors.Wrapf(err, "failed to get secret %s", secretKey)
+		}
+		credentials = &image.OCICredentials{
+			Username: string(secret.Data["username"]),
+			Password: string(secret.Data["password"]),
+		}
+	}
+	blobURL, err := image.ResolveOCIBlobURL(ctx, source.OCI.Reference, credentials, source.OCI.Insecure)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve oci artifact %q", source.OCI.Reference)
+	}
+	return blobURL, nil
+}
+
+// reconcileTemplate imports the OVA image as a virtual machine and converts
+// it into the template of the image. It returns true once the template
+// exists.
+func (r vmImageReconciler) reconcileTemplate(ctx *context.VMImageContext, ovaImage *basetypv1.ImageFileInfo) (bool, error) {
+	spec := ctx.ICSVMImage.Spec.Template
+	status := &ctx.ICSVMImage.Status
+
+	name := spec.Name
+	if name == "" {
+		name = ctx.ICSVMImage.Name
+	}
+	tpl, err := template.FindTemplate(ctx, name)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to find template %q for %s", name, ctx)
+	}
+	if tpl != nil {
+		status.TemplateName = name
+		status.TaskRef = ""
+		conditions.MarkTrue(ctx.ICSVMImage, infrav1.TemplateAvailableCondition)
+		return true, nil
+	}
+
+	status.Ready = false
+	if status.TaskRef != "" {
+		task, err := basetkv1.NewTaskService(ctx.Session.Client).GetTaskInfo(ctx, &basetypv1.Task{TaskId: status.TaskRef})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get task %s for %s", status.TaskRef, ctx)
+		}
+		switch task.State {
+		case "WAITING", "RUNNING", "READY":
+			conditions.MarkFalse(ctx.ICSVMImage, infrav1.TemplateAvailableCondition, infrav1.ConvertingToTemplateReason, clusterv1.ConditionSeverityInfo,
+				"importing image %s", ovaImage.Name)
+			return false, nil
+		case "FINISHED":
+			status.TaskRef = ""
+		default:
+			status.TaskRef = ""
+			conditions.MarkFalse(ctx.ICSVMImage, infrav1.TemplateAvailableCondition, infrav1.TemplateConversionFailedReason, clusterv1.ConditionSeverityWarning,
+				"import of image %s %s: %s", ovaImage.Name, strings.ToLower(task.State), task.Error)
+			r.Recorder.Warnf(ctx.ICSVMImage, "TemplateConversionFailed", "Import of image %s %s: %s", ovaImage.Name, strings.ToLower(task.State), task.Error)
+			return false, nil
+		}
+	}
+
+	vm, err := template.FindVM(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if vm != nil {
+		if err := template.ConvertToTemplate(ctx, vm); err != nil {
+			conditions.MarkFalse(ctx.ICSVMImage, infrav1.TemplateAvailableCondition, infrav1.TemplateConversionFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			return false, err
+		}
+		conditions.MarkFalse(ctx.ICSVMImage, infrav1.TemplateAvailableCondition, infrav1.ConvertingToTemplateReason, clusterv1.ConditionSeverityInfo,
+			"converting virtual machine %s", name)
+		r.Recorder.Eventf(ctx.ICSVMImage, "TemplateConverted", "Converted virtual machine %s into a template", name)
+		return false, nil
+	}
+
+	task, err := template.ImportTemplate(ctx, ovaImage, name, spec)
+	if err != nil {
+		conditions.MarkFalse(ctx.ICSVMImage, infrav1.TemplateAvailableCondition, infrav1.TemplateConversionFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return false, err
+	}
+	status.TaskRef = task.TaskId
+	conditions.MarkFalse(ctx.ICSVMImage, infrav1.TemplateAvailableCondition, infrav1.ConvertingToTemplateReason, clusterv1.ConditionSeverityInfo,
+		"importing image %s", ovaImage.Name)
+	r.Recorder.Eventf(ctx.ICSVMImage, "TemplateImporting", "Importing image %s as template %s", ovaImage.Name, name)
+	return false, nil
+}
+
+// reconcileICenterConnectivity returns a session for the cloud of the image.
+func (r vmImageReconciler) reconcileICenterConnectivity(ctx *context.VMImageContext) (*session.Session, error) {
+	cloudName := ctx.ICSVMImage.Spec.CloudName
+	iCenter, err := identity.NewClientFromMachine(ctx, r.Client, ctx.ICSVMImage.Namespace, cloudName, ctx.ICSVMImage.Spec.IdentityRef)
+	if err != nil {
+		if infrautilv1.IsNotFoundError(err) {
+			return session.Get(ctx, cloudName)
+		}
+		return nil, err
+	}
+	if iCenter.AuthInfo == nil {
+		return session.Get(ctx, cloudName)
+	}
+
+	params := session.NewParams().
+		WithCloudName(cloudName).
+		WithServer(iCenter.ICenterURL).
+		WithUserInfo(iCenter.AuthInfo.Username, iCenter.AuthInfo.Password).
+		WithAPIVersion(iCenter.APIVersion).
+		WithFeatures(session.Feature{
+			KeepAliveDuration: r.KeepAliveDuration,
+		})
+	return session.GetOrCreate(ctx, params)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
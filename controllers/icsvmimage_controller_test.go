@@ -0,0 +1,116 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	goctx "context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgorecord "k8s.io/client-go/tools/record"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/record"
+)
+
+func newVMImageReconciler(objs ...client.Object) (vmImageReconciler, client.Client) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	ctrlClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	return vmImageReconciler{ControllerContext: &context.ControllerContext{
+		ControllerManagerContext: &context.ControllerManagerContext{
+			Context: goctx.Background(),
+			Client:  ctrlClient,
+			Logger:  log.Log,
+		},
+		Logger:   log.Log,
+		Recorder: record.New(clientgorecord.NewFakeRecorder(10)),
+	}}, ctrlClient
+}
+
+func TestVMImageReconcileDeleted(t *testing.T) {
+	now := metav1.Now()
+	icsVMImage := &infrav1.ICSVMImage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              "ubuntu",
+			DeletionTimestamp: &now,
+			Finalizers:        []string{"example.com/other"},
+		},
+		Spec: infrav1.ICSVMImageSpec{
+			CloudName: "unreachable",
+			Source:    infrav1.ICSVMImageSource{URL: "https://example.com/ubuntu.ova"},
+			Template:  &infrav1.ICSVMImageTemplateSpec{},
+		},
+		Status: infrav1.ICSVMImageStatus{Ready: true, ImageName: "ubuntu.ova", TemplateName: "ubuntu"},
+	}
+	r, ctrlClient := newVMImageReconciler(icsVMImage)
+
+	key := types.NamespacedName{Namespace: "default", Name: "ubuntu"}
+	if _, err := r.Reconcile(goctx.Background(), ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("reconcile of a deleted image failed: %v", err)
+	}
+
+	// The image and template are left in iCenter, so neither iCenter nor the
+	// ICSVMImage are touched.
+	got := &infrav1.ICSVMImage{}
+	if err := ctrlClient.Get(goctx.Background(), key, got); err != nil {
+		t.Fatalf("failed to get image: %v", err)
+	}
+	if !reflect.DeepEqual(got.Finalizers, icsVMImage.Finalizers) {
+		t.Errorf("got finalizers %v, want %v", got.Finalizers, icsVMImage.Finalizers)
+	}
+	if !reflect.DeepEqual(got.Status, icsVMImage.Status) {
+		t.Errorf("got status %+v, want %+v", got.Status, icsVMImage.Status)
+	}
+}
+
+func TestVMImageStaysReadyWithoutICenter(t *testing.T) {
+	icsVMImage := &infrav1.ICSVMImage{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ubuntu"},
+		Spec: infrav1.ICSVMImageSpec{
+			CloudName: "unreachable",
+			Source:    infrav1.ICSVMImageSource{URL: "https://example.com/ubuntu.ova"},
+		},
+		Status: infrav1.ICSVMImageStatus{Ready: true, ImageName: "ubuntu.ova"},
+	}
+	r, _ := newVMImageReconciler(icsVMImage)
+
+	ctx := &context.VMImageContext{
+		ControllerContext: r.ControllerContext,
+		ICSVMImage:        icsVMImage,
+		Logger:            log.Log,
+	}
+	if _, err := r.reconcileNormal(ctx); err == nil {
+		t.Fatalf("expected an error without a session to iCenter")
+	}
+	if !icsVMImage.Status.Ready {
+		t.Errorf("image is no longer ready after failing to reach iCenter")
+	}
+	if !conditions.IsFalse(icsVMImage, infrav1.ICenterAvailableCondition) {
+		t.Errorf("got condition %+v, want ICenterAvailable to be false", conditions.Get(icsVMImage, infrav1.ICenterAvailableCondition))
+	}
+}
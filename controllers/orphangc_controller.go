@@ -0,0 +1,376 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	goctx "context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+	basevmv1 "github.com/ics-sigs/ics-go-sdk/vm"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/identity"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/record"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
+	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
+)
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=icsvms,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ipaddresses,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+
+var (
+	orphanedVMs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capics_orphaned_vms",
+			Help: "Number of VMs created by the provider that are no longer backed by an ICSVM.",
+		},
+		[]string{"cloud"},
+	)
+	orphanedIPAddresses = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "capics_orphaned_ipaddresses",
+			Help: "Number of IPAddresses that refer to an ICSVM which no longer exists.",
+		},
+	)
+	orphansDeleted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "capics_orphans_deleted_total",
+			Help: "Number of orphaned VMs and IPAddresses deleted by the garbage collector.",
+		},
+		[]string{"kind"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(orphanedVMs, orphanedIPAddresses, orphansDeleted)
+}
+
+// AddOrphanGCToManager adds the garbage collector for orphaned VMs and
+// IPAddresses to the provided manager. The collector is not added if its
+// interval is zero.
+func AddOrphanGCToManager(ctx *context.ControllerManagerContext, mgr manager.Manager) error {
+	if ctx.OrphanGCInterval <= 0 {
+		return nil
+	}
+
+	var (
+		controllerNameShort = "orphangc-controller"
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	// Build the controller context.
+	controllerContext := &context.ControllerContext{
+		ControllerManagerContext: ctx,
+		Name:                     controllerNameShort,
+		Recorder:                 record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		Logger:                   ctx.Logger.WithName(controllerNameShort),
+	}
+	return mgr.Add(&orphanCollector{
+		ControllerContext: controllerContext,
+		firstSeen:         map[string]time.Time{},
+	})
+}
+
+// orphanCollector periodically looks for VMs that were created by this
+// provider but are no longer backed by an ICSVM, and for IPAddresses whose
+// ICSVM no longer exists. Orphans are reported as events and metrics, and
+// deleted once they have been observed for longer than the grace period
+// unless the collector runs in dry-run mode.
+type orphanCollector struct {
+	*context.ControllerContext
+
+	// firstSeen records when each orphan was first observed. It is only
+	// kept in memory, so the grace period of every orphan starts over when
+	// the collector is restarted or another manager becomes the leader.
+	firstSeen map[string]time.Time
+}
+
+// Start implements manager.Runnable.
+func (r *orphanCollector) Start(ctx goctx.Context) error {
+	r.Logger.Info("starting orphan garbage collector",
+		"interval", r.OrphanGCInterval,
+		"grace-period", r.OrphanGCGracePeriod,
+		"dry-run", r.OrphanGCDryRun)
+	wait.UntilWithContext(ctx, r.collect, r.OrphanGCInterval)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (r *orphanCollector) NeedLeaderElection() bool {
+	return true
+}
+
+func (r *orphanCollector) collect(ctx goctx.Context) {
+	icsVMs := &infrav1.ICSVMList{}
+	if err := r.Client.List(ctx, icsVMs, ctrlclient.InNamespace(r.WatchNamespace)); err != nil {
+		r.Logger.Error(err, "failed to list ICSVMs")
+		return
+	}
+	known := newKnownICSVMs(icsVMs.Items)
+
+	seen := map[string]bool{}
+	r.collectVMs(ctx, known, seen)
+	r.collectIPAddresses(ctx, known, seen)
+
+	// Forget the orphans that no longer exist.
+	for key := range r.firstSeen {
+		if !seen[key] {
+			delete(r.firstSeen, key)
+		}
+	}
+}
+
+func (r *orphanCollector) collectVMs(ctx goctx.Context, known knownICSVMs, seen map[string]bool) {
+	icsClusters := &infrav1.ICSClusterList{}
+	if err := r.Client.List(ctx, icsClusters, ctrlclient.InNamespace(r.WatchNamespace)); err != nil {
+		r.Logger.Error(err, "failed to list ICSClusters")
+		return
+	}
+
+	// ICSClusters are indexed by the name of their cluster so that events
+	// are recorded on the ICSCluster named in the VM ownership metadata.
+	byCluster := map[string]*infrav1.ICSCluster{}
+	for i := range icsClusters.Items {
+		icsCluster := &icsClusters.Items[i]
+		if clusterName, ok := icsCluster.Labels[clusterv1.ClusterLabelName]; ok {
+			byCluster[icsCluster.Namespace+"/"+clusterName] = icsCluster
+		}
+	}
+
+	// Every known iCenter is visited once, using the first ICSCluster that
+	// references it for the credentials.
+	visited := map[string]bool{}
+	for i := range icsClusters.Items {
+		icsCluster := &icsClusters.Items[i]
+		cloudName := icsCluster.Spec.CloudName
+		if visited[cloudName] {
+			continue
+		}
+		visited[cloudName] = true
+
+		authSession, err := r.getSession(ctx, icsCluster)
+		if err != nil {
+			r.Logger.Error(err, "failed to connect to icenter", "cloud", cloudName)
+			continue
+		}
+		vmService := basevmv1.NewVirtualMachineService(authSession.Client)
+		vms, err := vmService.GetVMList(ctx)
+		if err != nil {
+			r.Logger.Error(err, "failed to list vms", "cloud", cloudName)
+			continue
+		}
+
+		orphans := 0
+		for i := range vms {
+			vm := &vms[i]
+			owner, ok := orphanedVMOwner(known, r.WatchNamespace, vm)
+			if !ok {
+				continue
+			}
+			orphans++
+
+			eventTarget := icsCluster
+			if ownerCluster, ok := byCluster[owner.Namespace+"/"+owner.Cluster]; ok {
+				eventTarget = ownerCluster
+			}
+
+			key := fmt.Sprintf("vm/%s/%s", cloudName, vm.ID)
+			seen[key] = true
+			if r.observe(key) {
+				r.Logger.Info("found orphaned vm", "cloud", cloudName, "vm-id", vm.ID, "vm-name", vm.Name, "owner", owner)
+				r.Recorder.Warnf(eventTarget, "OrphanedVM", "%s VM %s (%s) of cluster %s created for ICSVM %s/%s has no ICSVM",
+					owner.Role, vm.Name, vm.ID, owner.Cluster, owner.Namespace, owner.Name)
+			}
+			if !r.isExpired(key) {
+				continue
+			}
+			r.deleteVM(ctx, eventTarget, authSession, vm)
+		}
+		orphanedVMs.WithLabelValues(cloudName).Set(float64(orphans))
+	}
+}
+
+func (r *orphanCollector) deleteVM(ctx goctx.Context, icsCluster *infrav1.ICSCluster, authSession *session.Session, vm *basetypv1.VirtualMachine) {
+	vmService := basevmv1.NewVirtualMachineService(authSession.Client)
+
+	// A running VM is powered off first and deleted on a later pass.
+	if vm.Status == "STARTED" || vm.Status == "PAUSED" {
+		if _, err := vmService.PowerOffVM(ctx, vm.ID); err != nil {
+			r.Logger.Error(err, "failed to power off orphaned vm", "vm-id", vm.ID)
+		}
+		return
+	}
+
+	if _, err := vmService.DeleteVMWithCheckParams(ctx, vm.ID, true, true, authSession.Password); err != nil {
+		r.Logger.Error(err, "failed to delete orphaned vm", "vm-id", vm.ID)
+		r.Recorder.Warnf(icsCluster, "OrphanedVMDeleteFailed", "Failed to delete orphaned VM %s (%s): %v", vm.Name, vm.ID, err)
+		return
+	}
+	orphansDeleted.WithLabelValues("vm").Inc()
+	r.Logger.Info("deleted orphaned vm", "vm-id", vm.ID, "vm-name", vm.Name)
+	r.Recorder.Eventf(icsCluster, "OrphanedVMDeleted", "Deleted orphaned VM %s (%s)", vm.Name, vm.ID)
+}
+
+func (r *orphanCollector) collectIPAddresses(ctx goctx.Context, known knownICSVMs, seen map[string]bool) {
+	ipAddresses := &infrav1.IPAddressList{}
+	if err := r.Client.List(ctx, ipAddresses, ctrlclient.InNamespace(r.WatchNamespace)); err != nil {
+		r.Logger.Error(err, "failed to list IPAddresses")
+		return
+	}
+
+	orphans := 0
+	for i := range ipAddresses.Items {
+		ipAddress := &ipAddresses.Items[i]
+		if !ipAddress.DeletionTimestamp.IsZero() || known.hasName(ipAddress.Namespace, ipAddress.Spec.VMRef.Name) {
+			continue
+		}
+		// Addresses reserved for a cluster, such as its control plane
+		// virtual IP, are owned by the ICSCluster.
+		if ipAddress.Spec.VMRef.Kind == "ICSCluster" {
+			continue
+		}
+		orphans++
+
+		key := fmt.Sprintf("ipaddress/%s/%s", ipAddress.Namespace, ipAddress.Name)
+		seen[key] = true
+		if r.observe(key) {
+			r.Logger.Info("found orphaned ipaddress", "namespace", ipAddress.Namespace, "name", ipAddress.Name, "icsvm", ipAddress.Spec.VMRef.Name)
+			r.Recorder.Warnf(ipAddress, "OrphanedIPAddress", "ICSVM %s no longer exists", ipAddress.Spec.VMRef.Name)
+		}
+		if !r.isExpired(key) {
+			continue
+		}
+		if err := r.Client.Delete(ctx, ipAddress); err != nil {
+			r.Logger.Error(err, "failed to delete orphaned ipaddress", "namespace", ipAddress.Namespace, "name", ipAddress.Name)
+			continue
+		}
+		orphansDeleted.WithLabelValues("ipaddress").Inc()
+		r.Logger.Info("deleted orphaned ipaddress", "namespace", ipAddress.Namespace, "name", ipAddress.Name)
+	}
+	orphanedIPAddresses.Set(float64(orphans))
+}
+
+// orphanedVMOwner returns the owner recorded on the VM if the VM was created
+// by this provider and is no longer backed by an ICSVM.
+func orphanedVMOwner(known knownICSVMs, watchNamespace string, vm *basetypv1.VirtualMachine) (infrautilv1.VMOwner, bool) {
+	owner, ok := infrautilv1.ParseVMOwner(vm.Description)
+	if !ok || known.hasVM(vm, owner) {
+		return owner, false
+	}
+	// VMs retained by their deletion policy outlive their ICSVM on purpose.
+	if owner.Retained {
+		return owner, false
+	}
+	// ICSVMs outside of the watched namespace are not known to the
+	// collector, so their VMs cannot be told apart from orphans.
+	if watchNamespace != "" && owner.Namespace != watchNamespace {
+		return owner, false
+	}
+	return owner, true
+}
+
+// observe records the first time an orphan is seen. It returns true if the
+// orphan was not seen before.
+func (r *orphanCollector) observe(key string) bool {
+	if _, ok := r.firstSeen[key]; ok {
+		return false
+	}
+	r.firstSeen[key] = time.Now()
+	return true
+}
+
+// isExpired returns true if the orphan has outlived the grace period and may
+// be deleted.
+func (r *orphanCollector) isExpired(key string) bool {
+	if r.OrphanGCDryRun {
+		return false
+	}
+	return time.Since(r.firstSeen[key]) >= r.OrphanGCGracePeriod
+}
+
+func (r *orphanCollector) getSession(ctx goctx.Context, icsCluster *infrav1.ICSCluster) (*session.Session, error) {
+	iCenter, err := identity.NewClientFromCluster(ctx, r.Client, icsCluster)
+	if err != nil {
+		if infrautilv1.IsNotFoundError(err) {
+			return session.Get(ctx, icsCluster.Spec.CloudName)
+		}
+		return nil, err
+	}
+
+	if iCenter == nil || iCenter.AuthInfo == nil {
+		return session.Get(ctx, icsCluster.Spec.CloudName)
+	}
+
+	params := session.NewParams().
+		WithCloudName(icsCluster.Spec.CloudName).
+		WithServer(iCenter.ICenterURL).
+		WithUserInfo(iCenter.AuthInfo.Username, iCenter.AuthInfo.Password).
+		WithAPIVersion(iCenter.APIVersion).
+		WithFeatures(session.Feature{
+			KeepAliveDuration: r.KeepAliveDuration,
+		})
+	return session.GetOrCreate(ctx, params)
+}
+
+// knownICSVMs indexes the existing ICSVMs by every identifier a VM can be
+// matched with.
+type knownICSVMs struct {
+	uids      map[string]bool
+	ids       map[string]bool
+	biosUUIDs map[string]bool
+	names     map[string]bool
+}
+
+func newKnownICSVMs(icsVMs []infrav1.ICSVM) knownICSVMs {
+	known := knownICSVMs{
+		uids:      map[string]bool{},
+		ids:       map[string]bool{},
+		biosUUIDs: map[string]bool{},
+		names:     map[string]bool{},
+	}
+	for _, icsVM := range icsVMs {
+		known.uids[string(icsVM.UID)] = true
+		known.names[icsVM.Namespace+"/"+icsVM.Name] = true
+		if icsVM.Spec.UID != "" {
+			known.ids[icsVM.Spec.UID] = true
+		}
+		if icsVM.Spec.BiosUUID != "" {
+			known.biosUUIDs[icsVM.Spec.BiosUUID] = true
+		}
+	}
+	return known
+}
+
+func (k knownICSVMs) hasVM(vm *basetypv1.VirtualMachine, owner infrautilv1.VMOwner) bool {
+	return k.uids[owner.UID] || k.ids[vm.ID] || k.biosUUIDs[vm.UUID] || k.hasName(owner.Namespace, vm.Name)
+}
+
+func (k knownICSVMs) hasName(namespace, name string) bool {
+	return k.names[namespace+"/"+name]
+}
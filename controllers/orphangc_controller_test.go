@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
+)
+
+func TestOrphanedVMOwner(t *testing.T) {
+	known := newKnownICSVMs([]infrav1.ICSVM{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "live", UID: "live-uid"}},
+	})
+	owner := infrautilv1.VMOwner{Cluster: "c1", Namespace: "default", Name: "gone", UID: "gone-uid", Role: infrautilv1.VMRoleWorker}
+	retained := owner
+	retained.Retained = true
+	otherNamespace := owner
+	otherNamespace.Namespace = "other"
+
+	testCases := []struct {
+		name           string
+		vm             basetypv1.VirtualMachine
+		watchNamespace string
+		want           bool
+	}{
+		{
+			name: "vm without ownership metadata",
+			vm:   basetypv1.VirtualMachine{ID: "vm-1", Name: "manual", Description: "created by hand"},
+		},
+		{
+			name: "vm backed by an icsvm",
+			vm: basetypv1.VirtualMachine{ID: "vm-2", Name: "live", Description: infrautilv1.VMOwner{
+				Namespace: "default", Name: "live", UID: "live-uid",
+			}.Description()},
+		},
+		{
+			name: "vm without icsvm",
+			vm:   basetypv1.VirtualMachine{ID: "vm-3", Name: "gone", Description: owner.Description()},
+			want: true,
+		},
+		{
+			name: "vm retained by its deletion policy",
+			vm:   basetypv1.VirtualMachine{ID: "vm-4", Name: "gone", Description: retained.Description()},
+		},
+		{
+			name:           "vm of an unwatched namespace",
+			vm:             basetypv1.VirtualMachine{ID: "vm-5", Name: "gone", Description: otherNamespace.Description()},
+			watchNamespace: "default",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if _, got := orphanedVMOwner(known, tc.watchNamespace, &tc.vm); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
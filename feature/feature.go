@@ -34,6 +34,12 @@ const (
 	//
 	// alpha: v1.4
 	NodeLabeling featuregate.Feature = "NodeLabeling"
+
+	// MachinePool is a feature gate for the ICSMachinePool infrastructure of
+	// the MachinePools of Cluster API.
+	//
+	// alpha: v1.5
+	MachinePool featuregate.Feature = "MachinePool"
 )
 
 func init() {
@@ -46,4 +52,5 @@ var defaultCAPICSFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	// Every feature should be initiated here:
 	NodeAntiAffinity: {Default: false, PreRelease: featuregate.Alpha},
 	NodeLabeling:     {Default: false, PreRelease: featuregate.Alpha},
+	MachinePool:      {Default: false, PreRelease: featuregate.Alpha},
 }
@@ -41,6 +41,8 @@ import (
 	"github.com/ics-sigs/cluster-api-provider-ics/feature"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/constants"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/inventory"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/machinedefaults"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/manager"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/version"
 )
@@ -60,6 +62,7 @@ var (
 	defaultWebhookPort       = manager.DefaultWebhookServiceContainerPort
 	defaultEnableKeepAlive   = constants.DefaultEnableKeepAlive
 	defaultKeepAliveDuration = constants.DefaultKeepAliveDuration
+	defaultOrphanGCGrace     = manager.DefaultOrphanGCGracePeriod
 	defaultTLSMinVersion     = "1.2"
 )
 
@@ -133,6 +136,30 @@ func InitFlags(fs *pflag.FlagSet) {
 		defaultKeepAliveDuration,
 		"idle time interval(minutes) in between send() requests in keepalive handler",
 	)
+	flag.DurationVar(
+		&managerOpts.OrphanGCInterval,
+		"orphan-gc-interval",
+		0,
+		"The interval at which VMs and IPAddresses that are no longer backed by an ICSVM are looked for. Set to 0 to disable the garbage collector.",
+	)
+	flag.DurationVar(
+		&managerOpts.OrphanGCGracePeriod,
+		"orphan-gc-grace-period",
+		defaultOrphanGCGrace,
+		"How long an orphaned VM or IPAddress must have been observed before it is deleted. Observations are kept in memory, so the grace period restarts when the manager restarts or the leader changes.",
+	)
+	flag.BoolVar(
+		&managerOpts.OrphanGCDryRun,
+		"orphan-gc-dry-run",
+		true,
+		"Only report orphaned VMs and IPAddresses as events and metrics, without deleting them.",
+	)
+	flag.StringVar(
+		&managerOpts.InventoryValidation,
+		"inventory-validation",
+		"",
+		"Validate the template, datastores, networks and compute cluster of new ICSMachines, ICSMachineTemplates and ICSVMs against iCenter. Possible values are \"\" to disable the validation, \"Warn\" and \"Reject\".",
+	)
 	flag.StringVar(
 		&tlsMinVersion,
 		"tls-min-version",
@@ -192,7 +219,7 @@ func main() {
 			if err := setupControllers(ctx, mgr); err != nil {
 				return err
 			}
-			if err := setupWebhooks(mgr); err != nil {
+			if err := setupWebhooks(ctx, mgr); err != nil {
 				return err
 			}
 		}
@@ -232,16 +259,33 @@ func setupControllers(ctx *context.ControllerManagerContext, mgr ctrlmgr.Manager
 	if err := controllers.AddMachineControllerToManager(ctx, mgr, &v1beta1.ICSMachine{}); err != nil {
 		return err
 	}
+	if err := controllers.AddMachineTemplateControllerToManager(ctx, mgr); err != nil {
+		return err
+	}
 	if err := controllers.AddVMControllerToManager(ctx, mgr); err != nil {
 		return err
 	}
+	if err := controllers.AddVMImageControllerToManager(ctx, mgr); err != nil {
+		return err
+	}
 	if err := controllers.AddIPAddressControllerToManager(ctx, mgr); err != nil {
 		return err
 	}
+	if err := controllers.AddHAProxyLoadBalancerControllerToManager(ctx, mgr); err != nil {
+		return err
+	}
+	if err := controllers.AddOrphanGCToManager(ctx, mgr); err != nil {
+		return err
+	}
+	if feature.Gates.Enabled(feature.MachinePool) {
+		if err := controllers.AddMachinePoolControllerToManager(ctx, mgr); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func setupWebhooks(mgr ctrlmgr.Manager) error {
+func setupWebhooks(ctx *context.ControllerManagerContext, mgr ctrlmgr.Manager) error {
 	if err := (&v1beta1.ICSCluster{}).SetupWebhookWithManager(mgr); err != nil {
 		return err
 	}
@@ -249,6 +293,13 @@ func setupWebhooks(mgr ctrlmgr.Manager) error {
 		return err
 	}
 
+	if err := (&v1beta1.ICSClusterTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (&v1beta1.ICSClusterTemplateList{}).SetupWebhookWithManager(mgr); err != nil {
+		return err
+	}
+
 	if err := (&v1beta1.ICSMachine{}).SetupWebhookWithManager(mgr); err != nil {
 		return err
 	}
@@ -276,7 +327,34 @@ func setupWebhooks(mgr ctrlmgr.Manager) error {
 	if err := (&v1beta1.IPAddressList{}).SetupWebhookWithManager(mgr); err != nil {
 		return err
 	}
-	return nil
+
+	if err := (&v1beta1.ICSHAProxyLoadBalancer{}).SetupWebhookWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (&v1beta1.ICSHAProxyLoadBalancerList{}).SetupWebhookWithManager(mgr); err != nil {
+		return err
+	}
+
+	if err := (&v1beta1.ICSVMImage{}).SetupWebhookWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (&v1beta1.ICSVMImageList{}).SetupWebhookWithManager(mgr); err != nil {
+		return err
+	}
+
+	if feature.Gates.Enabled(feature.MachinePool) {
+		if err := (&v1beta1.ICSMachinePool{}).SetupWebhookWithManager(mgr); err != nil {
+			return err
+		}
+		if err := (&v1beta1.ICSMachinePoolList{}).SetupWebhookWithManager(mgr); err != nil {
+			return err
+		}
+	}
+
+	if err := machinedefaults.SetupWebhookWithManager(mgr); err != nil {
+		return err
+	}
+	return inventory.SetupWebhookWithManager(mgr, inventory.Mode(ctx.InventoryValidation), ctx.KeepAliveDuration)
 }
 
 func setupChecks(mgr ctrlmgr.Manager) {
@@ -54,6 +54,10 @@ func RunRoot(command *cobra.Command) error {
 	switch flavor {
 	case "loadbalancer":
 		util.PrintObjects(flavors.MultiNodeTemplateWithLoadBalancer())
+	case "clusterclass":
+		util.PrintObjects(flavors.ClusterClass())
+	case "topology":
+		util.PrintObjects(flavors.ClusterTopology())
 	default:
 		//return errors.Errorf("invalid flavor")
 		util.PrintObjects(flavors.MultiNodeTemplateWithOutLoadBalancer())
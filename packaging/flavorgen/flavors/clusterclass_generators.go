@@ -0,0 +1,330 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavors
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/packaging/flavorgen/flavors/env"
+	"github.com/ics-sigs/cluster-api-provider-ics/packaging/flavorgen/flavors/util"
+)
+
+const (
+	// workerClassName is the class of the MachineDeployments of the ClusterClass.
+	workerClassName = "worker"
+
+	// identityRefNameTemplate names the identity secret after the cluster, as
+	// newIdentitySecret does.
+	identityRefNameTemplate = "{{ .builtin.cluster.name }}-cloud-config"
+)
+
+func newClusterClass(icsClusterTemplate infrav1.ICSClusterTemplate, controlPlaneTemplate controlplanev1.KubeadmControlPlaneTemplate,
+	controlPlaneMachineTemplate, workerMachineTemplate infrav1.ICSMachineTemplate, workerBootstrapTemplate bootstrapv1.KubeadmConfigTemplate) clusterv1.ClusterClass {
+	return clusterv1.ClusterClass{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clusterv1.GroupVersion.String(),
+			Kind:       util.TypeToKind(&clusterv1.ClusterClass{}),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      env.ClusterClassNameVar,
+			Namespace: env.NamespaceVar,
+		},
+		Spec: clusterv1.ClusterClassSpec{
+			Infrastructure: clusterv1.LocalObjectTemplate{
+				Ref: templateRef(icsClusterTemplate.TypeMeta, icsClusterTemplate.Name),
+			},
+			ControlPlane: clusterv1.ControlPlaneClass{
+				LocalObjectTemplate: clusterv1.LocalObjectTemplate{
+					Ref: templateRef(controlPlaneTemplate.TypeMeta, controlPlaneTemplate.Name),
+				},
+				MachineInfrastructure: &clusterv1.LocalObjectTemplate{
+					Ref: templateRef(controlPlaneMachineTemplate.TypeMeta, controlPlaneMachineTemplate.Name),
+				},
+			},
+			Workers: clusterv1.WorkersClass{
+				MachineDeployments: []clusterv1.MachineDeploymentClass{
+					{
+						Class: workerClassName,
+						Template: clusterv1.MachineDeploymentClassTemplate{
+							Bootstrap: clusterv1.LocalObjectTemplate{
+								Ref: templateRef(workerBootstrapTemplate.TypeMeta, workerBootstrapTemplate.Name),
+							},
+							Infrastructure: clusterv1.LocalObjectTemplate{
+								Ref: templateRef(workerMachineTemplate.TypeMeta, workerMachineTemplate.Name),
+							},
+						},
+					},
+				},
+			},
+			Variables: clusterClassVariables(),
+			Patches:   clusterClassPatches(),
+		},
+	}
+}
+
+func templateRef(typeMeta metav1.TypeMeta, name string) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		APIVersion: typeMeta.APIVersion,
+		Kind:       typeMeta.Kind,
+		Name:       name,
+	}
+}
+
+func clusterClassVariables() []clusterv1.ClusterClassVariable {
+	return []clusterv1.ClusterClassVariable{
+		{
+			Name:     "template",
+			Required: true,
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+					Type:        "string",
+					Description: "Template is the name of the template the machines are cloned from.",
+				},
+			},
+		},
+		{
+			Name:     "datastore",
+			Required: true,
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+					Type:        "string",
+					Description: "Datastore is the name of the datastore the disks of the machines are placed on.",
+				},
+			},
+		},
+		{
+			Name:     "network",
+			Required: true,
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+					Type:        "string",
+					Description: "Network is the name of the network the machines are connected to.",
+				},
+			},
+		},
+		{
+			Name:     "numCPUs",
+			Required: false,
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+					Type:        "integer",
+					Description: "NumCPUs is the number of virtual processors of the machines.",
+					Minimum:     pointer.Int64Ptr(1),
+					Default:     jsonValue(2),
+				},
+			},
+		},
+		{
+			Name:     "memoryMiB",
+			Required: false,
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+					Type:        "integer",
+					Description: "MemoryMiB is the size of the memory of the machines in MiB.",
+					Minimum:     pointer.Int64Ptr(1024),
+					Default:     jsonValue(8192),
+				},
+			},
+		},
+	}
+}
+
+func clusterClassPatches() []clusterv1.ClusterClassPatch {
+	machineTemplates := clusterv1.PatchSelector{
+		APIVersion: infrav1.GroupVersion.String(),
+		Kind:       util.TypeToKind(&infrav1.ICSMachineTemplate{}),
+		MatchResources: clusterv1.PatchSelectorMatch{
+			ControlPlane: true,
+			MachineDeploymentClass: &clusterv1.PatchSelectorMatchMachineDeploymentClass{
+				Names: []string{workerClassName},
+			},
+		},
+	}
+	return []clusterv1.ClusterClassPatch{
+		{
+			Name: "identityRef",
+			Definitions: []clusterv1.PatchDefinition{
+				{
+					Selector: clusterv1.PatchSelector{
+						APIVersion: infrav1.GroupVersion.String(),
+						Kind:       util.TypeToKind(&infrav1.ICSClusterTemplate{}),
+						MatchResources: clusterv1.PatchSelectorMatch{
+							InfrastructureCluster: true,
+						},
+					},
+					JSONPatches: []clusterv1.JSONPatch{
+						templatePatch("/spec/template/spec/identityRef/name", identityRefNameTemplate),
+					},
+				},
+				{
+					Selector: machineTemplates,
+					JSONPatches: []clusterv1.JSONPatch{
+						templatePatch("/spec/template/spec/identityRef/name", identityRefNameTemplate),
+					},
+				},
+			},
+		},
+		{
+			Name: "machineTemplate",
+			Definitions: []clusterv1.PatchDefinition{
+				{
+					Selector: machineTemplates,
+					JSONPatches: []clusterv1.JSONPatch{
+						variablePatch("/spec/template/spec/template", "template"),
+						variablePatch("/spec/template/spec/datastore", "datastore"),
+						variablePatch("/spec/template/spec/network/devices/0/networkName", "network"),
+						variablePatch("/spec/template/spec/numCPUs", "numCPUs"),
+						variablePatch("/spec/template/spec/memoryMiB", "memoryMiB"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func variablePatch(path, variable string) clusterv1.JSONPatch {
+	return clusterv1.JSONPatch{
+		Op:   "add",
+		Path: path,
+		ValueFrom: &clusterv1.JSONPatchValue{
+			Variable: pointer.StringPtr(variable),
+		},
+	}
+}
+
+func templatePatch(path, template string) clusterv1.JSONPatch {
+	return clusterv1.JSONPatch{
+		Op:   "add",
+		Path: path,
+		ValueFrom: &clusterv1.JSONPatchValue{
+			Template: pointer.StringPtr(template),
+		},
+	}
+}
+
+func jsonValue(value interface{}) *apiextensionsv1.JSON {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return &apiextensionsv1.JSON{Raw: raw}
+}
+
+func newICSClusterTemplate() infrav1.ICSClusterTemplate {
+	icsCluster := newICSCluster()
+	icsCluster.Spec.IdentityRef.Name = clusterClassTemplateName("cloud-config")
+	return infrav1.ICSClusterTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: infrav1.GroupVersion.String(),
+			Kind:       util.TypeToKind(&infrav1.ICSClusterTemplate{}),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      env.ClusterClassNameVar,
+			Namespace: env.NamespaceVar,
+		},
+		Spec: infrav1.ICSClusterTemplateSpec{
+			Template: infrav1.ICSClusterTemplateResource{
+				Spec: icsCluster.Spec,
+			},
+		},
+	}
+}
+
+// newClusterClassMachineTemplate returns a machine template of the
+// ClusterClass. Its identityRef is patched per cluster.
+func newClusterClassMachineTemplate(templateName string) infrav1.ICSMachineTemplate {
+	template := newICSMachineTemplate(templateName)
+	template.Spec.Template.Spec.IdentityRef.Name = clusterClassTemplateName("cloud-config")
+	return template
+}
+
+func newKubeadmControlPlaneTemplate(templateName string) controlplanev1.KubeadmControlPlaneTemplate {
+	return controlplanev1.KubeadmControlPlaneTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: controlplanev1.GroupVersion.String(),
+			Kind:       util.TypeToKind(&controlplanev1.KubeadmControlPlaneTemplate{}),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      templateName,
+			Namespace: env.NamespaceVar,
+		},
+		Spec: controlplanev1.KubeadmControlPlaneTemplateSpec{
+			Template: controlplanev1.KubeadmControlPlaneTemplateResource{
+				Spec: controlplanev1.KubeadmControlPlaneTemplateResourceSpec{
+					KubeadmConfigSpec: defaultKubeadmInitSpec(nil),
+				},
+			},
+		},
+	}
+}
+
+func newClusterTopology() clusterv1.Cluster {
+	return clusterv1.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clusterv1.GroupVersion.String(),
+			Kind:       util.TypeToKind(&clusterv1.Cluster{}),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      env.ClusterNameVar,
+			Namespace: env.NamespaceVar,
+			Labels:    clusterLabels(),
+		},
+		Spec: clusterv1.ClusterSpec{
+			ClusterNetwork: &clusterv1.ClusterNetwork{
+				Pods: &clusterv1.NetworkRanges{
+					CIDRBlocks: []string{env.DefaultClusterCIDR},
+				},
+			},
+			Topology: &clusterv1.Topology{
+				Class:   env.ClusterClassNameVar,
+				Version: env.KubernetesVersionVar,
+				ControlPlane: clusterv1.ControlPlaneTopology{
+					Replicas: pointer.Int32Ptr(444),
+				},
+				Workers: &clusterv1.WorkersTopology{
+					MachineDeployments: []clusterv1.MachineDeploymentTopology{
+						{
+							Class: workerClassName,
+							Name:  "md-0",
+						},
+					},
+				},
+				Variables: []clusterv1.ClusterVariable{
+					{Name: "template", Value: *jsonValue(env.ICSTemplateVar)},
+					{Name: "datastore", Value: *jsonValue(env.ICSDatastoreVar)},
+					{Name: "network", Value: *jsonValue(env.ICSNetworkVar)},
+					{Name: "numCPUs", Value: *jsonValue(env.DefaultNumCPUs)},
+					{Name: "memoryMiB", Value: *jsonValue(env.DefaultMemoryMiB)},
+				},
+			},
+		},
+	}
+}
+
+func clusterClassTemplateName(suffix string) string {
+	return fmt.Sprintf("%s-%s", env.ClusterClassNameVar, suffix)
+}
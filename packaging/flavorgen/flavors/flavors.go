@@ -71,3 +71,35 @@ func MultiNodeTemplateWithLoadBalancer() []runtime.Object {
 
 	return MultiNodeTemplate
 }
+
+func ClusterClass() []runtime.Object {
+	icsClusterTemplate := newICSClusterTemplate()
+	controlPlaneTemplate := newKubeadmControlPlaneTemplate(clusterClassTemplateName("control-plane"))
+	controlPlaneMachineTemplate := newClusterClassMachineTemplate(clusterClassTemplateName("control-plane"))
+	workerMachineTemplate := newClusterClassMachineTemplate(clusterClassTemplateName(workerClassName))
+	workerBootstrapTemplate := newKubeadmWorkConfigTemplate(clusterClassTemplateName(workerClassName), true)
+	clusterClass := newClusterClass(icsClusterTemplate, controlPlaneTemplate, controlPlaneMachineTemplate, workerMachineTemplate, workerBootstrapTemplate)
+
+	ClusterClassTemplate := []runtime.Object{
+		&clusterClass,
+		&icsClusterTemplate,
+		&controlPlaneTemplate,
+		&controlPlaneMachineTemplate,
+		&workerMachineTemplate,
+		&workerBootstrapTemplate,
+	}
+
+	return ClusterClassTemplate
+}
+
+func ClusterTopology() []runtime.Object {
+	cluster := newClusterTopology()
+	identitySecret := newIdentitySecret()
+
+	ClusterTopologyTemplate := []runtime.Object{
+		&cluster,
+		&identitySecret,
+	}
+
+	return ClusterTopologyTemplate
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavors
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+// TestFlavorsPassValidation runs the infrastructure objects of every flavor
+// through the validating webhooks, together with an ICSVM cloned from each
+// machine template the way the machine controller creates them.
+func TestFlavorsPassValidation(t *testing.T) {
+	flavors := map[string]func() []runtime.Object{
+		"default":      MultiNodeTemplateWithOutLoadBalancer,
+		"loadbalancer": MultiNodeTemplateWithLoadBalancer,
+		"clusterclass": ClusterClass,
+		"topology":     ClusterTopology,
+	}
+
+	for name, flavor := range flavors {
+		flavor := flavor
+		t.Run(name, func(t *testing.T) {
+			for _, obj := range flavor() {
+				var err error
+				switch obj := obj.(type) {
+				case *infrav1.ICSCluster:
+					err = obj.ValidateCreate()
+				case *infrav1.ICSClusterTemplate:
+					err = obj.ValidateCreate()
+				case *infrav1.ICSMachineTemplate:
+					if err = obj.ValidateCreate(); err != nil {
+						break
+					}
+					icsVM := &infrav1.ICSVM{
+						Spec: infrav1.ICSVMSpec{
+							VirtualMachineCloneSpec: obj.Spec.Template.Spec.VirtualMachineCloneSpec,
+						},
+					}
+					err = icsVM.ValidateCreate()
+				default:
+					continue
+				}
+				if err != nil {
+					t.Errorf("%T failed validation: %v", obj, err)
+				}
+			}
+		})
+	}
+}
@@ -155,11 +155,15 @@ func defaultVirtualMachineCloneSpec() infrav1.VirtualMachineCloneSpec {
 			Devices: []infrav1.NetworkDeviceSpec{
 				{
 					NetworkName: env.ICSNetworkVar,
+					SwitchType:  infrav1.NormalSwitch,
 					DHCP4:       true,
 					DHCP6:       false,
 				},
 			},
 		},
+		Disks: []infrav1.DiskSpec{
+			{DiskSize: 40},
+		},
 		CloneMode:         infrav1.LinkedClone,
 		NumCPUs:           2,
 		MemoryMiB:         8192,
@@ -79,6 +79,12 @@ var (
 			Value:     env.DefaultNumCPUs,
 			FieldPath: []string{"spec", "template", "spec", "numCPUs"},
 		},
+		{
+			Kind:      "Cluster",
+			Name:      "${CLUSTER_NAME}",
+			Value:     env.ControlPlaneMachineCountVar,
+			FieldPath: []string{"spec", "topology", "controlPlane", "replicas"},
+		},
 		{
 			Kind:      "Secret",
 			Name:      "${CLUSTER_NAME}-cloud-config",
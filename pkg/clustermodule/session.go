@@ -28,6 +28,7 @@ import (
 	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/identity"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
 )
 
@@ -93,5 +94,8 @@ func fetchMachineTemplate(ctx *context.ClusterContext, input Wrapper, templateNa
 	}, template); err != nil {
 		return nil, err
 	}
+	// Templates inherit the cloud and identity they leave empty from the
+	// cluster.
+	services.SetMachineDefaults(&template.Spec.Template.Spec.VirtualMachineCloneSpec, ctx.ICSCluster)
 	return template, nil
 }
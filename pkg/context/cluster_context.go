@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/patch"
 
 	"github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
 )
 
 // ClusterContext is a Go context used with a ICSCluster.
@@ -33,6 +34,7 @@ type ClusterContext struct {
 	ICSCluster  *v1beta1.ICSCluster
 	PatchHelper *patch.Helper
 	Logger      logr.Logger
+	Session     *session.Session
 }
 
 // String returns ICSClusterGroupVersionKind ICSClusterNamespace/ICSClusterName.
@@ -44,3 +46,13 @@ func (c *ClusterContext) String() string {
 func (c *ClusterContext) Patch() error {
 	return c.PatchHelper.Patch(c, c.ICSCluster)
 }
+
+// GetLogger returns this context's logger.
+func (c *ClusterContext) GetLogger() logr.Logger {
+	return c.Logger
+}
+
+// GetSession returns this context's session.
+func (c *ClusterContext) GetSession() *session.Session {
+	return c.Session
+}
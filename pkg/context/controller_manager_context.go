@@ -78,6 +78,22 @@ type ControllerManagerContext struct {
 	// in keepalive handler
 	KeepAliveDuration time.Duration
 
+	// OrphanGCInterval is the interval at which VMs and IPAddresses that are
+	// no longer backed by an ICSVM are looked for. Zero disables the
+	// garbage collector.
+	OrphanGCInterval time.Duration
+
+	// OrphanGCGracePeriod is how long an orphan must have been observed
+	// before it is deleted.
+	OrphanGCGracePeriod time.Duration
+
+	// OrphanGCDryRun reports orphans without ever deleting them.
+	OrphanGCDryRun bool
+
+	// InventoryValidation is how new machines referencing inventory that
+	// does not exist in iCenter are handled.
+	InventoryValidation string
+
 	genericEventCache sync.Map
 }
 
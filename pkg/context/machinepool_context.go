@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package context
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/patch"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+// MachinePoolContext is a Go context used with an ICSMachinePool.
+type MachinePoolContext struct {
+	*ControllerContext
+	Cluster        *clusterv1.Cluster
+	MachinePool    *expv1.MachinePool
+	ICSCluster     *infrav1.ICSCluster
+	ICSMachinePool *infrav1.ICSMachinePool
+	PatchHelper    *patch.Helper
+	Logger         logr.Logger
+}
+
+// String returns ICSMachinePoolGroupVersionKind ICSMachinePoolNamespace/ICSMachinePoolName.
+func (c *MachinePoolContext) String() string {
+	return fmt.Sprintf("%s %s/%s", c.ICSMachinePool.GroupVersionKind(), c.ICSMachinePool.Namespace, c.ICSMachinePool.Name)
+}
+
+// Patch updates the object and its status on the API server.
+func (c *MachinePoolContext) Patch() error {
+	return c.PatchHelper.Patch(c, c.ICSMachinePool)
+}
+
+// GetLogger returns this context's logger.
+func (c *MachinePoolContext) GetLogger() logr.Logger {
+	return c.Logger
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package context
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/cluster-api/util/patch"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
+)
+
+// VMImageContext is a Go context used with an ICSVMImage.
+type VMImageContext struct {
+	*ControllerContext
+	ICSVMImage  *infrav1.ICSVMImage
+	PatchHelper *patch.Helper
+	Logger      logr.Logger
+	Session     *session.Session
+}
+
+// String returns ICSVMImageGroupVersionKind ICSVMImageNamespace/ICSVMImageName.
+func (c *VMImageContext) String() string {
+	return fmt.Sprintf("%s %s/%s", c.ICSVMImage.GroupVersionKind(), c.ICSVMImage.Namespace, c.ICSVMImage.Name)
+}
+
+// Patch updates the object and its status on the API server.
+func (c *VMImageContext) Patch() error {
+	return c.PatchHelper.Patch(c, c.ICSVMImage)
+}
+
+// GetLogger returns this context's logger.
+func (c *VMImageContext) GetLogger() logr.Logger {
+	return c.Logger
+}
+
+// GetSession returns this context's session.
+func (c *VMImageContext) GetSession() *session.Session {
+	return c.Session
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	basecltv1 "github.com/ics-sigs/ics-go-sdk/cluster"
+	basenetv1 "github.com/ics-sigs/ics-go-sdk/network"
+	basestv1 "github.com/ics-sigs/ics-go-sdk/storage"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/identity"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/image"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/template"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
+)
+
+const (
+	// DefaultCacheTTL is how long the objects looked up in iCenter are
+	// remembered.
+	DefaultCacheTTL = 5 * time.Minute
+
+	// cacheSize is the maximum number of lookups that are remembered.
+	cacheSize = 1024
+
+	gibibyte = 1024 * 1024 * 1024
+)
+
+// Checker resolves the inventory objects referenced by a clone spec in
+// iCenter. The lookups are cached, so that validating many machines of the
+// same cloud does not query iCenter for each of them.
+type Checker struct {
+	// Client is used to read the identity secrets.
+	Client client.Client
+
+	// KeepAliveDuration is the keep alive duration of the sessions created
+	// by the checker.
+	KeepAliveDuration time.Duration
+
+	// CacheTTL is how long lookups are cached. Defaults to DefaultCacheTTL.
+	CacheTTL time.Duration
+
+	cache *cache.LRUExpireCache
+}
+
+// NewChecker returns a Checker that reads identities with the given client.
+func NewChecker(c client.Client, keepAliveDuration time.Duration) *Checker {
+	return &Checker{
+		Client:            c,
+		KeepAliveDuration: keepAliveDuration,
+		CacheTTL:          DefaultCacheTTL,
+		cache:             cache.NewLRUExpireCache(cacheSize),
+	}
+}
+
+// sourceInfo is what is remembered of the template or OVA image of a clone.
+type sourceInfo struct {
+	found bool
+	// diskSizes are the sizes of the disks of the source in bytes.
+	diskSizes []int64
+}
+
+// reference is the name of an inventory object and the field it is
+// referenced by.
+type reference struct {
+	path *field.Path
+	name string
+}
+
+// checkContext carries the session of a validation to the goclient helpers.
+type checkContext struct {
+	context.Context
+	logger  logr.Logger
+	session *session.Session
+}
+
+func (c *checkContext) GetLogger() logr.Logger {
+	return c.logger
+}
+
+func (c *checkContext) GetSession() *session.Session {
+	return c.session
+}
+
+// Session returns a session for the cloud and identity. A nil identity
+// reuses a session created by the controllers for the cloud.
+func (c *Checker) Session(ctx context.Context, namespace, cloudName string, identityRef *infrav1.ICSIdentityReference) (*session.Session, error) {
+	iCenter, err := identity.NewClientFromMachine(ctx, c.Client, namespace, cloudName, identityRef)
+	if err != nil {
+		return nil, err
+	}
+	if iCenter.AuthInfo == nil {
+		return session.Get(ctx, cloudName)
+	}
+
+	params := session.NewParams().
+		WithCloudName(cloudName).
+		WithServer(iCenter.ICenterURL).
+		WithUserInfo(iCenter.AuthInfo.Username, iCenter.AuthInfo.Password).
+		WithAPIVersion(iCenter.APIVersion).
+		WithFeatures(session.Feature{
+			KeepAliveDuration: c.KeepAliveDuration,
+		})
+	return session.GetOrCreate(ctx, params)
+}
+
+// Validate resolves the template, datastores, networks and compute cluster
+// of the clone spec in iCenter. It returns a field error for each of them
+// that does not exist, and for each disk that is smaller than the disk of
+// the template it is cloned from. An error is returned when iCenter could
+// not be queried.
+func (c *Checker) Validate(ctx context.Context, logger logr.Logger, icsSession *session.Session, cloudName string, spec *infrav1.VirtualMachineCloneSpec, fldPath *field.Path) (field.ErrorList, error) {
+	var allErrs field.ErrorList
+	checkCtx := &checkContext{Context: ctx, logger: logger, session: icsSession}
+
+	if spec.Template != "" {
+		source, err := c.source(checkCtx, cloudName, spec)
+		if err != nil {
+			return nil, err
+		}
+		if !source.found {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("template"), spec.Template))
+		} else {
+			for i, disk := range spec.Disks {
+				if i >= len(source.diskSizes) || disk.DiskSize <= 0 {
+					continue
+				}
+				if int64(disk.DiskSize)*gibibyte < source.diskSizes[i] {
+					allErrs = append(allErrs, field.Invalid(fldPath.Child("disks").Index(i).Child("diskSize"), disk.DiskSize,
+						fmt.Sprintf("must not be smaller than the %d GiB disk of template %q", source.diskSizes[i]/gibibyte, spec.Template)))
+				}
+			}
+		}
+	}
+
+	var dataStoreRefs []reference
+	if spec.Datastore != "" {
+		dataStoreRefs = append(dataStoreRefs, reference{fldPath.Child("datastore"), spec.Datastore})
+	}
+	for i, disk := range spec.Disks {
+		if disk.Datastore != "" {
+			dataStoreRefs = append(dataStoreRefs, reference{fldPath.Child("disks").Index(i).Child("datastore"), disk.Datastore})
+		}
+	}
+	if len(dataStoreRefs) > 0 {
+		dataStores, err := c.dataStores(checkCtx, cloudName)
+		if err != nil {
+			return nil, err
+		}
+		for _, ref := range dataStoreRefs {
+			if !dataStores[ref.name] {
+				allErrs = append(allErrs, field.NotFound(ref.path, ref.name))
+			}
+		}
+	}
+
+	var networkRefs []reference
+	for i, device := range spec.Network.Devices {
+		// Devices of external SDN networks are referenced by ID.
+		if device.SwitchType != infrav1.NormalSwitch && device.SwitchType != infrav1.SDNSwitch {
+			continue
+		}
+		if device.NetworkName != "" {
+			networkRefs = append(networkRefs, reference{fldPath.Child("network", "devices").Index(i).Child("networkName"), device.NetworkName})
+		}
+	}
+	if len(networkRefs) > 0 {
+		networks, err := c.networks(checkCtx, cloudName)
+		if err != nil {
+			return nil, err
+		}
+		for _, ref := range networkRefs {
+			if !networks[ref.name] {
+				allErrs = append(allErrs, field.NotFound(ref.path, ref.name))
+			}
+		}
+	}
+
+	if spec.Cluster != "" {
+		clusters, err := c.clusters(checkCtx, cloudName)
+		if err != nil {
+			return nil, err
+		}
+		if !clusters[spec.Cluster] {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("cluster"), spec.Cluster))
+		}
+	}
+
+	return allErrs, nil
+}
+
+// lookup returns the cached value of the key, or caches the value returned
+// by find. Errors are not cached.
+func (c *Checker) lookup(key string, find func() (interface{}, error)) (interface{}, error) {
+	if value, ok := c.cache.Get(key); ok {
+		return value, nil
+	}
+	value, err := find()
+	if err != nil {
+		return nil, err
+	}
+	ttl := c.CacheTTL
+	if ttl == 0 {
+		ttl = DefaultCacheTTL
+	}
+	c.cache.Add(key, value, ttl)
+	return value, nil
+}
+
+// source looks up the template, or the OVA image when the clone mode is
+// ImportVM, the virtual machines of the clone spec are created from.
+func (c *Checker) source(ctx *checkContext, cloudName string, spec *infrav1.VirtualMachineCloneSpec) (*sourceInfo, error) {
+	key := fmt.Sprintf("%s/source/%s/%s/%s", cloudName, spec.CloneMode, spec.Cluster, spec.Template)
+	value, err := c.lookup(key, func() (interface{}, error) {
+		if spec.CloneMode == infrav1.ImportVM {
+			ovaImage, err := image.FindOvaImageByName(ctx, spec.Template)
+			if err != nil {
+				return nil, err
+			}
+			if ovaImage == nil {
+				return &sourceInfo{}, nil
+			}
+		} else {
+			tpl, err := template.FindTemplate(ctx, spec.Template)
+			if err != nil {
+				return nil, err
+			}
+			if tpl == nil {
+				return &sourceInfo{}, nil
+			}
+		}
+		vm, err := template.FindSourceVM(ctx, spec)
+		if err != nil {
+			return nil, err
+		}
+		info := &sourceInfo{found: true}
+		for _, disk := range vm.Disks {
+			size := int64(disk.Volume.SizeInByte)
+			if size <= 0 {
+				size = int64(disk.Volume.Size * gibibyte)
+			}
+			info.diskSizes = append(info.diskSizes, size)
+		}
+		return info, nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to look up template %q", spec.Template)
+	}
+	return value.(*sourceInfo), nil
+}
+
+// dataStores returns the names of the datastores of the cloud.
+func (c *Checker) dataStores(ctx *checkContext, cloudName string) (map[string]bool, error) {
+	value, err := c.lookup(cloudName+"/datastores", func() (interface{}, error) {
+		storageService := basestv1.NewStorageService(ctx.GetSession().Client)
+		dataStores, err := storageService.GetStoragesList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names := make(map[string]bool, len(dataStores))
+		for _, dataStore := range dataStores {
+			names[dataStore.Name] = true
+		}
+		return names, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list datastores")
+	}
+	return value.(map[string]bool), nil
+}
+
+// networks returns the names of the networks of the cloud.
+func (c *Checker) networks(ctx *checkContext, cloudName string) (map[string]bool, error) {
+	value, err := c.lookup(cloudName+"/networks", func() (interface{}, error) {
+		networkService := basenetv1.NewNetworkService(ctx.GetSession().Client)
+		networks, err := networkService.GetNetworkList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names := make(map[string]bool, len(networks))
+		for _, network := range networks {
+			names[network.Name] = true
+		}
+		return names, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list networks")
+	}
+	return value.(map[string]bool), nil
+}
+
+// clusters returns the IDs and names of the compute clusters of the cloud.
+func (c *Checker) clusters(ctx *checkContext, cloudName string) (map[string]bool, error) {
+	value, err := c.lookup(cloudName+"/clusters", func() (interface{}, error) {
+		clusterService := basecltv1.NewClusterService(ctx.GetSession().Client)
+		clusters, err := clusterService.GetClusterList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := make(map[string]bool, 2*len(clusters))
+		for _, cluster := range clusters {
+			ids[cluster.Id] = true
+			ids[cluster.Name] = true
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list compute clusters")
+	}
+	return value.(map[string]bool), nil
+}
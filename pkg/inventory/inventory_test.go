@@ -0,0 +1,153 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+// newCachedChecker returns a Checker whose lookups of the cloud are all
+// cached, so that validating does not query iCenter.
+func newCachedChecker() *Checker {
+	c := NewChecker(nil, 0)
+	c.cache.Add("cloud/source//cluster-1/ubuntu", &sourceInfo{found: true, diskSizes: []int64{20 * gibibyte, 10 * gibibyte}}, DefaultCacheTTL)
+	c.cache.Add("cloud/source//cluster-1/missing", &sourceInfo{}, DefaultCacheTTL)
+	c.cache.Add("cloud/datastores", map[string]bool{"fast": true}, DefaultCacheTTL)
+	c.cache.Add("cloud/networks", map[string]bool{"vlan10": true}, DefaultCacheTTL)
+	c.cache.Add("cloud/clusters", map[string]bool{"cluster-1": true, "id-1": true}, DefaultCacheTTL)
+	return c
+}
+
+func TestValidate(t *testing.T) {
+	validSpec := infrav1.VirtualMachineCloneSpec{
+		Template:  "ubuntu",
+		Cluster:   "cluster-1",
+		Datastore: "fast",
+		Disks:     []infrav1.DiskSpec{{DiskSize: 20}, {DiskSize: 0, Datastore: "fast"}, {DiskSize: 100}},
+		Network: infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{
+			{NetworkName: "vlan10", SwitchType: infrav1.NormalSwitch},
+			{NetworkName: "ext-net-id", SwitchType: infrav1.ExtSDNSwitch},
+		}},
+	}
+
+	testCases := []struct {
+		name     string
+		modify   func(spec *infrav1.VirtualMachineCloneSpec)
+		expected []string
+	}{
+		{
+			name:   "valid",
+			modify: func(spec *infrav1.VirtualMachineCloneSpec) {},
+		},
+		{
+			name: "missing template",
+			modify: func(spec *infrav1.VirtualMachineCloneSpec) {
+				spec.Template = "missing"
+			},
+			expected: []string{"spec.template"},
+		},
+		{
+			name: "disk smaller than the template",
+			modify: func(spec *infrav1.VirtualMachineCloneSpec) {
+				spec.Disks[0].DiskSize = 10
+			},
+			expected: []string{"spec.disks[0].diskSize"},
+		},
+		{
+			name: "missing datastores",
+			modify: func(spec *infrav1.VirtualMachineCloneSpec) {
+				spec.Datastore = "slow"
+				spec.Disks[1].Datastore = "slow"
+			},
+			expected: []string{"spec.datastore", "spec.disks[1].datastore"},
+		},
+		{
+			name: "missing network",
+			modify: func(spec *infrav1.VirtualMachineCloneSpec) {
+				spec.Network.Devices[0].NetworkName = "vlan20"
+			},
+			expected: []string{"spec.network.devices[0].networkName"},
+		},
+		{
+			name: "compute cluster by ID",
+			modify: func(spec *infrav1.VirtualMachineCloneSpec) {
+				spec.Template = ""
+				spec.Cluster = "id-1"
+			},
+		},
+		{
+			name: "missing compute cluster",
+			modify: func(spec *infrav1.VirtualMachineCloneSpec) {
+				spec.Template = ""
+				spec.Cluster = "cluster-2"
+			},
+			expected: []string{"spec.cluster"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			spec := validSpec.DeepCopy()
+			tc.modify(spec)
+			allErrs, err := newCachedChecker().Validate(context.Background(), log, nil, "cloud", spec, field.NewPath("spec"))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(allErrs) != len(tc.expected) {
+				t.Fatalf("got errors %v, want errors for %v", allErrs, tc.expected)
+			}
+			for i, fieldErr := range allErrs {
+				if fieldErr.Field != tc.expected[i] {
+					t.Errorf("got error %d for %q, want %q", i, fieldErr.Field, tc.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	c := NewChecker(nil, 0)
+	calls := 0
+	find := func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("iCenter is unreachable")
+		}
+		return calls, nil
+	}
+
+	if _, err := c.lookup("key", find); err == nil {
+		t.Fatal("expected the error of the lookup")
+	}
+	value, err := c.lookup("key", find)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value != 2 {
+		t.Errorf("got %v, want the failed lookup to be retried", value)
+	}
+	if value, _ := c.lookup("key", find); value != 2 || calls != 2 {
+		t.Errorf("got %v after %d calls, want the cached value", value, calls)
+	}
+}
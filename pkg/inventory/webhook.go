@@ -0,0 +1,197 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	clusterutilv1 "sigs.k8s.io/cluster-api/util"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+// Mode is how the problems found in the inventory are reported.
+type Mode string
+
+const (
+	// ModeDisabled does not validate against the inventory.
+	ModeDisabled = Mode("")
+
+	// ModeWarn admits the objects and reports the problems as warnings.
+	ModeWarn = Mode("Warn")
+
+	// ModeReject rejects the objects that reference missing inventory.
+	ModeReject = Mode("Reject")
+)
+
+const webhookPath = "/validate-infrastructure-cluster-x-k8s-io-v1beta1-inventory"
+
+// log is for logging in this package.
+var log = logf.Log.WithName("inventory-webhook")
+
+// +kubebuilder:webhook:verbs=create,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-inventory,mutating=false,failurePolicy=ignore,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsmachines;icsmachinetemplates;icsvms,versions=v1beta1,name=inventory.validation.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// Webhook validates the inventory objects referenced by new ICSMachines,
+// ICSMachineTemplates and ICSVMs against iCenter.
+type Webhook struct {
+	Client  client.Client
+	Mode    Mode
+	Checker *Checker
+
+	decoder *admission.Decoder
+}
+
+var _ admission.Handler = &Webhook{}
+
+// SetupWebhookWithManager registers the inventory webhook with the manager.
+// The webhook admits everything when the mode is ModeDisabled.
+func SetupWebhookWithManager(mgr ctrl.Manager, mode Mode, keepAliveDuration time.Duration) error {
+	switch mode {
+	case ModeDisabled, ModeWarn, ModeReject:
+	default:
+		return errors.Errorf("invalid inventory validation mode %q, must be one of %q, %q or %q", mode, ModeDisabled, ModeWarn, ModeReject)
+	}
+	mgr.GetWebhookServer().Register(webhookPath, &webhook.Admission{
+		Handler: &Webhook{
+			Client:  mgr.GetClient(),
+			Mode:    mode,
+			Checker: NewChecker(mgr.GetClient(), keepAliveDuration),
+		},
+	})
+	return nil
+}
+
+// InjectDecoder injects the decoder of the admission requests.
+func (w *Webhook) InjectDecoder(d *admission.Decoder) error {
+	w.decoder = d
+	return nil
+}
+
+// Handle validates the inventory of the object of the request.
+func (w *Webhook) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if w.Mode == ModeDisabled || req.Operation != admissionv1.Create {
+		return admission.Allowed("")
+	}
+
+	var (
+		obj     client.Object
+		spec    *infrav1.VirtualMachineCloneSpec
+		fldPath *field.Path
+	)
+	switch req.Kind.Kind {
+	case "ICSMachine":
+		machine := &infrav1.ICSMachine{}
+		obj, spec, fldPath = machine, &machine.Spec.VirtualMachineCloneSpec, field.NewPath("spec")
+	case "ICSMachineTemplate":
+		machineTemplate := &infrav1.ICSMachineTemplate{}
+		obj, spec, fldPath = machineTemplate, &machineTemplate.Spec.Template.Spec.VirtualMachineCloneSpec, field.NewPath("spec", "template", "spec")
+	case "ICSVM":
+		vm := &infrav1.ICSVM{}
+		obj, spec, fldPath = vm, &vm.Spec.VirtualMachineCloneSpec, field.NewPath("spec")
+	default:
+		return admission.Allowed("")
+	}
+	if err := w.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	logger := log.WithValues("kind", req.Kind.Kind, "namespace", req.Namespace, "name", obj.GetName())
+
+	cloudName, identityRef, err := w.identityOf(ctx, obj, spec)
+	if err != nil {
+		logger.V(4).Info("skipping inventory validation", "reason", err.Error())
+		return admission.Allowed("").WithWarnings(fmt.Sprintf("unable to validate against the inventory: %v", err))
+	}
+	icsSession, err := w.Checker.Session(ctx, req.Namespace, cloudName, identityRef)
+	if err != nil {
+		logger.Error(err, "unable to connect to iCenter")
+		return admission.Allowed("").WithWarnings(fmt.Sprintf("unable to validate against the inventory of cloud %q: %v", cloudName, err))
+	}
+	allErrs, err := w.Checker.Validate(ctx, logger, icsSession, cloudName, spec, fldPath)
+	if err != nil {
+		logger.Error(err, "unable to query iCenter")
+		return admission.Allowed("").WithWarnings(fmt.Sprintf("unable to validate against the inventory of cloud %q: %v", cloudName, err))
+	}
+	if len(allErrs) == 0 {
+		return admission.Allowed("")
+	}
+
+	if w.Mode == ModeWarn {
+		warnings := make([]string, 0, len(allErrs))
+		for _, fieldErr := range allErrs {
+			warnings = append(warnings, fieldErr.Error())
+		}
+		return admission.Allowed("").WithWarnings(warnings...)
+	}
+	status := apierrors.NewInvalid(obj.GetObjectKind().GroupVersionKind().GroupKind(), obj.GetName(), allErrs).Status()
+	return admission.Response{
+		AdmissionResponse: admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result:  &status,
+		},
+	}
+}
+
+// identityOf returns the cloud and identity of the object. Objects leaving
+// them empty are connected like the ICSCluster of the cluster they are
+// labeled with.
+func (w *Webhook) identityOf(ctx context.Context, obj client.Object, spec *infrav1.VirtualMachineCloneSpec) (string, *infrav1.ICSIdentityReference, error) {
+	cloudName, identityRef := spec.CloudName, spec.IdentityRef
+	if cloudName != "" && identityRef != nil {
+		return cloudName, identityRef, nil
+	}
+
+	if _, ok := obj.GetLabels()[clusterv1.ClusterLabelName]; !ok {
+		if cloudName == "" {
+			return "", nil, errors.New("the object has no cloud and is not labeled with a cluster")
+		}
+		return cloudName, identityRef, nil
+	}
+	meta := metav1.ObjectMeta{Namespace: obj.GetNamespace(), Labels: obj.GetLabels()}
+	cluster, err := clusterutilv1.GetClusterFromMetadata(ctx, w.Client, meta)
+	if err != nil {
+		return "", nil, err
+	}
+	if cluster.Spec.InfrastructureRef == nil {
+		return "", nil, errors.Errorf("cluster %s has no infrastructure", cluster.Name)
+	}
+	icsCluster := &infrav1.ICSCluster{}
+	icsClusterKey := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.InfrastructureRef.Name}
+	if err := w.Client.Get(ctx, icsClusterKey, icsCluster); err != nil {
+		return "", nil, errors.Wrapf(err, "failed to get ICSCluster %s", icsClusterKey)
+	}
+	if cloudName == "" {
+		cloudName = icsCluster.Spec.CloudName
+	}
+	if identityRef == nil {
+		identityRef = icsCluster.Spec.IdentityRef
+	}
+	return cloudName, identityRef, nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+func newTestScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+	return scheme
+}
+
+func TestHandleSkipped(t *testing.T) {
+	machine := &infrav1.ICSMachine{
+		TypeMeta:   metav1.TypeMeta{APIVersion: infrav1.GroupVersion.String(), Kind: "ICSMachine"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "machine"},
+	}
+	raw, err := json.Marshal(machine)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name      string
+		mode      Mode
+		operation admissionv1.Operation
+		kind      string
+		warned    bool
+	}{
+		{
+			name:      "disabled",
+			mode:      ModeDisabled,
+			operation: admissionv1.Create,
+			kind:      "ICSMachine",
+		},
+		{
+			name:      "update",
+			mode:      ModeReject,
+			operation: admissionv1.Update,
+			kind:      "ICSMachine",
+		},
+		{
+			name:      "other kind",
+			mode:      ModeReject,
+			operation: admissionv1.Create,
+			kind:      "ICSCluster",
+		},
+		{
+			name:      "no cloud and no cluster",
+			mode:      ModeReject,
+			operation: admissionv1.Create,
+			kind:      "ICSMachine",
+			warned:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := newTestScheme()
+			decoder, _ := admission.NewDecoder(scheme)
+			w := &Webhook{
+				Client:  fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Mode:    tc.mode,
+				Checker: NewChecker(nil, 0),
+			}
+			_ = w.InjectDecoder(decoder)
+
+			resp := w.Handle(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: tc.operation,
+				Kind:      metav1.GroupVersionKind{Group: infrav1.GroupVersion.Group, Version: infrav1.GroupVersion.Version, Kind: tc.kind},
+				Namespace: "default",
+				Object:    runtime.RawExtension{Raw: raw},
+			}})
+			if !resp.Allowed {
+				t.Fatalf("got %+v, want the object to be admitted", resp.Result)
+			}
+			if warned := len(resp.Warnings) > 0; warned != tc.warned {
+				t.Errorf("got warnings %v, want warned %t", resp.Warnings, tc.warned)
+			}
+		})
+	}
+}
+
+func TestIdentityOf(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+		Spec: clusterv1.ClusterSpec{
+			InfrastructureRef: &corev1.ObjectReference{Kind: "ICSCluster", Name: "test-ics"},
+		},
+	}
+	icsCluster := &infrav1.ICSCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-ics"},
+		Spec: infrav1.ICSClusterSpec{
+			CloudName:   "cluster-cloud",
+			IdentityRef: &infrav1.ICSIdentityReference{Kind: infrav1.SecretKind, Name: "cluster-identity"},
+		},
+	}
+	machineIdentity := &infrav1.ICSIdentityReference{Kind: infrav1.SecretKind, Name: "machine-identity"}
+
+	testCases := []struct {
+		name             string
+		labels           map[string]string
+		cloudName        string
+		identityRef      *infrav1.ICSIdentityReference
+		expectedCloud    string
+		expectedIdentity string
+		expectErr        bool
+	}{
+		{
+			name:             "set on the object",
+			labels:           map[string]string{clusterv1.ClusterLabelName: "test"},
+			cloudName:        "machine-cloud",
+			identityRef:      machineIdentity,
+			expectedCloud:    "machine-cloud",
+			expectedIdentity: "machine-identity",
+		},
+		{
+			name:             "from the cluster",
+			labels:           map[string]string{clusterv1.ClusterLabelName: "test"},
+			expectedCloud:    "cluster-cloud",
+			expectedIdentity: "cluster-identity",
+		},
+		{
+			name:             "cloud of the object and identity of the cluster",
+			labels:           map[string]string{clusterv1.ClusterLabelName: "test"},
+			cloudName:        "machine-cloud",
+			expectedCloud:    "machine-cloud",
+			expectedIdentity: "cluster-identity",
+		},
+		{
+			name:          "cloud without a cluster",
+			cloudName:     "machine-cloud",
+			expectedCloud: "machine-cloud",
+		},
+		{
+			name:      "no cloud and no cluster",
+			expectErr: true,
+		},
+		{
+			name:      "missing cluster",
+			labels:    map[string]string{clusterv1.ClusterLabelName: "other"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w := &Webhook{Client: fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster, icsCluster).Build()}
+			machine := &infrav1.ICSMachine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "machine", Labels: tc.labels},
+				Spec: infrav1.ICSMachineSpec{
+					VirtualMachineCloneSpec: infrav1.VirtualMachineCloneSpec{CloudName: tc.cloudName, IdentityRef: tc.identityRef},
+				},
+			}
+			cloudName, identityRef, err := w.identityOf(context.Background(), machine, &machine.Spec.VirtualMachineCloneSpec)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cloudName != tc.expectedCloud {
+				t.Errorf("got cloud %q, want %q", cloudName, tc.expectedCloud)
+			}
+			identityName := ""
+			if identityRef != nil {
+				identityName = identityRef.Name
+			}
+			if identityName != tc.expectedIdentity {
+				t.Errorf("got identity %q, want %q", identityName, tc.expectedIdentity)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package machinedefaults defaults the ICSMachines, ICSMachineTemplates and
+// ICSVMs of a cluster from the spec of its ICSCluster.
+package machinedefaults
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
+)
+
+const webhookPath = "/mutate-infrastructure-cluster-x-k8s-io-v1beta1-machinedefaults"
+
+// log is for logging in this package.
+var log = logf.Log.WithName("machinedefaults-webhook")
+
+// +kubebuilder:webhook:verbs=create,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta1-machinedefaults,mutating=true,failurePolicy=ignore,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=icsmachines;icsmachinetemplates;icsvms,versions=v1beta1,name=default.machinedefaults.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// Webhook fills the cloud, identity and placement that new ICSMachines,
+// ICSMachineTemplates and ICSVMs leave empty from the ICSCluster of the
+// cluster they are labeled with. Objects that are not labeled, or whose
+// cluster does not exist yet, are admitted unchanged and defaulted by the
+// controllers when their ICSVMs are created.
+type Webhook struct {
+	Client client.Client
+
+	decoder *admission.Decoder
+}
+
+var _ admission.Handler = &Webhook{}
+
+// SetupWebhookWithManager registers the machine defaults webhook with the
+// manager.
+func SetupWebhookWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(webhookPath, &webhook.Admission{
+		Handler: &Webhook{Client: mgr.GetClient()},
+	})
+	return nil
+}
+
+// InjectDecoder injects the decoder of the admission requests.
+func (w *Webhook) InjectDecoder(d *admission.Decoder) error {
+	w.decoder = d
+	return nil
+}
+
+// Handle defaults the object of the request from its ICSCluster.
+func (w *Webhook) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create {
+		return admission.Allowed("")
+	}
+
+	var (
+		obj  client.Object
+		spec *infrav1.VirtualMachineCloneSpec
+	)
+	switch req.Kind.Kind {
+	case "ICSMachine":
+		machine := &infrav1.ICSMachine{}
+		obj, spec = machine, &machine.Spec.VirtualMachineCloneSpec
+	case "ICSMachineTemplate":
+		machineTemplate := &infrav1.ICSMachineTemplate{}
+		obj, spec = machineTemplate, &machineTemplate.Spec.Template.Spec.VirtualMachineCloneSpec
+	case "ICSVM":
+		vm := &infrav1.ICSVM{}
+		obj, spec = vm, &vm.Spec.VirtualMachineCloneSpec
+	default:
+		return admission.Allowed("")
+	}
+	if err := w.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if _, ok := obj.GetLabels()[clusterv1.ClusterLabelName]; !ok {
+		return admission.Allowed("")
+	}
+	logger := log.WithValues("kind", req.Kind.Kind, "namespace", req.Namespace, "name", obj.GetName())
+
+	// The namespace is not always set in the body of the request.
+	objMeta := &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{Namespace: req.Namespace, Name: obj.GetName(), Labels: obj.GetLabels()},
+	}
+	icsCluster, err := util.GetICSClusterFromObject(ctx, w.Client, objMeta)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(4).Info("skipping defaulting, the cluster does not exist yet")
+			return admission.Allowed("")
+		}
+		logger.Error(err, "unable to get the ICSCluster")
+		return admission.Allowed("").WithWarnings(fmt.Sprintf("unable to default from the ICSCluster: %v", err))
+	}
+
+	services.SetMachineDefaults(spec, icsCluster)
+	marshaled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
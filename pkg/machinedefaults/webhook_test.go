@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinedefaults
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+func TestHandle(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+		Spec: clusterv1.ClusterSpec{
+			InfrastructureRef: &corev1.ObjectReference{Kind: "ICSCluster", Name: "test-ics"},
+		},
+	}
+	icsCluster := &infrav1.ICSCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-ics"},
+		Spec: infrav1.ICSClusterSpec{
+			CloudName:       "cluster-cloud",
+			MachineDefaults: &infrav1.MachineDefaults{Template: "ubuntu"},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		operation admissionv1.Operation
+		labels    map[string]string
+		template  string
+		expected  map[string]interface{}
+	}{
+		{
+			name:      "defaulted from the cluster",
+			operation: admissionv1.Create,
+			labels:    map[string]string{clusterv1.ClusterLabelName: "test"},
+			expected:  map[string]interface{}{"/spec/cloudName": "cluster-cloud", "/spec/template": "ubuntu"},
+		},
+		{
+			name:      "template of the machine",
+			operation: admissionv1.Create,
+			labels:    map[string]string{clusterv1.ClusterLabelName: "test"},
+			template:  "centos",
+			expected:  map[string]interface{}{"/spec/cloudName": "cluster-cloud"},
+		},
+		{
+			name:      "not labeled",
+			operation: admissionv1.Create,
+		},
+		{
+			name:      "cluster does not exist yet",
+			operation: admissionv1.Create,
+			labels:    map[string]string{clusterv1.ClusterLabelName: "other"},
+		},
+		{
+			name:      "update",
+			operation: admissionv1.Update,
+			labels:    map[string]string{clusterv1.ClusterLabelName: "test"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+			decoder, _ := admission.NewDecoder(scheme)
+			w := &Webhook{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, icsCluster).Build()}
+			_ = w.InjectDecoder(decoder)
+
+			machine := &infrav1.ICSMachine{
+				TypeMeta:   metav1.TypeMeta{APIVersion: infrav1.GroupVersion.String(), Kind: "ICSMachine"},
+				ObjectMeta: metav1.ObjectMeta{Name: "machine", Labels: tc.labels},
+				Spec: infrav1.ICSMachineSpec{
+					VirtualMachineCloneSpec: infrav1.VirtualMachineCloneSpec{Template: tc.template},
+				},
+			}
+			raw, err := json.Marshal(machine)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp := w.Handle(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: tc.operation,
+				Kind:      metav1.GroupVersionKind{Group: infrav1.GroupVersion.Group, Version: infrav1.GroupVersion.Version, Kind: "ICSMachine"},
+				Namespace: "default",
+				Object:    runtime.RawExtension{Raw: raw},
+			}})
+			if !resp.Allowed {
+				t.Fatalf("got %+v, want the object to be admitted", resp.Result)
+			}
+
+			patches := map[string]interface{}{}
+			for _, patch := range resp.Patches {
+				patches[patch.Path] = patch.Value
+			}
+			if len(patches) != len(tc.expected) {
+				t.Fatalf("got patches %v, want %v", patches, tc.expected)
+			}
+			for path, value := range tc.expected {
+				if patches[path] != value {
+					t.Errorf("got %s=%v, want %v", path, patches[path], value)
+				}
+			}
+		})
+	}
+}
@@ -36,4 +36,8 @@ const (
 
 	// DefaultLeaderElectionID is the default value for the eponymous manager option.
 	DefaultLeaderElectionID = DefaultPodName + "-runtime"
+
+	// DefaultOrphanGCGracePeriod is the default value for the eponymous
+	// manager option.
+	DefaultOrphanGCGracePeriod = time.Hour
 )
@@ -26,10 +26,12 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
 	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	infrav1a4 "github.com/ics-sigs/cluster-api-provider-ics/api/v1alpha4"
 	infrav1b1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	infrav1b2 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta2"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/record"
 )
@@ -51,8 +53,10 @@ func New(opts Options) (Manager, error) {
 	_ = clusterv1.AddToScheme(opts.Scheme)
 	_ = infrav1a4.AddToScheme(opts.Scheme)
 	_ = infrav1b1.AddToScheme(opts.Scheme)
+	_ = infrav1b2.AddToScheme(opts.Scheme)
 	_ = controlplanev1.AddToScheme(opts.Scheme)
 	_ = bootstrapv1.AddToScheme(opts.Scheme)
+	_ = expv1.AddToScheme(opts.Scheme)
 	// +kubebuilder:scaffold:scheme
 
 	podName, err := os.Hostname()
@@ -81,6 +85,10 @@ func New(opts Options) (Manager, error) {
 		Scheme:                  opts.Scheme,
 		EnableKeepAlive:         opts.EnableKeepAlive,
 		KeepAliveDuration:       opts.KeepAliveDuration,
+		OrphanGCInterval:        opts.OrphanGCInterval,
+		OrphanGCGracePeriod:     opts.OrphanGCGracePeriod,
+		OrphanGCDryRun:          opts.OrphanGCDryRun,
+		InventoryValidation:     opts.InventoryValidation,
 	}
 
 	// Add the requested items to the manager.
@@ -63,6 +63,25 @@ type Options struct {
 	// in keepalive handler
 	KeepAliveDuration time.Duration
 
+	// OrphanGCInterval is the interval at which VMs and IPAddresses that are
+	// no longer backed by an ICSVM are looked for. Zero disables the
+	// garbage collector.
+	OrphanGCInterval time.Duration
+
+	// OrphanGCGracePeriod is how long an orphan must have been observed
+	// before it is deleted.
+	//
+	// Defaults to the eponymous constant in this package.
+	OrphanGCGracePeriod time.Duration
+
+	// OrphanGCDryRun reports orphans without ever deleting them.
+	OrphanGCDryRun bool
+
+	// InventoryValidation is how new machines referencing inventory that
+	// does not exist in iCenter are handled: "" to not validate them,
+	// "Warn" or "Reject".
+	InventoryValidation string
+
 	KubeConfig *rest.Config
 
 	// AddToManager is a function that can be optionally specified with
@@ -80,6 +99,10 @@ func (o *Options) defaults() {
 		o.PodName = DefaultPodName
 	}
 
+	if o.OrphanGCGracePeriod == 0 {
+		o.OrphanGCGracePeriod = DefaultOrphanGCGracePeriod
+	}
+
 	if o.KubeConfig == nil {
 		o.KubeConfig = config.GetConfigOrDie()
 	}
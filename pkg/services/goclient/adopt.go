@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructure
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+)
+
+// isAdoptionRequested returns true if the ICSVM should take over a
+// pre-existing VM instead of cloning a new one. Adoption is requested by the
+// AdoptVMAnnotation, or by creating the ICSVM with Spec.UID already set. In
+// the latter case the BIOS UUID is still empty, since the controller always
+// records both IDs together once it has created a VM.
+func isAdoptionRequested(ctx *context.VMContext) bool {
+	if conditions.Has(ctx.ICSVM, infrav1.VMAdoptedCondition) {
+		return true
+	}
+	if _, ok := ctx.ICSVM.Annotations[infrav1.AdoptVMAnnotation]; ok {
+		return true
+	}
+	return ctx.ICSVM.Spec.UID != "" && ctx.ICSVM.Spec.BiosUUID == ""
+}
+
+// prepareAdoption marks the ICSVM as adopting and seeds Spec.UID from the
+// AdoptVMAnnotation so that findVM looks the VM up by its ID.
+func prepareAdoption(ctx *context.VMContext) {
+	if id := ctx.ICSVM.Annotations[infrav1.AdoptVMAnnotation]; id != "" && ctx.ICSVM.Spec.UID == "" && ctx.ICSVM.Spec.BiosUUID == "" {
+		ctx.ICSVM.Spec.UID = id
+	}
+	if !conditions.Has(ctx.ICSVM, infrav1.VMAdoptedCondition) {
+		conditions.MarkFalse(ctx.ICSVM, infrav1.VMAdoptedCondition, infrav1.AdoptingReason, clusterv1.ConditionSeverityInfo, "")
+	}
+}
+
+// reconcileAdoption validates that the VM found for the ICSVM matches its
+// spec before the VM is brought under management.
+func (vms *VMService) reconcileAdoption(ctx *virtualMachineContext) error {
+	vmObj, err := ctx.Obj.GetVM(ctx, ctx.Ref.Value)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get vm %s to adopt", ctx.Ref.Value)
+	}
+
+	// Only the compute and network configuration is validated, the disks of
+	// a legacy VM are left as they are.
+	spec := &ctx.ICSVM.Spec.VirtualMachineCloneSpec
+	mismatches := append(diffCompute(spec, vmObj), diffNetworks(spec, vmObj)...)
+	if len(mismatches) > 0 {
+		msg := strings.Join(mismatches, "; ")
+		conditions.MarkFalse(ctx.ICSVM, infrav1.VMAdoptedCondition, infrav1.AdoptionValidationFailedReason, clusterv1.ConditionSeverityError, msg)
+		return errors.Errorf("vm %s does not match the spec of %s: %s", vmObj.ID, ctx, msg)
+	}
+
+	ctx.Logger.Info("adopted existing vm", "vm-id", vmObj.ID, "vm-name", vmObj.Name)
+	ctx.Recorder.Eventf(ctx.ICSVM, "AdoptedVM", "Adopted existing VM %s (%s)", vmObj.Name, vmObj.ID)
+	conditions.MarkTrue(ctx.ICSVM, infrav1.VMAdoptedCondition)
+	return nil
+}
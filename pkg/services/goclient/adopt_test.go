@@ -0,0 +1,350 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructure
+
+import (
+	goctx "context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgorecord "k8s.io/client-go/tools/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	basegov1 "github.com/ics-sigs/ics-go-sdk"
+	basecltv1 "github.com/ics-sigs/ics-go-sdk/client"
+	"github.com/ics-sigs/ics-go-sdk/client/restful"
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+	basevmv1 "github.com/ics-sigs/ics-go-sdk/vm"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/record"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
+)
+
+// fakeICenter serves the VMs it holds by ID and records the requests it
+// receives.
+type fakeICenter struct {
+	mu       sync.Mutex
+	vms      map[string]basetypv1.VirtualMachine
+	requests []string
+}
+
+func (f *fakeICenter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+	if vm, ok := f.vms[strings.TrimPrefix(r.URL.Path, "/vms/")]; ok && r.Method == http.MethodGet {
+		_ = json.NewEncoder(w).Encode(vm)
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func (f *fakeICenter) requested(request string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, r := range f.requests {
+		if r == request {
+			return true
+		}
+	}
+	return false
+}
+
+// newTestVMContext returns a context for the ICSVM whose session talks to the
+// handler.
+func newTestVMContext(t *testing.T, handler http.Handler, icsVM *infrav1.ICSVM) *context.VMContext {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restClient := restful.NewClient(u, true)
+	return &context.VMContext{
+		ControllerContext: &context.ControllerContext{
+			ControllerManagerContext: &context.ControllerManagerContext{Context: goctx.Background(), Logger: log.Log},
+			Logger:                   log.Log,
+			Recorder:                 record.New(clientgorecord.NewFakeRecorder(10)),
+		},
+		ICSVM:  icsVM,
+		Logger: log.Log,
+		Session: &session.Session{ICSConnection: &basegov1.ICSConnection{
+			Client: &basecltv1.Client{Client: restClient, RestAPITripper: restClient},
+		}},
+	}
+}
+
+func TestIsAdoptionRequested(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(icsVM *infrav1.ICSVM)
+		want   bool
+	}{
+		{
+			name:   "new icsvm",
+			modify: func(icsVM *infrav1.ICSVM) {},
+		},
+		{
+			name: "annotation",
+			modify: func(icsVM *infrav1.ICSVM) {
+				icsVM.Annotations = map[string]string{infrav1.AdoptVMAnnotation: "vm-1"}
+			},
+			want: true,
+		},
+		{
+			name: "created with an id",
+			modify: func(icsVM *infrav1.ICSVM) {
+				icsVM.Spec.UID = "vm-1"
+			},
+			want: true,
+		},
+		{
+			name: "vm created by the controller",
+			modify: func(icsVM *infrav1.ICSVM) {
+				icsVM.Spec.UID = "vm-1"
+				icsVM.Spec.BiosUUID = "bios-1"
+			},
+		},
+		{
+			name: "adopted vm",
+			modify: func(icsVM *infrav1.ICSVM) {
+				icsVM.Spec.UID = "vm-1"
+				icsVM.Spec.BiosUUID = "bios-1"
+				conditions.MarkTrue(icsVM, infrav1.VMAdoptedCondition)
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			icsVM := &infrav1.ICSVM{}
+			tc.modify(icsVM)
+			if got := isAdoptionRequested(&context.VMContext{ICSVM: icsVM}); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPrepareAdoption(t *testing.T) {
+	testCases := []struct {
+		name       string
+		modify     func(icsVM *infrav1.ICSVM)
+		wantUID    string
+		wantReason string
+	}{
+		{
+			name: "id from the annotation",
+			modify: func(icsVM *infrav1.ICSVM) {
+				icsVM.Annotations = map[string]string{infrav1.AdoptVMAnnotation: "vm-1"}
+			},
+			wantUID:    "vm-1",
+			wantReason: infrav1.AdoptingReason,
+		},
+		{
+			name: "id of the spec",
+			modify: func(icsVM *infrav1.ICSVM) {
+				icsVM.Annotations = map[string]string{infrav1.AdoptVMAnnotation: "vm-1"}
+				icsVM.Spec.UID = "vm-2"
+			},
+			wantUID:    "vm-2",
+			wantReason: infrav1.AdoptingReason,
+		},
+		{
+			name: "failed adoption is kept",
+			modify: func(icsVM *infrav1.ICSVM) {
+				icsVM.Spec.UID = "vm-1"
+				conditions.MarkFalse(icsVM, infrav1.VMAdoptedCondition, infrav1.AdoptionTargetNotFoundReason, clusterv1.ConditionSeverityError, "")
+			},
+			wantUID:    "vm-1",
+			wantReason: infrav1.AdoptionTargetNotFoundReason,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			icsVM := &infrav1.ICSVM{}
+			tc.modify(icsVM)
+			prepareAdoption(&context.VMContext{ICSVM: icsVM})
+			if icsVM.Spec.UID != tc.wantUID {
+				t.Errorf("got uid %q, want %q", icsVM.Spec.UID, tc.wantUID)
+			}
+			if reason := conditions.GetReason(icsVM, infrav1.VMAdoptedCondition); reason != tc.wantReason {
+				t.Errorf("got reason %q, want %q", reason, tc.wantReason)
+			}
+		})
+	}
+}
+
+func TestFindVMToAdopt(t *testing.T) {
+	testCases := []struct {
+		name    string
+		uid     string
+		wantRef string
+	}{
+		{
+			name:    "found by id",
+			uid:     "vm-1",
+			wantRef: "vm-1",
+		},
+		{
+			name: "missing id",
+			uid:  "vm-2",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			iCenter := &fakeICenter{vms: map[string]basetypv1.VirtualMachine{
+				"vm-1": {ID: "vm-1", Name: "legacy"},
+			}}
+			icsVM := &infrav1.ICSVM{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine", UID: "1234"},
+				Spec:       infrav1.ICSVMSpec{UID: tc.uid},
+			}
+			ctx := newTestVMContext(t, iCenter, icsVM)
+
+			ref, err := findVM(ctx)
+			if tc.wantRef == "" {
+				if !isNotFound(err) {
+					t.Fatalf("got %v, want a not found error", err)
+				}
+			} else if err != nil || ref.Value != tc.wantRef {
+				t.Fatalf("got ref %q and error %v, want %q", ref.Value, err, tc.wantRef)
+			}
+			// Neither the name nor the ownership metadata are looked up.
+			if iCenter.requested("GET /vms") {
+				t.Error("expected no fallback lookup of the vm to adopt")
+			}
+		})
+	}
+}
+
+func TestReconcileAdoption(t *testing.T) {
+	legacyVM := basetypv1.VirtualMachine{
+		ID:     "vm-1",
+		Name:   "legacy",
+		CPUNum: 4,
+		Memory: 8192,
+		Nics: []basetypv1.Nic{
+			{NetworkID: "net-1", NetworkName: "vlan10"},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		spec       infrav1.VirtualMachineCloneSpec
+		wantErr    bool
+		wantReason string
+	}{
+		{
+			name: "matching vm",
+			spec: infrav1.VirtualMachineCloneSpec{
+				NumCPUs:   4,
+				MemoryMiB: 8192,
+				Network:   infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{{NetworkName: "vlan10"}}},
+			},
+		},
+		{
+			name: "compute left to the vm",
+			spec: infrav1.VirtualMachineCloneSpec{
+				Network: infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{{NetworkID: "net-1"}}},
+			},
+		},
+		{
+			name: "cpu mismatch",
+			spec: infrav1.VirtualMachineCloneSpec{
+				NumCPUs: 2,
+				Network: infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{{NetworkName: "vlan10"}}},
+			},
+			wantErr:    true,
+			wantReason: infrav1.AdoptionValidationFailedReason,
+		},
+		{
+			name: "memory mismatch",
+			spec: infrav1.VirtualMachineCloneSpec{
+				MemoryMiB: 4096,
+				Network:   infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{{NetworkName: "vlan10"}}},
+			},
+			wantErr:    true,
+			wantReason: infrav1.AdoptionValidationFailedReason,
+		},
+		{
+			name: "network mismatch",
+			spec: infrav1.VirtualMachineCloneSpec{
+				Network: infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{{NetworkName: "vlan20"}}},
+			},
+			wantErr:    true,
+			wantReason: infrav1.AdoptionValidationFailedReason,
+		},
+		{
+			name: "nic count mismatch",
+			spec: infrav1.VirtualMachineCloneSpec{
+				Network: infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{{NetworkName: "vlan10"}, {NetworkName: "vlan20"}}},
+			},
+			wantErr:    true,
+			wantReason: infrav1.AdoptionValidationFailedReason,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			iCenter := &fakeICenter{vms: map[string]basetypv1.VirtualMachine{"vm-1": legacyVM}}
+			icsVM := &infrav1.ICSVM{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine"},
+				Spec:       infrav1.ICSVMSpec{VirtualMachineCloneSpec: tc.spec, UID: "vm-1"},
+			}
+			ctx := newTestVMContext(t, iCenter, icsVM)
+			prepareAdoption(ctx)
+			vmCtx := &virtualMachineContext{
+				VMContext: *ctx,
+				Obj:       basevmv1.NewVirtualMachineService(ctx.Session.Client),
+				Ref:       basetypv1.ManagedObjectReference{Type: "id", Value: "vm-1"},
+			}
+
+			err := (&VMService{}).reconcileAdoption(vmCtx)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				if reason := conditions.GetReason(icsVM, infrav1.VMAdoptedCondition); reason != tc.wantReason {
+					t.Errorf("got reason %q, want %q", reason, tc.wantReason)
+				}
+				return
+			}
+			if !conditions.IsTrue(icsVM, infrav1.VMAdoptedCondition) {
+				t.Error("expected the vm to be adopted")
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructure
+
+import (
+	"github.com/pkg/errors"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+	basevlv1 "github.com/ics-sigs/ics-go-sdk/volume"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	basev1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/icenter"
+)
+
+// reconcileDisks reports the disks of the VM in the ICSVM status and grows
+// the volumes or attaches the new disks of the spec. Disks are never shrunk
+// or removed. It returns false while a disk is being changed.
+func (vms *VMService) reconcileDisks(ctx *virtualMachineContext) (bool, error) {
+	vmObj, err := ctx.Obj.GetVM(ctx, ctx.Ref.Value)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get vm %s", ctx)
+	}
+	ctx.ICSVM.Status.Disks = diskStatuses(vmObj)
+
+	specs := ctx.ICSVM.Spec.Disks
+	volumeService := basevlv1.NewVolumeService(ctx.Session.Client)
+	if i := diskToExpand(specs, vmObj.Disks); i >= 0 {
+		disk := vmObj.Disks[i]
+		volume := disk.Volume
+		volume.Size = float64(specs[i].DiskSize)
+		volume.SizeInByte = int(specs[i].DiskSize) * 1024 * 1024 * 1024
+		task, err := volumeService.SetVolume(ctx, volume.ID, volume)
+		if err != nil {
+			ctx.Recorder.Warnf(ctx.ICSVM, "DiskExpansionFailed", "Failed to expand volume %s to %d GiB: %v", volume.ID, specs[i].DiskSize, err)
+			return false, errors.Wrapf(err, "failed to expand volume %s of vm %s", volume.ID, ctx)
+		}
+		ctx.ICSVM.Status.TaskRef = task.TaskId
+		ctx.Recorder.Eventf(ctx.ICSVM, "DiskExpanded", "Expanding volume %s from %d GiB to %d GiB", volume.ID, int32(disk.Volume.Size), specs[i].DiskSize)
+		ctx.Logger.Info("wait for volume to be expanded", "volume-id", volume.ID, "disk-size", specs[i].DiskSize)
+		return false, nil
+	}
+
+	if len(specs) <= len(vmObj.Disks) {
+		return true, nil
+	}
+	if !canAttachDisks(vmObj) {
+		ctx.Logger.Info("guest os of vm does not support disk hot-plug, new disks are not attached",
+			"disks", len(specs), "vm-disks", len(vmObj.Disks))
+		return true, nil
+	}
+
+	// New disks are created next to the system disk unless they name a
+	// datastore of their own.
+	sysVolume := vmObj.Disks[0].Volume
+	dataStore := &basetypv1.Storage{
+		ID:            sysVolume.DataStoreID,
+		Name:          sysVolume.DataStoreName,
+		DataStoreType: sysVolume.DataStoreType,
+	}
+	added := len(specs) - len(vmObj.Disks)
+	for i := len(vmObj.Disks); i < len(specs); i++ {
+		diskDataStore, err := basev1.DiskDatastore(&ctx.VMContext, dataStore, specs[i])
+		if err != nil {
+			return false, err
+		}
+		vmObj.Disks = append(vmObj.Disks, basev1.NewDataDisk(diskDataStore, specs[i]))
+	}
+	task, err := ctx.Obj.SetVM(ctx, *vmObj)
+	if err != nil {
+		ctx.Recorder.Warnf(ctx.ICSVM, "DiskAttachFailed", "Failed to attach %d new disks: %v", added, err)
+		return false, errors.Wrapf(err, "failed to attach disks to vm %s", ctx)
+	}
+	ctx.ICSVM.Status.TaskRef = task.TaskId
+	ctx.Recorder.Eventf(ctx.ICSVM, "DiskAttached", "Attaching %d new disks", added)
+	ctx.Logger.Info("wait for disks to be attached", "count", added)
+	return false, nil
+}
+
+// diskToExpand returns the index of the first disk of the VM that is smaller
+// than its spec, or -1 if no disk has to grow.
+func diskToExpand(specs []infrav1.DiskSpec, disks []basetypv1.Disk) int {
+	for i, disk := range disks {
+		if i < len(specs) && specs[i].DiskSize > int32(disk.Volume.Size) {
+			return i
+		}
+	}
+	return -1
+}
+
+// canAttachDisks returns true if new disks can be attached to the VM, which
+// is the case while it is powered off or if its guest OS supports disk
+// hot-plug.
+func canAttachDisks(vmObj *basetypv1.VirtualMachine) bool {
+	return vmObj.Status != "STARTED" || vmObj.GuestOsInfo.SupportDiskHotPlug
+}
+
+// diskStatuses returns the status of the disks of the VM.
+func diskStatuses(vmObj *basetypv1.VirtualMachine) []infrav1.DiskStatus {
+	statuses := make([]infrav1.DiskStatus, 0, len(vmObj.Disks))
+	for _, disk := range vmObj.Disks {
+		statuses = append(statuses, infrav1.DiskStatus{
+			VolumeID:  disk.Volume.ID,
+			DiskSize:  int32(disk.Volume.Size),
+			Datastore: disk.Volume.DataStoreName,
+		})
+	}
+	return statuses
+}
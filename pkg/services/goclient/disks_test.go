@@ -0,0 +1,126 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructure
+
+import (
+	"reflect"
+	"testing"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+func newTestDisk(id string, size float64) basetypv1.Disk {
+	return basetypv1.Disk{Volume: basetypv1.Volume{ID: id, Size: size, DataStoreName: "ds-1"}}
+}
+
+func TestDiskToExpand(t *testing.T) {
+	disks := []basetypv1.Disk{newTestDisk("sys", 40), newTestDisk("data", 100)}
+
+	testCases := []struct {
+		name  string
+		specs []infrav1.DiskSpec
+		want  int
+	}{
+		{
+			name:  "disks of the spec",
+			specs: []infrav1.DiskSpec{{DiskSize: 40}, {DiskSize: 100}},
+			want:  -1,
+		},
+		{
+			name:  "grown data disk",
+			specs: []infrav1.DiskSpec{{DiskSize: 40}, {DiskSize: 200}},
+			want:  1,
+		},
+		{
+			name:  "grown system and data disks",
+			specs: []infrav1.DiskSpec{{DiskSize: 60}, {DiskSize: 200}},
+			want:  0,
+		},
+		{
+			name:  "smaller disk in the spec",
+			specs: []infrav1.DiskSpec{{DiskSize: 20}, {DiskSize: 100}},
+			want:  -1,
+		},
+		{
+			name:  "new disk in the spec",
+			specs: []infrav1.DiskSpec{{DiskSize: 40}, {DiskSize: 100}, {DiskSize: 10}},
+			want:  -1,
+		},
+		{
+			name:  "fewer disks in the spec",
+			specs: []infrav1.DiskSpec{{DiskSize: 40}},
+			want:  -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := diskToExpand(tc.specs, disks); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCanAttachDisks(t *testing.T) {
+	testCases := []struct {
+		name string
+		vm   basetypv1.VirtualMachine
+		want bool
+	}{
+		{
+			name: "stopped vm",
+			vm:   basetypv1.VirtualMachine{Status: "STOPPED"},
+			want: true,
+		},
+		{
+			name: "running vm without disk hot-plug",
+			vm:   basetypv1.VirtualMachine{Status: "STARTED"},
+		},
+		{
+			name: "running vm with disk hot-plug",
+			vm:   basetypv1.VirtualMachine{Status: "STARTED", GuestOsInfo: basetypv1.GuestOsInfo{SupportDiskHotPlug: true}},
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := canAttachDisks(&tc.vm); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDiskStatuses(t *testing.T) {
+	vmObj := &basetypv1.VirtualMachine{Disks: []basetypv1.Disk{newTestDisk("sys", 40), newTestDisk("data", 100.5)}}
+	want := []infrav1.DiskStatus{
+		{VolumeID: "sys", DiskSize: 40, Datastore: "ds-1"},
+		{VolumeID: "data", DiskSize: 100, Datastore: "ds-1"},
+	}
+	if got := diskStatuses(vmObj); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if got := diskStatuses(&basetypv1.VirtualMachine{}); got == nil || len(got) != 0 {
+		t.Errorf("got %#v for a vm without disks, want an empty list", got)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	basev1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/icenter"
+)
+
+// reconcileDrift compares the live VM with the ICSVM spec and reports any
+// difference in the VMConfigurationDrifted condition. Depending on the drift
+// policy the drift is then corrected or the machine is marked as failed. It
+// returns false while a correction is in progress.
+func (vms *VMService) reconcileDrift(ctx *virtualMachineContext) (bool, error) {
+	vmObj, err := ctx.Obj.GetVM(ctx, ctx.Ref.Value)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get vm %s", ctx)
+	}
+
+	spec := &ctx.ICSVM.Spec.VirtualMachineCloneSpec
+	compute := diffCompute(spec, vmObj)
+	drift := append(append(compute, diffDisks(spec, vmObj)...), diffNetworks(spec, vmObj)...)
+	if len(drift) == 0 {
+		conditions.Delete(ctx.ICSVM, infrav1.VMConfigurationDriftedCondition)
+		return true, nil
+	}
+
+	msg := strings.Join(drift, "; ")
+	ctx.Logger.Info("vm configuration drifted", "drift", msg, "policy", spec.DriftPolicy)
+
+	switch spec.DriftPolicy {
+	case infrav1.DriftPolicyCorrect:
+		if len(compute) > 0 && canCorrectCompute(spec, vmObj) {
+			markDrifted(ctx, infrav1.DriftCorrectingReason, msg)
+			task, err := ctx.Obj.SetVM(ctx, withSpecCompute(spec, *vmObj))
+			if err != nil {
+				return false, errors.Wrapf(err, "failed to correct drift of vm %s", ctx)
+			}
+			ctx.ICSVM.Status.TaskRef = task.TaskId
+			ctx.Logger.Info("wait for vm drift to be corrected")
+			return false, nil
+		}
+		if len(compute) > 0 {
+			ctx.Logger.Info("vm drift cannot be corrected without a reboot", "status", vmObj.Status)
+		}
+	case infrav1.DriftPolicyRemediate:
+		markDrifted(ctx, infrav1.DriftRemediationReason, msg)
+		failureReason := capierrors.UpdateMachineError
+		failureMessage := fmt.Sprintf("vm configuration drifted: %s", msg)
+		ctx.ICSVM.Status.FailureReason = &failureReason
+		ctx.ICSVM.Status.FailureMessage = &failureMessage
+		ctx.Recorder.Warnf(ctx.ICSVM, "DriftRemediation", "Marked machine as failed because its VM configuration drifted: %s", msg)
+		return true, nil
+	}
+
+	markDrifted(ctx, infrav1.DriftDetectedReason, msg)
+	return true, nil
+}
+
+func markDrifted(ctx *virtualMachineContext, reason, msg string) {
+	conditions.Set(ctx.ICSVM, &clusterv1.Condition{
+		Type:    infrav1.VMConfigurationDriftedCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  reason,
+		Message: msg,
+	})
+}
+
+// canCorrectCompute returns true if the CPU and memory of the spec can be
+// applied to the VM without rebooting it. A running VM is only corrected when
+// the change can be hot-plugged, rebooting it is left to the InPlace resize
+// policy.
+func canCorrectCompute(spec *infrav1.VirtualMachineCloneSpec, vmObj *basetypv1.VirtualMachine) bool {
+	switch vmObj.Status {
+	case "STOPPED":
+		return true
+	case "STARTED":
+		return canHotPlug(spec, vmObj)
+	}
+	return false
+}
+
+// withSpecCompute returns the VM with the CPU and memory of the spec.
+func withSpecCompute(spec *infrav1.VirtualMachineCloneSpec, vmObj basetypv1.VirtualMachine) basetypv1.VirtualMachine {
+	if spec.NumCPUs > 0 {
+		basev1.SetCPUTopology(&vmObj, int(spec.NumCPUs))
+	}
+	if spec.MemoryMiB > 0 {
+		vmObj.Memory = int(spec.MemoryMiB)
+		vmObj.MemoryInByte = vmObj.Memory * 1024 * 1024
+		if vmObj.MaxMemory < vmObj.Memory {
+			vmObj.MaxMemory = vmObj.Memory
+			vmObj.MaxMemoryInByte = vmObj.MemoryInByte
+		}
+	}
+	return vmObj
+}
+
+// diffCompute describes the differences in CPU and memory between the spec
+// and the VM. Fields that are not set in the spec are not compared.
+func diffCompute(spec *infrav1.VirtualMachineCloneSpec, vmObj *basetypv1.VirtualMachine) []string {
+	var diff []string
+	if spec.NumCPUs > 0 && int32(vmObj.CPUNum) != spec.NumCPUs {
+		diff = append(diff, fmt.Sprintf("numCPUs is %d, vm has %d", spec.NumCPUs, vmObj.CPUNum))
+	}
+	if spec.MemoryMiB > 0 && int64(vmObj.Memory) != spec.MemoryMiB {
+		diff = append(diff, fmt.Sprintf("memoryMiB is %d, vm has %d", spec.MemoryMiB, vmObj.Memory))
+	}
+	return diff
+}
+
+// diffDisks describes the differences in the number of the disks between the
+// spec and the VM, and the disks of the VM that are smaller than in the spec.
+// Disks that were grown beyond the spec are not a drift.
+func diffDisks(spec *infrav1.VirtualMachineCloneSpec, vmObj *basetypv1.VirtualMachine) []string {
+	if len(spec.Disks) == 0 {
+		return nil
+	}
+	if len(vmObj.Disks) != len(spec.Disks) {
+		return []string{fmt.Sprintf("disks has %d entries, vm has %d disks", len(spec.Disks), len(vmObj.Disks))}
+	}
+	var diff []string
+	for i, disk := range spec.Disks {
+		size := int32(vmObj.Disks[i].Volume.Size)
+		if disk.DiskSize > 0 && size < disk.DiskSize {
+			diff = append(diff, fmt.Sprintf("disks[%d] diskSize is %d, vm disk has only %d", i, disk.DiskSize, size))
+		}
+	}
+	return diff
+}
+
+// diffNetworks describes the differences in the number of NICs and their
+// networks between the spec and the VM.
+func diffNetworks(spec *infrav1.VirtualMachineCloneSpec, vmObj *basetypv1.VirtualMachine) []string {
+	devices := spec.Network.Devices
+	if len(vmObj.Nics) != len(devices) {
+		return []string{fmt.Sprintf("network has %d devices, vm has %d nics", len(devices), len(vmObj.Nics))}
+	}
+	var diff []string
+	for i, device := range devices {
+		nic := vmObj.Nics[i]
+		switch {
+		case device.NetworkID != "" && nic.NetworkID != device.NetworkID:
+			diff = append(diff, fmt.Sprintf("devices[%d] network id is %q, vm nic has %q", i, device.NetworkID, nic.NetworkID))
+		case device.NetworkName != "" && nic.NetworkName != device.NetworkName:
+			diff = append(diff, fmt.Sprintf("devices[%d] network name is %q, vm nic has %q", i, device.NetworkName, nic.NetworkName))
+		}
+	}
+	return diff
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructure
+
+import (
+	"testing"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+func TestCanCorrectCompute(t *testing.T) {
+	spec := &infrav1.VirtualMachineCloneSpec{NumCPUs: 4}
+
+	testCases := []struct {
+		name string
+		vm   basetypv1.VirtualMachine
+		want bool
+	}{
+		{
+			name: "stopped vm",
+			vm:   basetypv1.VirtualMachine{Status: "STOPPED", CPUNum: 2},
+			want: true,
+		},
+		{
+			name: "running vm without cpu hot-plug",
+			vm:   basetypv1.VirtualMachine{Status: "STARTED", CPUNum: 2},
+		},
+		{
+			name: "running vm with cpu hot-plug",
+			vm: basetypv1.VirtualMachine{
+				Status:            "STARTED",
+				CPUNum:            2,
+				CPUHotplugEnabled: true,
+				GuestOsInfo:       basetypv1.GuestOsInfo{SupportCPUHotPlug: true},
+			},
+			want: true,
+		},
+		{
+			name: "vm being powered on",
+			vm:   basetypv1.VirtualMachine{Status: "STARTING", CPUNum: 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := canCorrectCompute(spec, &tc.vm); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDiffDisks(t *testing.T) {
+	spec := &infrav1.VirtualMachineCloneSpec{
+		Disks: []infrav1.DiskSpec{{DiskSize: 40}, {DiskSize: 100}},
+	}
+	disk := func(size float64) basetypv1.Disk {
+		return basetypv1.Disk{Volume: basetypv1.Volume{Size: size}}
+	}
+
+	testCases := []struct {
+		name  string
+		disks []basetypv1.Disk
+		want  int
+	}{
+		{
+			name:  "disks of the spec",
+			disks: []basetypv1.Disk{disk(40), disk(100)},
+		},
+		{
+			name:  "grown disk",
+			disks: []basetypv1.Disk{disk(60), disk(100)},
+		},
+		{
+			name:  "smaller disk",
+			disks: []basetypv1.Disk{disk(40), disk(50)},
+			want:  1,
+		},
+		{
+			name:  "missing disk",
+			disks: []basetypv1.Disk{disk(40)},
+			want:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			diff := diffDisks(spec, &basetypv1.VirtualMachine{Disks: tc.disks})
+			if len(diff) != tc.want {
+				t.Errorf("got drift %v, want %d entries", diff, tc.want)
+			}
+		})
+	}
+}
+
+func TestWithSpecCompute(t *testing.T) {
+	testCases := []struct {
+		cpus    int32
+		sockets int
+		cores   int
+	}{
+		{cpus: 1, sockets: 1, cores: 1},
+		{cpus: 3, sockets: 3, cores: 1},
+		{cpus: 4, sockets: 2, cores: 2},
+	}
+
+	for _, tc := range testCases {
+		spec := &infrav1.VirtualMachineCloneSpec{NumCPUs: tc.cpus, MemoryMiB: 4096}
+		vmObj := withSpecCompute(spec, basetypv1.VirtualMachine{CPUNum: 2, Memory: 2048, MaxMemory: 2048})
+		if vmObj.CPUNum != int(tc.cpus) || vmObj.CPUSocket != tc.sockets || vmObj.CPUCore != tc.cores {
+			t.Errorf("got %d CPUs in %d sockets of %d cores, want %d in %d of %d",
+				vmObj.CPUNum, vmObj.CPUSocket, vmObj.CPUCore, tc.cpus, tc.sockets, tc.cores)
+		}
+		if vmObj.Memory != 4096 || vmObj.MaxMemory != 4096 || vmObj.MemoryInByte != 4096*1024*1024 {
+			t.Errorf("got memory %d, max memory %d", vmObj.Memory, vmObj.MaxMemory)
+		}
+	}
+}
@@ -26,13 +26,17 @@ import (
 type errNotFound struct {
 	uuid            string
 	byInventoryPath string
+	id              string
 }
 
 func (e errNotFound) Error() string {
 	if e.byInventoryPath != "" {
 		return fmt.Sprintf("vm with inventory path %s not found", e.byInventoryPath)
 	}
-	return fmt.Sprintf("vm with bios uuid %s not found", e.uuid)
+	if e.uuid != "" {
+		return fmt.Sprintf("vm with bios uuid %s not found", e.uuid)
+	}
+	return fmt.Sprintf("vm with id %q not found", e.id)
 }
 
 func isNotFound(err error) bool {
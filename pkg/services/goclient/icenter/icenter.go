@@ -49,9 +49,9 @@ const (
 `
 	CLOUDINITTYPE string = "OPENSTACK"
 
-	NormalSwitchType string = "NORMALSWITCH"
-	LocalSDNSwitchType string = "SDNSWITCH"
-	ExtSDNSwitchType string = "VXLANOPENSTACKSWITCH"
+	NormalSwitchType string = string(infrav1.NormalSwitch)
+	LocalSDNSwitchType string = string(infrav1.SDNSwitch)
+	ExtSDNSwitchType string = string(infrav1.ExtSDNSwitch)
 	SDNDeviceType string = "ADVANCEDNETWORK"
 	NormalDeviceType string = "NETWORK"
 )
@@ -85,28 +85,21 @@ func ImportVM(ctx *context.VMContext, userdata string) error {
 		return errors.Wrapf(err, "unable to get ova image for %q", ctx)
 	}
 
-	storageService := basestv1.NewStorageService(ctx.GetSession().Client)
-	dataStore, err := storageService.GetStorageInfoByName(ctx, ctx.ICSVM.Spec.Datastore)
-	if err != nil {
-		ctx.Logger.Error(err, "fail to find the data store from ics")
-		return errors.Wrapf(err, "unable to get DataStore for %q", ctx)
-	}
-
 	networks := make(map[int]basetypv1.Network)
 	networkService := basenetv1.NewNetworkService(ctx.GetSession().Client)
 	for index, device := range ctx.ICSVM.Spec.Network.Devices {
-		if device.SwitchType == NormalSwitchType || device.SwitchType == LocalSDNSwitchType {
+		if device.SwitchType == infrav1.NormalSwitch || device.SwitchType == infrav1.SDNSwitch {
 			network, err := networkService.GetNetworkByID(ctx, device.NetworkID)
 			if err != nil {
 				ctx.Logger.Error(err, "fail to find the network devices from ics")
 				return errors.Wrapf(err, "unable to get networks for %q", ctx)
 			}
-			if device.SwitchType == LocalSDNSwitchType {
+			if device.SwitchType == infrav1.SDNSwitch {
 				network.ResourceID = device.DeviceID
 				network.Name = device.DeviceName
 			}
 			networks[index] = *network
-		} else if device.SwitchType == ExtSDNSwitchType {
+		} else if device.SwitchType == infrav1.ExtSDNSwitch {
 			network := basetypv1.Network{
 				ID:         device.NetworkID,
 				Name:       device.DeviceName,
@@ -121,11 +114,12 @@ func ImportVM(ctx *context.VMContext, userdata string) error {
 		}
 	}
 
-	host, err := getAvailableHosts(ctx, *dataStore, networks)
+	place, err := selectPlacement(ctx, networks)
 	if err != nil {
-		ctx.Logger.Error(err, "fail to find the host from ics")
-		return errors.Wrapf(err, "unable to get available host for %q", ctx)
+		ctx.Logger.Error(err, "fail to find the placement from ics")
+		return errors.Wrapf(err, "unable to get placement for %q", ctx)
 	}
+	dataStore, host := place.dataStore, place.host
 
 	ovaFilePath := ovaImage.Path + "/" + ovaImage.Name
 	ovaConfig, err := image.GetVMForm(ctx, ovaFilePath, host.ID, ovaImage.ServerID)
@@ -136,19 +130,14 @@ func ImportVM(ctx *context.VMContext, userdata string) error {
 	vmForm := *ovaConfig
 	vmForm.UUID = uuid.New().String()   // the vm path /sys/class/dmi/id/product_uuid
 	vmForm.Name = ctx.ICSVM.Name
+	vmForm.Description = infrautilv1.VMOwnerFor(ctx.ICSVM).Description()
 	vmForm.HostID = host.ID
 	vmForm.HostName = host.HostName
 	vmForm.HostIP = host.Name
 	vmForm.DataStoreID = dataStore.ID
-	if vmForm.CPUNum % 2 == 0 {
-		vmForm.CPUCore = 2
-		vmForm.CPUSocket = vmForm.CPUNum / vmForm.CPUCore
-	} else {
-		vmForm.CPUCore = 1
-		vmForm.CPUSocket = vmForm.CPUNum / vmForm.CPUCore
-	}
+	SetCPUTopology(&vmForm, vmForm.CPUNum)
 
-	diskSpecs, err := getOVADisks(dataStore, ovaConfig.Disks)
+	diskSpecs, err := getOVADisks(ctx, dataStore, ctx.ICSVM.Spec.Disks, ovaConfig.Disks)
 	if err != nil {
 		ctx.Logger.Error(err, "fail to find the disk spec")
 		return errors.Wrapf(err, "error getting disk spec for %q", ctx)
@@ -185,6 +174,7 @@ func ImportVM(ctx *context.VMContext, userdata string) error {
 		ctx.Logger.Error(err, "failed to import vm by the ova image")
 		return errors.Wrapf(err, "error import vm for machine %s", ctx)
 	}
+	place.placed()
 
 	ctx.ICSVM.Status.TaskRef = task.TaskId
 
@@ -219,29 +209,23 @@ func CloneVM(ctx *context.VMContext, userdata string) error {
 	vmTemplate.UUID = uuid.New().String()   // the vm path /sys/class/dmi/id/product_uuid
 	vmTemplate.Name = ctx.ICSVM.Name
 	vmTemplate.VMHostName = ""
-
-	storageService := basestv1.NewStorageService(ctx.GetSession().Client)
-	dataStore, err := storageService.GetStorageInfoByName(ctx, ctx.ICSVM.Spec.Datastore)
-	if err != nil {
-		ctx.Logger.Error(err, "fail to find the data store from ics")
-		return errors.Wrapf(err, "unable to get DataStore for %q", ctx)
-	}
+	vmTemplate.Description = infrautilv1.VMOwnerFor(ctx.ICSVM).Description()
 
 	networks := make(map[int]basetypv1.Network)
 	networkService := basenetv1.NewNetworkService(ctx.GetSession().Client)
 	for index, device := range ctx.ICSVM.Spec.Network.Devices {
-		if device.SwitchType == NormalSwitchType || device.SwitchType == LocalSDNSwitchType {
+		if device.SwitchType == infrav1.NormalSwitch || device.SwitchType == infrav1.SDNSwitch {
 			network, err := networkService.GetNetworkByName(ctx, device.NetworkName)
 			if err != nil {
 				ctx.Logger.Error(err, "fail to find the network devices from ics")
 				return errors.Wrapf(err, "unable to get networks for %q", ctx)
 			}
-			if device.SwitchType == LocalSDNSwitchType {
+			if device.SwitchType == infrav1.SDNSwitch {
 				network.ResourceID = device.DeviceID
 				network.Name = device.DeviceName
 			}
 			networks[index] = *network
-		} else if device.SwitchType == ExtSDNSwitchType {
+		} else if device.SwitchType == infrav1.ExtSDNSwitch {
 			network := basetypv1.Network{
 				ID:         device.NetworkID,
 				Name:       device.DeviceName,
@@ -256,25 +240,18 @@ func CloneVM(ctx *context.VMContext, userdata string) error {
 		}
 	}
 
-	host, err := getAvailableHosts(ctx, *dataStore, networks)
+	place, err := selectPlacement(ctx, networks)
 	if err != nil {
-		ctx.Logger.Error(err, "fail to find the host from ics")
-		return errors.Wrapf(err, "unable to get available host for %q", ctx)
+		ctx.Logger.Error(err, "fail to find the placement from ics")
+		return errors.Wrapf(err, "unable to get placement for %q", ctx)
 	}
+	dataStore, host := place.dataStore, place.host
 	vmTemplate.HostID = host.ID
 	vmTemplate.HostName = host.HostName
 	vmTemplate.HostIP = host.Name
 
 	// vm cpu config
-	cpuNum := ctx.ICSVM.Spec.NumCPUs
-	vmTemplate.CPUNum = int(cpuNum)
-	if vmTemplate.CPUNum % 2 == 0 {
-		vmTemplate.CPUCore = 2
-		vmTemplate.CPUSocket = int(cpuNum) / vmTemplate.CPUCore
-	} else {
-		vmTemplate.CPUCore = 1
-		vmTemplate.CPUSocket = int(cpuNum) / vmTemplate.CPUCore
-	}
+	SetCPUTopology(&vmTemplate, int(ctx.ICSVM.Spec.NumCPUs))
 
 	// vm memory config
 	memory := ctx.ICSVM.Spec.MemoryMiB
@@ -291,7 +268,7 @@ func CloneVM(ctx *context.VMContext, userdata string) error {
 		}
 	}
 
-	diskSpecs, err := getMultiDisks(dataStore, ctx.ICSVM.Spec.Disks, tpl.Disks)
+	diskSpecs, err := getMultiDisks(ctx, dataStore, ctx.ICSVM.Spec.Disks, tpl.Disks)
 	if err != nil {
 		ctx.Logger.Error(err, "fail to find the disk spec")
 		return errors.Wrapf(err, "error getting disk spec for %q", ctx)
@@ -336,6 +313,7 @@ func CloneVM(ctx *context.VMContext, userdata string) error {
 		ctx.Logger.Error(err, "fail to create vm by the template")
 		return errors.Wrapf(err, "error trigging clone op for machine %s", ctx)
 	}
+	place.placed()
 
 	ctx.ICSVM.Status.TaskRef = task.TaskId
 
@@ -348,55 +326,133 @@ func CloneVM(ctx *context.VMContext, userdata string) error {
 	return nil
 }
 
-func getOVADisks(dataStore *basetypv1.Storage,
-	devices []basetypv1.Disk) ([]basetypv1.Disk, error) {
+func getOVADisks(ctx *context.VMContext, dataStore *basetypv1.Storage,
+	specs []infrav1.DiskSpec, devices []basetypv1.Disk) ([]basetypv1.Disk, error) {
 
 	disks := []basetypv1.Disk{}
 
-	for _, disk := range devices {
-		disk.Volume.DataStoreID = dataStore.ID
-		disk.Volume.DataStoreName = dataStore.Name
-		disk.Volume.Format= "RAW"
+	for i, disk := range devices {
+		spec := infrav1.DiskSpec{}
+		if i < len(specs) {
+			spec = specs[i]
+		}
+		diskDataStore, err := DiskDatastore(ctx, dataStore, spec)
+		if err != nil {
+			return nil, err
+		}
+		disk.Volume.Format = "RAW"
+		applyDiskSpec(&disk, diskDataStore, spec)
 		disks = append(disks, disk)
 	}
 
 	return disks, nil
 }
 
-func getMultiDisks(dataStore *basetypv1.Storage,
+func getMultiDisks(ctx *context.VMContext, dataStore *basetypv1.Storage,
 	specs []infrav1.DiskSpec, devices []basetypv1.Disk) ([]basetypv1.Disk, error) {
 
 	disks := []basetypv1.Disk{}
 	sysDisk := devices[0]
-	sysDisk.Volume.DataStoreID = dataStore.ID
-	sysDisk.Volume.DataStoreName = dataStore.Name
-	sysDisk.Volume.DataStoreType = dataStore.DataStoreType
-	sysDisk.Volume.Format= "RAW"
+	sysDataStore, err := DiskDatastore(ctx, dataStore, specs[0])
+	if err != nil {
+		return nil, err
+	}
+	sysDisk.Volume.Format = "RAW"
+	applyDiskSpec(&sysDisk, sysDataStore, specs[0])
 	sysDisk.Volume.Size = float64(specs[0].DiskSize)
 	sysDisk.Volume.SizeInByte = int(specs[0].DiskSize) * 1024 * 1024 * 1024
 	disks = append(disks, sysDisk)
 	if len(specs) >= 2 {
 		for i := 1; i < len(specs); i++ {
-			disk := initDisk()
-			disk.Volume.DataStoreID = dataStore.ID
-			disk.Volume.DataStoreName = dataStore.Name
-			disk.Volume.DataStoreType = dataStore.DataStoreType
-			disk.Volume.Size = float64(specs[i].DiskSize)
-			disk.Volume.SizeInByte = int(specs[i].DiskSize) * 1024 * 1024 * 1024
-			if specs[i].BusModel != "" {
-				disk.BusModel = specs[i].BusModel
-			}
-			if specs[i].VolumePolicy != "" {
-				disk.Volume.VolumePolicy = specs[i].VolumePolicy
+			diskDataStore, err := DiskDatastore(ctx, dataStore, specs[i])
+			if err != nil {
+				return nil, err
 			}
-
-			disks = append(disks, disk)
+			disks = append(disks, NewDataDisk(diskDataStore, specs[i]))
 		}
 	}
 
 	return disks, nil
 }
 
+// DiskDatastore returns the datastore of the disk spec. Disks without a
+// datastore of their own are placed on the datastore of the virtual machine.
+func DiskDatastore(ctx *context.VMContext, dataStore *basetypv1.Storage, spec infrav1.DiskSpec) (*basetypv1.Storage, error) {
+	if spec.Datastore == "" || spec.Datastore == dataStore.Name {
+		return dataStore, nil
+	}
+	storageService := basestv1.NewStorageService(ctx.GetSession().Client)
+	diskDataStore, err := storageService.GetStorageInfoByName(ctx, spec.Datastore)
+	if err != nil {
+		ctx.Logger.Error(err, "fail to find the data store of disk from ics", "datastore", spec.Datastore)
+		return nil, errors.Wrapf(err, "unable to get DataStore %q of disk for %q", spec.Datastore, ctx)
+	}
+	return diskDataStore, nil
+}
+
+// SetCPUTopology sets the number of CPUs of the virtual machine, split into
+// sockets of two cores, or of one core for an odd number of CPUs.
+func SetCPUTopology(vm *basetypv1.VirtualMachine, cpuNum int) {
+	vm.CPUNum = cpuNum
+	if cpuNum%2 == 0 {
+		vm.CPUCore = 2
+	} else {
+		vm.CPUCore = 1
+	}
+	vm.CPUSocket = cpuNum / vm.CPUCore
+}
+
+// NewDataDisk returns a new data disk on the datastore for the disk spec.
+func NewDataDisk(dataStore *basetypv1.Storage, spec infrav1.DiskSpec) basetypv1.Disk {
+	disk := initDisk()
+	applyDiskSpec(&disk, dataStore, spec)
+	disk.Volume.Size = float64(spec.DiskSize)
+	disk.Volume.SizeInByte = int(spec.DiskSize) * 1024 * 1024 * 1024
+	if spec.BusModel != "" {
+		disk.BusModel = spec.BusModel
+	}
+	if spec.VolumePolicy != "" {
+		disk.Volume.VolumePolicy = spec.VolumePolicy
+	}
+	return disk
+}
+
+// applyDiskSpec places the disk on the datastore and applies the format, the
+// QoS limits and the IO settings of the disk spec. Settings that are not set
+// in the spec keep the value of the disk.
+func applyDiskSpec(disk *basetypv1.Disk, dataStore *basetypv1.Storage, spec infrav1.DiskSpec) {
+	disk.Volume.DataStoreID = dataStore.ID
+	disk.Volume.DataStoreName = dataStore.Name
+	disk.Volume.DataStoreType = dataStore.DataStoreType
+	if spec.VolumeFormat != "" {
+		disk.Volume.Format = spec.VolumeFormat
+	}
+	if spec.ReadIOPS > 0 {
+		disk.ReadIops = int(spec.ReadIOPS)
+	}
+	if spec.WriteIOPS > 0 {
+		disk.WriteIops = int(spec.WriteIOPS)
+	}
+	if spec.ReadBPS > 0 {
+		disk.ReadBps = int(spec.ReadBPS)
+	}
+	if spec.WriteBPS > 0 {
+		disk.WriteBps = int(spec.WriteBPS)
+	}
+	if spec.QueueCount > 0 {
+		disk.QueueNum = int(spec.QueueCount)
+	}
+	if spec.NativeIO {
+		disk.EnableNativeIO = true
+	}
+	if spec.KernelIO {
+		disk.EnableKernelIO = true
+	}
+	if spec.CacheMode != "" {
+		disk.ReadWriteModel = spec.CacheMode
+	}
+}
+
 func initDisk() basetypv1.Disk {
 	disk := basetypv1.Disk {
 		QueueNum: 1,
@@ -446,6 +502,15 @@ func getNetworkSpecs(ctx *context.VMContext, devices []basetypv1.Nic,
 			nic.NetworkID = network.ID
 			nic.SwitchType = network.VswitchDto.SwitchType
 			netSpec := ctx.ICSVM.Spec.Network.Devices[index]
+			applyNicSpec(&nic, &netSpec)
+			mac, err := getDeviceMAC(ctx, index, &netSpec)
+			if err != nil {
+				return nil, err
+			}
+			if mac != "" {
+				nic.Mac = mac
+				nic.AutoGenerated = false
+			}
 			if netSpec.DHCP4 || netSpec.DHCP6 {
 				nic.Dhcp = true
 				nic.StaticIp = false
@@ -474,6 +539,15 @@ func getNetworkSpecs(ctx *context.VMContext, devices []basetypv1.Nic,
 			netSpec.NetworkID = network.ID
 			netSpec.SwitchType = network.VswitchDto.SwitchType
 			deviceSpec := &ctx.ICSVM.Spec.Network.Devices[index]
+			applyNicSpec(&netSpec, deviceSpec)
+			mac, err := getDeviceMAC(ctx, index, deviceSpec)
+			if err != nil {
+				return nil, err
+			}
+			if mac != "" {
+				netSpec.Mac = mac
+				netSpec.AutoGenerated = false
+			}
 			if deviceSpec.DHCP4 || deviceSpec.DHCP6 {
 				netSpec.Dhcp = true
 			}
@@ -510,6 +584,40 @@ func initNic() basetypv1.Nic {
 	return nic
 }
 
+// applyNicSpec applies the model, queue, QoS and security group settings of the device spec
+// to the NIC. Settings that are not set in the spec keep the value of the NIC.
+func applyNicSpec(nic *basetypv1.Nic, deviceSpec *infrav1.NetworkDeviceSpec) {
+	if deviceSpec.Model != "" {
+		nic.Model = deviceSpec.Model
+	}
+	if deviceSpec.Queues > 0 {
+		nic.Queues = int(deviceSpec.Queues)
+	}
+	if deviceSpec.SendQueueLength > 0 || deviceSpec.ReceiveQueueLength > 0 {
+		nic.QueueLengthSet = true
+		if deviceSpec.SendQueueLength > 0 {
+			nic.SendQueueLength = int(deviceSpec.SendQueueLength)
+		}
+		if deviceSpec.ReceiveQueueLength > 0 {
+			nic.ReceiveQueueLength = int(deviceSpec.ReceiveQueueLength)
+		}
+	}
+	if deviceSpec.UplinkRate > 0 || deviceSpec.DownlinkRate > 0 {
+		nic.Enable = true
+		nic.UplinkRate = int(deviceSpec.UplinkRate)
+		nic.UplinkBurst = int(deviceSpec.UplinkBurst)
+		nic.DownlinkRate = int(deviceSpec.DownlinkRate)
+		nic.DownlinkBurst = int(deviceSpec.DownlinkBurst)
+	}
+	if deviceSpec.Priority != "" {
+		nic.PriorityEnabled = true
+		nic.NetPriority = deviceSpec.Priority
+	}
+	if len(deviceSpec.SecurityGroups) > 0 && (nic.SwitchType == LocalSDNSwitchType || nic.SwitchType == ExtSDNSwitchType) {
+		nic.SecurityGroups = deviceSpec.SecurityGroups
+	}
+}
+
 func UpdateNicIPConfig(ctx *context.VMContext, netSpec *basetypv1.Nic, deviceSpec *infrav1.NetworkDeviceSpec) {
 	// Check to see if the IP is in the list of the device
 	// spec's static IP addresses.
@@ -554,6 +662,10 @@ func getAvailableHosts(ctx *context.VMContext,
 		if host.ID == "" || host.Status != "CONNECTED" {
 			continue
 		}
+		// A local datastore can only be used by the host it belongs to.
+		if dataStore.HostID != "" && host.ID != dataStore.HostID {
+			continue
+		}
 		if clusterID != "" && host.ClusterID != clusterID {
 			continue
 		}
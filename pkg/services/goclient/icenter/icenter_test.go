@@ -0,0 +1,181 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package icenter
+
+import (
+	"reflect"
+	"testing"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+func TestNewDataDisk(t *testing.T) {
+	dataStore := &basetypv1.Storage{ID: "ds-1", Name: "fast", DataStoreType: "LOCAL"}
+
+	testCases := []struct {
+		name  string
+		spec  infrav1.DiskSpec
+		check func(t *testing.T, disk basetypv1.Disk)
+	}{
+		{
+			name: "defaults",
+			spec: infrav1.DiskSpec{DiskSize: 10},
+			check: func(t *testing.T, disk basetypv1.Disk) {
+				if disk.Volume.Format != "RAW" || disk.Volume.VolumePolicy != "THIN" || disk.BusModel != "VIRTIO" || disk.QueueNum != 1 || disk.ReadWriteModel != "NONE" {
+					t.Errorf("got disk %+v, want the defaults of a new disk", disk)
+				}
+			},
+		},
+		{
+			name: "size and datastore",
+			spec: infrav1.DiskSpec{DiskSize: 10},
+			check: func(t *testing.T, disk basetypv1.Disk) {
+				if disk.Volume.Size != 10 || disk.Volume.SizeInByte != 10*1024*1024*1024 {
+					t.Errorf("got size %v, %d bytes, want 10 GiB", disk.Volume.Size, disk.Volume.SizeInByte)
+				}
+				if disk.Volume.DataStoreID != "ds-1" || disk.Volume.DataStoreName != "fast" || disk.Volume.DataStoreType != "LOCAL" {
+					t.Errorf("got datastore %s/%s/%s, want ds-1/fast/LOCAL", disk.Volume.DataStoreID, disk.Volume.DataStoreName, disk.Volume.DataStoreType)
+				}
+			},
+		},
+		{
+			name: "format, bus and policy",
+			spec: infrav1.DiskSpec{DiskSize: 10, VolumeFormat: "QCOW2", BusModel: "SCSI", VolumePolicy: "THICK"},
+			check: func(t *testing.T, disk basetypv1.Disk) {
+				if disk.Volume.Format != "QCOW2" || disk.BusModel != "SCSI" || disk.Volume.VolumePolicy != "THICK" {
+					t.Errorf("got format %s, bus %s, policy %s", disk.Volume.Format, disk.BusModel, disk.Volume.VolumePolicy)
+				}
+			},
+		},
+		{
+			name: "qos and io",
+			spec: infrav1.DiskSpec{
+				DiskSize:   10,
+				ReadIOPS:   100,
+				WriteIOPS:  200,
+				ReadBPS:    300,
+				WriteBPS:   400,
+				QueueCount: 4,
+				NativeIO:   true,
+				KernelIO:   true,
+				CacheMode:  "WRITEBACK",
+			},
+			check: func(t *testing.T, disk basetypv1.Disk) {
+				if disk.ReadIops != 100 || disk.WriteIops != 200 || disk.ReadBps != 300 || disk.WriteBps != 400 {
+					t.Errorf("got limits %d/%d/%d/%d", disk.ReadIops, disk.WriteIops, disk.ReadBps, disk.WriteBps)
+				}
+				if disk.QueueNum != 4 || !disk.EnableNativeIO || !disk.EnableKernelIO || disk.ReadWriteModel != "WRITEBACK" {
+					t.Errorf("got io settings %+v", disk)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(t, NewDataDisk(dataStore, tc.spec))
+		})
+	}
+}
+
+func TestApplyNicSpec(t *testing.T) {
+	testCases := []struct {
+		name       string
+		switchType string
+		spec       infrav1.NetworkDeviceSpec
+		check      func(t *testing.T, nic basetypv1.Nic)
+	}{
+		{
+			name: "empty spec keeps the defaults",
+			spec: infrav1.NetworkDeviceSpec{},
+			check: func(t *testing.T, nic basetypv1.Nic) {
+				if nic.Model != "VIRTIO" || nic.Queues != 1 || nic.QueueLengthSet || nic.Enable || nic.PriorityEnabled {
+					t.Errorf("got nic %+v, want the defaults of a new nic", nic)
+				}
+			},
+		},
+		{
+			name: "model",
+			spec: infrav1.NetworkDeviceSpec{Model: "E1000"},
+			check: func(t *testing.T, nic basetypv1.Nic) {
+				if nic.Model != "E1000" {
+					t.Errorf("got model %q, want E1000", nic.Model)
+				}
+			},
+		},
+		{
+			name: "priority",
+			spec: infrav1.NetworkDeviceSpec{Priority: "HIGH"},
+			check: func(t *testing.T, nic basetypv1.Nic) {
+				if !nic.PriorityEnabled || nic.NetPriority != "HIGH" {
+					t.Errorf("got priority enabled %t and priority %q, want HIGH", nic.PriorityEnabled, nic.NetPriority)
+				}
+			},
+		},
+		{
+			name: "queues and a single queue length",
+			spec: infrav1.NetworkDeviceSpec{Queues: 4, SendQueueLength: 1024},
+			check: func(t *testing.T, nic basetypv1.Nic) {
+				if nic.Queues != 4 || !nic.QueueLengthSet || nic.SendQueueLength != 1024 || nic.ReceiveQueueLength != 256 {
+					t.Errorf("got queues %d, send %d and receive %d, want 4, 1024 and 256", nic.Queues, nic.SendQueueLength, nic.ReceiveQueueLength)
+				}
+			},
+		},
+		{
+			name: "rate limits",
+			spec: infrav1.NetworkDeviceSpec{UplinkRate: 100, UplinkBurst: 10, DownlinkRate: 200},
+			check: func(t *testing.T, nic basetypv1.Nic) {
+				if !nic.Enable || nic.UplinkRate != 100 || nic.UplinkBurst != 10 || nic.DownlinkRate != 200 || nic.DownlinkBurst != 0 {
+					t.Errorf("got nic %+v, want the rate limits of the spec", nic)
+				}
+			},
+		},
+		{
+			name:       "security groups on an SDN switch",
+			switchType: LocalSDNSwitchType,
+			spec:       infrav1.NetworkDeviceSpec{SecurityGroups: []string{"sg-1"}},
+			check: func(t *testing.T, nic basetypv1.Nic) {
+				if !reflect.DeepEqual(nic.SecurityGroups, []string{"sg-1"}) {
+					t.Errorf("got security groups %v, want [sg-1]", nic.SecurityGroups)
+				}
+			},
+		},
+		{
+			name:       "security groups on a normal switch",
+			switchType: NormalSwitchType,
+			spec:       infrav1.NetworkDeviceSpec{SecurityGroups: []string{"sg-1"}},
+			check: func(t *testing.T, nic basetypv1.Nic) {
+				if nic.SecurityGroups != nil {
+					t.Errorf("got security groups %v, want none", nic.SecurityGroups)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nic := initNic()
+			nic.SwitchType = tc.switchType
+			applyNicSpec(&nic, &tc.spec)
+			tc.check(t, nic)
+		})
+	}
+}
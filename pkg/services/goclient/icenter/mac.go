@@ -0,0 +1,276 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package icenter
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	apitypes "k8s.io/apimachinery/pkg/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+
+	basevmv1 "github.com/ics-sigs/ics-go-sdk/vm"
+)
+
+// macReservationTTL is how long a MAC address allocated from a pool stays
+// reserved for a VM that does not show up in ICS.
+const macReservationTTL = 10 * time.Minute
+
+type macReservation struct {
+	owner   apitypes.UID
+	device  int
+	expires time.Time
+}
+
+var (
+	// reservedMACs holds the MAC addresses allocated to VMs that are being
+	// created, so that concurrent clones do not get the same address.
+	reservedMACs   = map[string]macReservation{}
+	reservedMACsMu sync.Mutex
+)
+
+// getDeviceMAC returns the MAC address of the device spec at the index. An
+// explicit MACAddr is used as is, otherwise the lowest free address of the
+// MAC pool or prefix is allocated, so that replacement machines reuse the
+// addresses of the machines they replace. It returns an empty string when
+// the MAC address is left to ICS.
+func getDeviceMAC(ctx *context.VMContext, index int, deviceSpec *infrav1.NetworkDeviceSpec) (string, error) {
+	if deviceSpec.MACAddr != "" {
+		mac, err := net.ParseMAC(deviceSpec.MACAddr)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid macAddr %q", deviceSpec.MACAddr)
+		}
+		return mac.String(), nil
+	}
+	if len(deviceSpec.MACAddrPool) == 0 && deviceSpec.MACAddrPrefix == "" {
+		return "", nil
+	}
+
+	reservedMACsMu.Lock()
+	defer reservedMACsMu.Unlock()
+
+	used, err := usedMACs(ctx)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	if mac, ok := reservedMAC(used, now, ctx.ICSVM.UID, index, deviceSpec); ok {
+		return mac, nil
+	}
+
+	candidates, err := macPoolCandidates(deviceSpec)
+	if err != nil {
+		return "", err
+	}
+	for _, candidate := range candidates {
+		if candidate.free(used) {
+			mac := candidate.mac.String()
+			reservedMACs[mac] = macReservation{owner: ctx.ICSVM.UID, device: index, expires: now.Add(macReservationTTL)}
+			ctx.Logger.Info("allocated mac address", "device", index, "mac", mac)
+			return mac, nil
+		}
+	}
+	return "", errors.Errorf("no free mac address left in the pool of device %d", index)
+}
+
+// reservedMAC drops the reservations that expired or whose address is used
+// in ICS, and adds the addresses of the other reservations to the used ones.
+// It returns the address reserved for the device of the VM by an earlier
+// attempt to create the VM, and extends its reservation. The caller must
+// hold reservedMACsMu.
+func reservedMAC(used map[string]bool, now time.Time, owner apitypes.UID, index int, deviceSpec *infrav1.NetworkDeviceSpec) (string, bool) {
+	for mac, reservation := range reservedMACs {
+		switch {
+		case used[mac], now.After(reservation.expires):
+			delete(reservedMACs, mac)
+		case reservation.owner == owner && reservation.device == index:
+			// Reuse the address of an earlier attempt to create the VM.
+			if inMACPool(deviceSpec, mac) {
+				reservation.expires = now.Add(macReservationTTL)
+				reservedMACs[mac] = reservation
+				return mac, true
+			}
+			delete(reservedMACs, mac)
+		default:
+			used[mac] = true
+		}
+	}
+	return "", false
+}
+
+// usedMACs returns the MAC addresses of all the NICs in ICS.
+func usedMACs(ctx *context.VMContext) (map[string]bool, error) {
+	vms, err := basevmv1.NewVirtualMachineService(ctx.GetSession().Client).GetVMList(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list vms for %q", ctx)
+	}
+	used := map[string]bool{}
+	for _, vm := range vms {
+		for _, nic := range vm.Nics {
+			if mac, err := net.ParseMAC(nic.Mac); err == nil {
+				used[mac.String()] = true
+			}
+		}
+	}
+	return used, nil
+}
+
+// macRange is an inclusive range of MAC addresses.
+type macRange struct {
+	mac  net.HardwareAddr
+	last net.HardwareAddr
+}
+
+// free returns the first address of the range that is not used and moves
+// the range past it. It returns false if all the addresses are used.
+func (r *macRange) free(used map[string]bool) bool {
+	for compareMAC(r.mac, r.last) <= 0 {
+		if !used[r.mac.String()] {
+			return true
+		}
+		if !incrementMAC(r.mac) {
+			return false
+		}
+	}
+	return false
+}
+
+// macPoolCandidates returns the ranges of MAC addresses the device spec
+// allocates from, in order.
+func macPoolCandidates(deviceSpec *infrav1.NetworkDeviceSpec) ([]*macRange, error) {
+	var ranges []*macRange
+	for _, entry := range deviceSpec.MACAddrPool {
+		r, err := parseMACRange(entry)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	if deviceSpec.MACAddrPrefix != "" {
+		r, err := parseMACPrefix(deviceSpec.MACAddrPrefix)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+// inMACPool returns true if the MAC address belongs to the pool or prefix of
+// the device spec.
+func inMACPool(deviceSpec *infrav1.NetworkDeviceSpec, mac string) bool {
+	addr, err := net.ParseMAC(mac)
+	if err != nil {
+		return false
+	}
+	ranges, err := macPoolCandidates(deviceSpec)
+	if err != nil {
+		return false
+	}
+	for _, r := range ranges {
+		if compareMAC(r.mac, addr) <= 0 && compareMAC(addr, r.last) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// parseMACRange parses a single MAC address or a range of MAC addresses in
+// the form first-last.
+func parseMACRange(entry string) (*macRange, error) {
+	parts := strings.SplitN(entry, "-", 2)
+	first, err := net.ParseMAC(strings.TrimSpace(parts[0]))
+	if err != nil || len(first) != 6 {
+		return nil, errors.Errorf("invalid mac address pool entry %q", entry)
+	}
+	last := append(net.HardwareAddr{}, first...)
+	if len(parts) == 2 {
+		if last, err = net.ParseMAC(strings.TrimSpace(parts[1])); err != nil || len(last) != 6 || compareMAC(first, last) > 0 {
+			return nil, errors.Errorf("invalid mac address pool entry %q", entry)
+		}
+	}
+	// A range that spans more than one first octet includes the multicast
+	// addresses of an odd first octet.
+	if isMulticastMAC(first) || first[0] != last[0] {
+		return nil, errors.Errorf("mac address pool entry %q includes multicast addresses", entry)
+	}
+	return &macRange{mac: first, last: last}, nil
+}
+
+// parseMACPrefix parses a prefix of one to five octets into the range of
+// all the MAC addresses starting with it.
+func parseMACPrefix(prefix string) (*macRange, error) {
+	octets := strings.Split(strings.TrimSuffix(prefix, ":"), ":")
+	if len(octets) == 0 || len(octets) > 5 {
+		return nil, errors.Errorf("invalid mac address prefix %q", prefix)
+	}
+	first := make([]string, 6)
+	last := make([]string, 6)
+	for i := range first {
+		first[i], last[i] = "00", "ff"
+		if i < len(octets) {
+			first[i], last[i] = octets[i], octets[i]
+		}
+	}
+	firstMAC, err := net.ParseMAC(strings.Join(first, ":"))
+	if err != nil {
+		return nil, errors.Errorf("invalid mac address prefix %q", prefix)
+	}
+	lastMAC, err := net.ParseMAC(strings.Join(last, ":"))
+	if err != nil {
+		return nil, errors.Errorf("invalid mac address prefix %q", prefix)
+	}
+	if isMulticastMAC(firstMAC) {
+		return nil, errors.Errorf("mac address prefix %q is a multicast prefix", prefix)
+	}
+	return &macRange{mac: firstMAC, last: lastMAC}, nil
+}
+
+// isMulticastMAC returns true if the individual/group bit of the MAC address
+// is set. Multicast addresses cannot be assigned to a NIC.
+func isMulticastMAC(mac net.HardwareAddr) bool {
+	return mac[0]&0x01 != 0
+}
+
+func compareMAC(a, b net.HardwareAddr) int {
+	for i := range a {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// incrementMAC increments the MAC address in place. It returns false if the
+// address overflowed.
+func incrementMAC(mac net.HardwareAddr) bool {
+	for i := len(mac) - 1; i >= 0; i-- {
+		mac[i]++
+		if mac[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
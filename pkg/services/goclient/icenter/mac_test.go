@@ -0,0 +1,287 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package icenter
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+func mustParseMAC(t *testing.T, s string) net.HardwareAddr {
+	t.Helper()
+	mac, err := net.ParseMAC(s)
+	if err != nil {
+		t.Fatalf("invalid mac address %q: %v", s, err)
+	}
+	return mac
+}
+
+func TestParseMACRange(t *testing.T) {
+	testCases := []struct {
+		entry     string
+		first     string
+		last      string
+		expectErr bool
+	}{
+		{entry: "52:54:00:00:00:01", first: "52:54:00:00:00:01", last: "52:54:00:00:00:01"},
+		{entry: "52:54:00:00:00:01 - 52:54:00:00:01:00", first: "52:54:00:00:00:01", last: "52:54:00:00:01:00"},
+		{entry: "52:54:00:00:00:10-52:54:00:00:00:01", expectErr: true},
+		{entry: "52:54:00:00:00", expectErr: true},
+		{entry: "52:54:00:00:00:01-52:54:00", expectErr: true},
+		{entry: "01:00:5e:00:00:01", expectErr: true},
+		{entry: "53:54:00:00:00:01-53:54:00:00:00:ff", expectErr: true},
+		{entry: "52:ff:ff:ff:ff:00-54:00:00:00:00:ff", expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.entry, func(t *testing.T) {
+			r, err := parseMACRange(tc.entry)
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("got error %v, expected error %t", err, tc.expectErr)
+			}
+			if err != nil {
+				return
+			}
+			if r.mac.String() != tc.first || r.last.String() != tc.last {
+				t.Errorf("got range %s-%s, want %s-%s", r.mac, r.last, tc.first, tc.last)
+			}
+		})
+	}
+}
+
+func TestParseMACPrefix(t *testing.T) {
+	testCases := []struct {
+		prefix    string
+		first     string
+		last      string
+		expectErr bool
+	}{
+		{prefix: "52:54:00:10", first: "52:54:00:10:00:00", last: "52:54:00:10:ff:ff"},
+		{prefix: "52:54:00:10:", first: "52:54:00:10:00:00", last: "52:54:00:10:ff:ff"},
+		{prefix: "02", first: "02:00:00:00:00:00", last: "02:ff:ff:ff:ff:ff"},
+		{prefix: "52:54:00:10:20", first: "52:54:00:10:20:00", last: "52:54:00:10:20:ff"},
+		{prefix: "52:54:00:10:20:30", expectErr: true},
+		{prefix: "52:zz", expectErr: true},
+		{prefix: "01:00:5e", expectErr: true},
+		{prefix: "ff", expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.prefix, func(t *testing.T) {
+			r, err := parseMACPrefix(tc.prefix)
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("got error %v, expected error %t", err, tc.expectErr)
+			}
+			if err != nil {
+				return
+			}
+			if r.mac.String() != tc.first || r.last.String() != tc.last {
+				t.Errorf("got range %s-%s, want %s-%s", r.mac, r.last, tc.first, tc.last)
+			}
+		})
+	}
+}
+
+func TestIncrementMAC(t *testing.T) {
+	testCases := []struct {
+		mac  string
+		want string
+		ok   bool
+	}{
+		{mac: "52:54:00:00:00:01", want: "52:54:00:00:00:02", ok: true},
+		{mac: "52:54:00:00:00:ff", want: "52:54:00:00:01:00", ok: true},
+		{mac: "52:54:ff:ff:ff:ff", want: "52:55:00:00:00:00", ok: true},
+		{mac: "ff:ff:ff:ff:ff:ff", want: "00:00:00:00:00:00"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.mac, func(t *testing.T) {
+			mac := mustParseMAC(t, tc.mac)
+			if ok := incrementMAC(mac); ok != tc.ok || mac.String() != tc.want {
+				t.Errorf("got %s, %t, want %s, %t", mac, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
+
+func TestMACRangeFree(t *testing.T) {
+	testCases := []struct {
+		name  string
+		first string
+		last  string
+		used  []string
+		want  string
+	}{
+		{
+			name:  "first address is free",
+			first: "52:54:00:00:00:01",
+			last:  "52:54:00:00:00:03",
+			want:  "52:54:00:00:00:01",
+		},
+		{
+			name:  "last address is free",
+			first: "52:54:00:00:00:01",
+			last:  "52:54:00:00:00:03",
+			used:  []string{"52:54:00:00:00:01", "52:54:00:00:00:02"},
+			want:  "52:54:00:00:00:03",
+		},
+		{
+			name:  "all addresses are used",
+			first: "52:54:00:00:00:01",
+			last:  "52:54:00:00:00:02",
+			used:  []string{"52:54:00:00:00:01", "52:54:00:00:00:02"},
+		},
+		{
+			name:  "range ends at the highest address",
+			first: "fe:ff:ff:ff:ff:ff",
+			last:  "fe:ff:ff:ff:ff:ff",
+			used:  []string{"fe:ff:ff:ff:ff:ff"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			used := map[string]bool{}
+			for _, mac := range tc.used {
+				used[mac] = true
+			}
+			r := &macRange{mac: mustParseMAC(t, tc.first), last: mustParseMAC(t, tc.last)}
+			ok := r.free(used)
+			if ok != (tc.want != "") {
+				t.Fatalf("got free %t, want %t", ok, tc.want != "")
+			}
+			if ok && r.mac.String() != tc.want {
+				t.Errorf("got %s, want %s", r.mac, tc.want)
+			}
+		})
+	}
+}
+
+func TestInMACPool(t *testing.T) {
+	deviceSpec := &infrav1.NetworkDeviceSpec{
+		MACAddrPool:   []string{"52:54:00:00:00:10-52:54:00:00:00:20"},
+		MACAddrPrefix: "52:54:01",
+	}
+
+	testCases := []struct {
+		mac  string
+		want bool
+	}{
+		{mac: "52:54:00:00:00:10", want: true},
+		{mac: "52:54:00:00:00:20", want: true},
+		{mac: "52:54:00:00:00:21"},
+		{mac: "52:54:01:ab:cd:ef", want: true},
+		{mac: "52:54:02:00:00:00"},
+		{mac: "invalid"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.mac, func(t *testing.T) {
+			if got := inMACPool(deviceSpec, tc.mac); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReservedMAC(t *testing.T) {
+	deviceSpec := &infrav1.NetworkDeviceSpec{MACAddrPrefix: "52:54:00"}
+	now := time.Now()
+
+	testCases := []struct {
+		name         string
+		reservation  macReservation
+		mac          string
+		used         bool
+		wantReserved bool
+		wantUsed     bool
+		wantKept     bool
+	}{
+		{
+			name:         "reservation of the device",
+			reservation:  macReservation{owner: "vm-1", device: 0, expires: now.Add(time.Minute)},
+			mac:          "52:54:00:00:00:01",
+			wantReserved: true,
+			wantKept:     true,
+		},
+		{
+			name:        "expired reservation of the device",
+			reservation: macReservation{owner: "vm-1", device: 0, expires: now.Add(-time.Second)},
+			mac:         "52:54:00:00:00:01",
+		},
+		{
+			name:        "reservation of the device outside of its pool",
+			reservation: macReservation{owner: "vm-1", device: 0, expires: now.Add(time.Minute)},
+			mac:         "52:54:01:00:00:01",
+		},
+		{
+			name:        "reservation of another device",
+			reservation: macReservation{owner: "vm-1", device: 1, expires: now.Add(time.Minute)},
+			mac:         "52:54:00:00:00:01",
+			wantUsed:    true,
+			wantKept:    true,
+		},
+		{
+			name:        "reservation of another vm",
+			reservation: macReservation{owner: "vm-2", device: 0, expires: now.Add(time.Minute)},
+			mac:         "52:54:00:00:00:01",
+			wantUsed:    true,
+			wantKept:    true,
+		},
+		{
+			name:        "reservation of an address used in ics",
+			reservation: macReservation{owner: "vm-2", device: 0, expires: now.Add(time.Minute)},
+			mac:         "52:54:00:00:00:01",
+			used:        true,
+			wantUsed:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			reservedMACsMu.Lock()
+			defer reservedMACsMu.Unlock()
+			reservedMACs = map[string]macReservation{tc.mac: tc.reservation}
+			defer func() { reservedMACs = map[string]macReservation{} }()
+
+			used := map[string]bool{tc.mac: tc.used}
+			mac, ok := reservedMAC(used, now, "vm-1", 0, deviceSpec)
+			if ok != tc.wantReserved || (ok && mac != tc.mac) {
+				t.Errorf("got reserved %q, %t, want %t", mac, ok, tc.wantReserved)
+			}
+			if used[tc.mac] != tc.wantUsed {
+				t.Errorf("got used %t, want %t", used[tc.mac], tc.wantUsed)
+			}
+			reservation, kept := reservedMACs[tc.mac]
+			if kept != tc.wantKept {
+				t.Errorf("got reservation kept %t, want %t", kept, tc.wantKept)
+			}
+			if ok && !reservation.expires.Equal(now.Add(macReservationTTL)) {
+				t.Errorf("got reservation expiring at %s, want it extended by %s", reservation.expires, macReservationTTL)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package icenter
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/tags"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+	basestv1 "github.com/ics-sigs/ics-go-sdk/storage"
+)
+
+// roundRobinTTL is how long the position of the RoundRobin strategy is kept
+// for a set of datastores that is no longer placed on.
+const roundRobinTTL = 24 * time.Hour
+
+// roundRobinPosition is the index of the next datastore to try, in the order
+// of their names, for a set of datastores with the RoundRobin strategy.
+type roundRobinPosition struct {
+	next     int
+	lastUsed time.Time
+}
+
+var (
+	// roundRobinPositions holds the positions of the RoundRobin strategy by
+	// cluster and set of datastores.
+	roundRobinPositions   = map[string]roundRobinPosition{}
+	roundRobinPositionsMu sync.Mutex
+)
+
+// placement is the datastore and the host selected for a VM.
+type placement struct {
+	dataStore *basetypv1.Storage
+	host      basetypv1.Host
+
+	// roundRobinKey identifies the datastores the RoundRobin strategy chose
+	// from. It is empty for the other strategies.
+	roundRobinKey  string
+	roundRobinNext int
+}
+
+// placed records that the VM was created on the placement, so that the
+// RoundRobin strategy moves on to the next datastore. It is only called once
+// the creation of the VM was accepted, so failed attempts are retried on the
+// same datastore.
+func (p *placement) placed() {
+	if p.roundRobinKey == "" {
+		return
+	}
+	roundRobinPositionsMu.Lock()
+	defer roundRobinPositionsMu.Unlock()
+	roundRobinPositions[p.roundRobinKey] = roundRobinPosition{next: p.roundRobinNext, lastUsed: time.Now()}
+}
+
+// roundRobinStart returns the index of the next datastore to try for the
+// key, and drops the positions that were not used for roundRobinTTL.
+func roundRobinStart(key string) int {
+	roundRobinPositionsMu.Lock()
+	defer roundRobinPositionsMu.Unlock()
+	for k, position := range roundRobinPositions {
+		if time.Since(position.lastUsed) > roundRobinTTL {
+			delete(roundRobinPositions, k)
+		}
+	}
+	return roundRobinPositions[key].next
+}
+
+// roundRobinKey identifies a set of datastores of a cluster.
+func roundRobinKey(cluster string, dataStores []basetypv1.Storage) string {
+	names := make([]string, 0, len(dataStores))
+	for _, dataStore := range dataStores {
+		names = append(names, dataStore.Name)
+	}
+	sort.Strings(names)
+	return cluster + "/" + strings.Join(names, ",")
+}
+
+// selectPlacement returns the datastore and the host to create the VM on.
+// A named datastore is used as is, otherwise the datastores matched by the
+// datastore selector are tried in the order of its strategy. Datastores
+// without the capacity for the disks of the VM are skipped. The outcome is
+// reported in the PlacementConstraintMet condition.
+func selectPlacement(ctx *context.VMContext, networks map[int]basetypv1.Network) (*placement, error) {
+	storageService := basestv1.NewStorageService(ctx.GetSession().Client)
+
+	var (
+		candidates []basetypv1.Storage
+		key        string
+		start      int
+	)
+	selector := ctx.ICSVM.Spec.DatastoreSelector
+	if ctx.ICSVM.Spec.Datastore != "" || selector == nil {
+		dataStore, err := storageService.GetStorageInfoByName(ctx, ctx.ICSVM.Spec.Datastore)
+		if err != nil {
+			ctx.Logger.Error(err, "fail to find the data store from ics")
+			conditions.MarkFalse(ctx.ICSVM, infrav1.PlacementConstraintMetCondition, infrav1.DatastoreNotFoundReason, clusterv1.ConditionSeverityError,
+				"datastore %q not found", ctx.ICSVM.Spec.Datastore)
+			return nil, errors.Wrapf(err, "unable to get DataStore for %q", ctx)
+		}
+		candidates = []basetypv1.Storage{*dataStore}
+	} else {
+		matched, err := matchDatastores(ctx, storageService, selector)
+		if err != nil {
+			return nil, err
+		}
+		if len(matched) == 0 {
+			conditions.MarkFalse(ctx.ICSVM, infrav1.PlacementConstraintMetCondition, infrav1.DatastoreNotFoundReason, clusterv1.ConditionSeverityError,
+				"no datastore matches the datastore selector")
+			return nil, errors.Errorf("no datastore matches the datastore selector of %q", ctx)
+		}
+		if selector.Strategy == infrav1.DatastoreSelectionRoundRobin {
+			key = roundRobinKey(ctx.ICSVM.Spec.Cluster, matched)
+			start = roundRobinStart(key) % len(matched)
+		}
+		candidates = orderDatastores(selector, matched, start)
+	}
+
+	fits := 0
+	for i := range candidates {
+		dataStore := candidates[i]
+		required := requiredCapacityInByte(ctx.ICSVM.Spec.Disks, dataStore.Name)
+		if available := availableCapacityInByte(dataStore); available < required {
+			ctx.Logger.Info("datastore does not have the capacity for the disks", "datastore", dataStore.Name,
+				"available", available, "required", required)
+			continue
+		}
+		fits++
+		host, err := getAvailableHosts(ctx, dataStore, networks)
+		if err != nil {
+			ctx.Logger.Info("no available host for datastore", "datastore", dataStore.Name, "reason", err.Error())
+			continue
+		}
+		ctx.Logger.Info("selected placement", "datastore", dataStore.Name, "host", host.Name)
+		conditions.MarkTrue(ctx.ICSVM, infrav1.PlacementConstraintMetCondition)
+		p := &placement{dataStore: &dataStore, host: host}
+		if key != "" {
+			p.roundRobinKey = key
+			p.roundRobinNext = (start + i + 1) % len(candidates)
+		}
+		return p, nil
+	}
+
+	if fits == 0 {
+		conditions.MarkFalse(ctx.ICSVM, infrav1.PlacementConstraintMetCondition, infrav1.InsufficientDatastoreCapacityReason, clusterv1.ConditionSeverityError,
+			"none of the %d datastores has the capacity for the disks", len(candidates))
+		return nil, errors.Errorf("no datastore has the capacity for the disks of %q", ctx)
+	}
+	conditions.MarkFalse(ctx.ICSVM, infrav1.PlacementConstraintMetCondition, infrav1.NoAvailableHostReason, clusterv1.ConditionSeverityError,
+		"no host meets the scheduling conditions on the %d datastores with enough capacity", fits)
+	return nil, errors.Errorf("unable to get available host for %q", ctx)
+}
+
+// matchDatastores returns the datastores that match all the criteria of the
+// selector.
+func matchDatastores(ctx *context.VMContext, storageService *basestv1.StorageService, selector *infrav1.DatastoreSelector) ([]basetypv1.Storage, error) {
+	dataStores, err := storageService.GetStoragesList(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list DataStores for %q", ctx)
+	}
+	names := map[string]bool{}
+	for _, name := range selector.Candidates {
+		names[name] = true
+	}
+
+	var matched []basetypv1.Storage
+	for _, dataStore := range dataStores {
+		if dataStore.ID == "" {
+			continue
+		}
+		if len(names) > 0 && !names[dataStore.Name] {
+			continue
+		}
+		if selector.Type != "" && !strings.EqualFold(selector.Type, dataStore.DataStoreType) {
+			continue
+		}
+		if selector.Tag != "" {
+			ok, err := tags.DatastoreHasTag(ctx, dataStore.ID, selector.Tag)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		matched = append(matched, dataStore)
+	}
+	return matched, nil
+}
+
+// orderDatastores sorts the datastores in the order they are tried by the
+// strategy of the selector. The RoundRobin strategy tries the datastores in
+// the order of their names, starting at the given index.
+func orderDatastores(selector *infrav1.DatastoreSelector, dataStores []basetypv1.Storage, start int) []basetypv1.Storage {
+	mostFree := func(i, j int) bool {
+		return availableCapacityInByte(dataStores[i]) > availableCapacityInByte(dataStores[j])
+	}
+
+	switch selector.Strategy {
+	case infrav1.DatastoreSelectionRoundRobin:
+		sort.SliceStable(dataStores, func(i, j int) bool {
+			return dataStores[i].Name < dataStores[j].Name
+		})
+		start %= len(dataStores)
+		return append(dataStores[start:], dataStores[:start]...)
+	case infrav1.DatastoreSelectionHostLocal:
+		sort.SliceStable(dataStores, func(i, j int) bool {
+			iLocal, jLocal := dataStores[i].HostID != "", dataStores[j].HostID != ""
+			if iLocal != jLocal {
+				return iLocal
+			}
+			return mostFree(i, j)
+		})
+	default:
+		sort.SliceStable(dataStores, mostFree)
+	}
+	return dataStores
+}
+
+// requiredCapacityInByte returns the size of the disks that are created on
+// the named datastore.
+func requiredCapacityInByte(disks []infrav1.DiskSpec, dataStoreName string) int {
+	required := 0
+	for _, disk := range disks {
+		if disk.Datastore == "" || disk.Datastore == dataStoreName {
+			required += int(disk.DiskSize) * 1024 * 1024 * 1024
+		}
+	}
+	return required
+}
+
+// availableCapacityInByte returns the available capacity of the datastore.
+func availableCapacityInByte(dataStore basetypv1.Storage) int {
+	if dataStore.AvailCapacityInByte > 0 {
+		return dataStore.AvailCapacityInByte
+	}
+	return int(dataStore.AvailCapacity * 1024 * 1024 * 1024)
+}
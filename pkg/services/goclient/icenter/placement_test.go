@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package icenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+func datastoreNames(dataStores []basetypv1.Storage) []string {
+	names := make([]string, 0, len(dataStores))
+	for _, dataStore := range dataStores {
+		names = append(names, dataStore.Name)
+	}
+	return names
+}
+
+func TestOrderDatastores(t *testing.T) {
+	testCases := []struct {
+		name     string
+		strategy infrav1.DatastoreSelectionStrategy
+		start    int
+		want     []string
+	}{
+		{
+			name: "most free space",
+			want: []string{"b", "a", "c"},
+		},
+		{
+			name:     "round robin",
+			strategy: infrav1.DatastoreSelectionRoundRobin,
+			start:    1,
+			want:     []string{"b", "c", "a"},
+		},
+		{
+			name:     "round robin past the end",
+			strategy: infrav1.DatastoreSelectionRoundRobin,
+			start:    5,
+			want:     []string{"c", "a", "b"},
+		},
+		{
+			name:     "host local",
+			strategy: infrav1.DatastoreSelectionHostLocal,
+			want:     []string{"c", "b", "a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			dataStores := []basetypv1.Storage{
+				{Name: "c", AvailCapacityInByte: 10, HostID: "host-1"},
+				{Name: "a", AvailCapacityInByte: 20},
+				{Name: "b", AvailCapacityInByte: 30},
+			}
+			selector := &infrav1.DatastoreSelector{Strategy: tc.strategy}
+			got := datastoreNames(orderDatastores(selector, dataStores, tc.start))
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRoundRobinPositions(t *testing.T) {
+	dataStores := []basetypv1.Storage{{Name: "b"}, {Name: "a"}}
+	key := roundRobinKey("cluster-1", dataStores)
+	if other := roundRobinKey("cluster-2", dataStores); other == key {
+		t.Errorf("clusters share the round robin key %q", key)
+	}
+	if reordered := roundRobinKey("cluster-1", []basetypv1.Storage{{Name: "a"}, {Name: "b"}}); reordered != key {
+		t.Errorf("got key %q for the same datastores in another order, want %q", reordered, key)
+	}
+
+	if start := roundRobinStart(key); start != 0 {
+		t.Fatalf("got start %d for a new set of datastores", start)
+	}
+	(&placement{roundRobinKey: key, roundRobinNext: 1}).placed()
+	if start := roundRobinStart(key); start != 1 {
+		t.Errorf("got start %d after a placement, want 1", start)
+	}
+
+	roundRobinPositionsMu.Lock()
+	roundRobinPositions[key] = roundRobinPosition{next: 1, lastUsed: time.Now().Add(-2 * roundRobinTTL)}
+	roundRobinPositionsMu.Unlock()
+	if start := roundRobinStart(key); start != 0 {
+		t.Errorf("got start %d for a stale position, want 0", start)
+	}
+	if _, ok := roundRobinPositions[key]; ok {
+		t.Errorf("stale position was not dropped")
+	}
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+	ociTitleAnnotation      = "org.opencontainers.image.title"
+
+	dockerHubRegistry = "registry-1.docker.io"
+)
+
+// OCICredentials are the credentials used to pull an OCI artifact.
+type OCICredentials struct {
+	Username string
+	Password string
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type ociManifest struct {
+	MediaType string          `json:"mediaType"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+type ociToken struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+// ociClient pulls from a repository of an OCI registry.
+type ociClient struct {
+	baseURL     string
+	repository  string
+	credentials *OCICredentials
+	// authorization is the Authorization header of the requests once the
+	// registry challenged the client.
+	authorization string
+}
+
+// ResolveOCIBlobURL returns a URL the OVA image stored in the OCI artifact
+// can be downloaded from without credentials. The OVA image is the layer
+// whose title ends with .ova, or the only layer of the artifact. Registries
+// serving blobs from a storage redirect to a pre-signed URL, which is
+// returned. Otherwise the URL of the blob in the registry is returned, as
+// long as the registry serves it anonymously.
+func ResolveOCIBlobURL(ctx context.Context, reference string, credentials *OCICredentials, insecure bool) (string, error) {
+	registry, repository, tagOrDigest, err := parseOCIReference(reference)
+	if err != nil {
+		return "", err
+	}
+	scheme := "https"
+	if insecure {
+		scheme = "http"
+	}
+	c := &ociClient{
+		baseURL:     fmt.Sprintf("%s://%s/v2/%s", scheme, registry, repository),
+		repository:  repository,
+		credentials: credentials,
+	}
+
+	manifest, err := c.manifest(ctx, tagOrDigest)
+	if err != nil {
+		return "", err
+	}
+	layer, err := ovaLayer(manifest)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to find the ova image of %q", reference)
+	}
+	return c.blobURL(ctx, layer.Digest)
+}
+
+// parseOCIReference splits a reference into the registry, the repository and
+// the tag or digest. References without registry are pulled from Docker Hub.
+func parseOCIReference(reference string) (string, string, string, error) {
+	name, tagOrDigest := reference, "latest"
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, tagOrDigest = name[:i], name[i+1:]
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tagOrDigest = name[:i], name[i+1:]
+	}
+	if name == "" || tagOrDigest == "" {
+		return "", "", "", errors.Errorf("invalid oci reference %q", reference)
+	}
+
+	registry, repository := "docker.io", name
+	if i := strings.Index(name, "/"); i >= 0 {
+		host := name[:i]
+		if strings.ContainsAny(host, ".:") || host == "localhost" {
+			registry, repository = host, name[i+1:]
+		}
+	}
+	if repository == "" {
+		return "", "", "", errors.Errorf("invalid oci reference %q", reference)
+	}
+	if registry == "docker.io" {
+		registry = dockerHubRegistry
+		if !strings.Contains(repository, "/") {
+			repository = "library/" + repository
+		}
+	}
+	return registry, repository, tagOrDigest, nil
+}
+
+// ovaLayer returns the layer of the manifest holding the OVA image.
+func ovaLayer(manifest *ociManifest) (*ociDescriptor, error) {
+	for i := range manifest.Layers {
+		if strings.HasSuffix(manifest.Layers[i].Annotations[ociTitleAnnotation], ".ova") {
+			return &manifest.Layers[i], nil
+		}
+	}
+	if len(manifest.Layers) != 1 {
+		return nil, errors.Errorf("expected a layer titled *.ova or a single layer, found %d layers", len(manifest.Layers))
+	}
+	return &manifest.Layers[0], nil
+}
+
+func (c *ociClient) manifest(ctx context.Context, tagOrDigest string) (*ociManifest, error) {
+	resp, err := c.get(ctx, c.baseURL+"/manifests/"+tagOrDigest, true, ociManifestMediaType, dockerManifestMediaType)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unable to get manifest %s of %s: %s", tagOrDigest, c.repository, resp.Status)
+	}
+	manifest := &ociManifest{}
+	if err := json.NewDecoder(resp.Body).Decode(manifest); err != nil {
+		return nil, errors.Wrapf(err, "unable to decode manifest %s of %s", tagOrDigest, c.repository)
+	}
+	return manifest, nil
+}
+
+func (c *ociClient) blobURL(ctx context.Context, digest string) (string, error) {
+	blobURL := c.baseURL + "/blobs/" + digest
+	resp, err := c.get(ctx, blobURL, false)
+	if err != nil {
+		return "", err
+	}
+	// Only the headers are of interest, the blob is downloaded by iCenter.
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 300 && resp.StatusCode < 400:
+		location, err := resp.Location()
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid redirect of blob %s of %s", digest, c.repository)
+		}
+		return location.String(), nil
+	case resp.StatusCode == http.StatusOK && c.authorization == "":
+		return blobURL, nil
+	case resp.StatusCode == http.StatusOK:
+		return "", errors.Errorf("blob %s of %s is only served with credentials, which iCenter cannot download with", digest, c.repository)
+	default:
+		return "", errors.Errorf("unable to get blob %s of %s: %s", digest, c.repository, resp.Status)
+	}
+}
+
+// get sends a GET request, answering the authentication challenge of the
+// registry once.
+func (c *ociClient) get(ctx context.Context, rawURL string, followRedirects bool, accept ...string) (*http.Response, error) {
+	client := &http.Client{}
+	if !followRedirects {
+		client.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+	do := func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, mediaType := range accept {
+			req.Header.Add("Accept", mediaType)
+		}
+		if c.authorization != "" {
+			req.Header.Set("Authorization", c.authorization)
+		}
+		resp, err := client.Do(req)
+		return resp, errors.Wrapf(err, "unable to get %s", rawURL)
+	}
+
+	resp, err := do()
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || c.authorization != "" {
+		return resp, err
+	}
+	challenge := resp.Header.Get("WWW-Authenticate")
+	resp.Body.Close()
+	if err := c.authorize(ctx, challenge); err != nil {
+		return nil, err
+	}
+	return do()
+}
+
+// authorize sets the Authorization header answering the challenge.
+func (c *ociClient) authorize(ctx context.Context, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if c.credentials == nil {
+			return errors.Errorf("registry of %s requires credentials", c.repository)
+		}
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(c.credentials.Username, c.credentials.Password)
+		c.authorization = req.Header.Get("Authorization")
+		return nil
+	case "bearer":
+		token, err := c.token(ctx, params)
+		if err != nil {
+			return err
+		}
+		c.authorization = "Bearer " + token
+		return nil
+	default:
+		return errors.Errorf("unsupported authentication challenge %q of the registry of %s", challenge, c.repository)
+	}
+}
+
+// token gets a pull token from the token service of the registry.
+func (c *ociClient) token(ctx context.Context, params map[string]string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", errors.Errorf("invalid token realm %q of the registry of %s", params["realm"], c.repository)
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", c.repository)
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if c.credentials != nil {
+		req.SetBasicAuth(c.credentials.Username, c.credentials.Password)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to get a token for %s", c.repository)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", errors.Errorf("unable to get a token for %s: %s: %s", c.repository, resp.Status, strings.TrimSpace(string(body)))
+	}
+	token := ociToken{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", errors.Wrapf(err, "unable to decode the token for %s", c.repository)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	if token.AccessToken != "" {
+		return token.AccessToken, nil
+	}
+	return "", errors.Errorf("empty token for %s", c.repository)
+}
+
+// parseChallenge splits a WWW-Authenticate header into its scheme and
+// parameters, e.g. Bearer realm="https://auth.example.com/token",service="registry".
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := map[string]string{}
+	scheme, rest := challenge, ""
+	if i := strings.Index(challenge, " "); i >= 0 {
+		scheme, rest = challenge[:i], challenge[i+1:]
+	}
+	for rest != "" {
+		rest = strings.TrimLeft(rest, " ,")
+		eq := strings.Index(rest, "=")
+		if eq < 0 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(rest[:eq]))
+		rest = rest[eq+1:]
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				value, rest = rest[1:], ""
+			} else {
+				value, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else if comma := strings.Index(rest, ","); comma >= 0 {
+			value, rest = rest[:comma], rest[comma+1:]
+		} else {
+			value, rest = rest, ""
+		}
+		params[key] = value
+	}
+	return scheme, params
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseOCIReference(t *testing.T) {
+	testCases := []struct {
+		reference      string
+		wantRegistry   string
+		wantRepository string
+		wantTag        string
+		expectErr      bool
+	}{
+		{
+			reference:      "registry.example.com/images/ubuntu-2004:v1.23.5",
+			wantRegistry:   "registry.example.com",
+			wantRepository: "images/ubuntu-2004",
+			wantTag:        "v1.23.5",
+		},
+		{
+			reference:      "localhost:5000/ubuntu-2004",
+			wantRegistry:   "localhost:5000",
+			wantRepository: "ubuntu-2004",
+			wantTag:        "latest",
+		},
+		{
+			reference:      "registry.example.com/ubuntu-2004@sha256:0123",
+			wantRegistry:   "registry.example.com",
+			wantRepository: "ubuntu-2004",
+			wantTag:        "sha256:0123",
+		},
+		{
+			reference:      "ics/ubuntu-2004:v1",
+			wantRegistry:   dockerHubRegistry,
+			wantRepository: "ics/ubuntu-2004",
+			wantTag:        "v1",
+		},
+		{
+			reference:      "ubuntu-2004",
+			wantRegistry:   dockerHubRegistry,
+			wantRepository: "library/ubuntu-2004",
+			wantTag:        "latest",
+		},
+		{
+			reference: "registry.example.com/ubuntu-2004:",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.reference, func(t *testing.T) {
+			registry, repository, tag, err := parseOCIReference(tc.reference)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s %s %s", registry, repository, tag)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if registry != tc.wantRegistry || repository != tc.wantRepository || tag != tc.wantTag {
+				t.Errorf("got %s %s %s, want %s %s %s", registry, repository, tag, tc.wantRegistry, tc.wantRepository, tc.wantTag)
+			}
+		})
+	}
+}
+
+// fakeRegistry serves a single artifact with an OVA layer. Pulling requires
+// a bearer token when a password is set.
+type fakeRegistry struct {
+	password string
+	// redirect is where blobs are redirected to, they are served by the
+	// registry when empty.
+	redirect string
+	layers   []ociDescriptor
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		if _, password, _ := req.BasicAuth(); password != r.password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(ociToken{Token: "pull-token"})
+		return
+	}
+	if r.password != "" && req.Header.Get("Authorization") != "Bearer pull-token" {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="http://%s/token",service="fake"`, req.Host))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case req.URL.Path == "/v2/images/ubuntu/manifests/v1":
+		_ = json.NewEncoder(w).Encode(ociManifest{MediaType: ociManifestMediaType, Layers: r.layers})
+	case strings.HasPrefix(req.URL.Path, "/v2/images/ubuntu/blobs/") && r.redirect != "":
+		http.Redirect(w, req, r.redirect, http.StatusTemporaryRedirect)
+	case strings.HasPrefix(req.URL.Path, "/v2/images/ubuntu/blobs/"):
+		_, _ = w.Write([]byte("ova"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestResolveOCIBlobURL(t *testing.T) {
+	ovaLayer := ociDescriptor{Digest: "sha256:ova", Annotations: map[string]string{ociTitleAnnotation: "ubuntu.ova"}}
+	otherLayer := ociDescriptor{Digest: "sha256:other"}
+
+	testCases := []struct {
+		name        string
+		registry    *fakeRegistry
+		credentials *OCICredentials
+		want        string
+		expectErr   bool
+	}{
+		{
+			name:     "anonymous registry serving blobs",
+			registry: &fakeRegistry{layers: []ociDescriptor{otherLayer, ovaLayer}},
+			want:     "/v2/images/ubuntu/blobs/sha256:ova",
+		},
+		{
+			name:      "several layers without title",
+			registry:  &fakeRegistry{layers: []ociDescriptor{otherLayer, otherLayer}},
+			expectErr: true,
+		},
+		{
+			name:        "authenticated registry redirecting blobs",
+			registry:    &fakeRegistry{password: "secret", redirect: "https://storage.example.com/blob?signature=1", layers: []ociDescriptor{otherLayer}},
+			credentials: &OCICredentials{Username: "user", Password: "secret"},
+			want:        "https://storage.example.com/blob?signature=1",
+		},
+		{
+			name:        "authenticated registry serving blobs",
+			registry:    &fakeRegistry{password: "secret", layers: []ociDescriptor{ovaLayer}},
+			credentials: &OCICredentials{Username: "user", Password: "secret"},
+			expectErr:   true,
+		},
+		{
+			name:        "wrong password",
+			registry:    &fakeRegistry{password: "secret", layers: []ociDescriptor{ovaLayer}},
+			credentials: &OCICredentials{Username: "user", Password: "wrong"},
+			expectErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(tc.registry)
+			defer server.Close()
+			host := strings.TrimPrefix(server.URL, "http://")
+
+			got, err := ResolveOCIBlobURL(context.Background(), host+"/images/ubuntu:v1", tc.credentials, true)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := tc.want
+			if strings.HasPrefix(want, "/") {
+				want = server.URL + want
+			}
+			if got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/ics-sigs/ics-go-sdk/client/methods"
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+	basestv1 "github.com/ics-sigs/ics-go-sdk/storage"
+)
+
+type downloadRequest struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// GetImageStorages returns the image datastores of iCenter.
+func GetImageStorages(ctx ovfContext) ([]basetypv1.Storage, error) {
+	storages, err := methods.GetImageStorageList(ctx, ctx.GetSession().Client)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list image datastores")
+	}
+	return storages.Items, nil
+}
+
+// FindImageFile returns the image file with the name on the image datastore,
+// or nil if there is none.
+func FindImageFile(ctx ovfContext, storageID, name string) (*basetypv1.ImageFileInfo, error) {
+	files, err := basestv1.NewStorageService(ctx.GetSession().Client).GetImageFileList(ctx, storageID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list the images of datastore %s", storageID)
+	}
+	for i := range files {
+		if files[i].Name == name {
+			return &files[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// DownloadImage asks iCenter to download the image at the URL into the image
+// datastore under the name. The download runs in the background, the image
+// file is listed with its checksum once it is complete.
+func DownloadImage(ctx ovfContext, storageID, name, url string) error {
+	api := basetypv1.ICSApi{Api: fmt.Sprintf("/storages/%s/files?action=download", storageID), Token: true}
+	resp, err := ctx.GetSession().Client.PostTrip(ctx, api, downloadRequest{Name: name, URL: url})
+	if _, err := methods.HandleResponse(resp, err); err != nil {
+		return errors.Wrapf(err, "failed to download image %q into datastore %s", name, storageID)
+	}
+	ctx.GetLogger().Info("downloading image", "datastore-id", storageID, "image", name)
+	return nil
+}
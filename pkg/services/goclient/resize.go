@@ -0,0 +1,344 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructure
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	clusterutilv1 "sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
+)
+
+// resizeCordonAnnotation marks a node that was cordoned to reboot its VM for
+// a resize, so that only nodes cordoned by the controller are uncordoned.
+const resizeCordonAnnotation = "icsvm.infrastructure.cluster.x-k8s.io/cordoned-for-resize"
+
+// resizeShutdownTimeout is how long the guest of a VM is given to shut down
+// for a resize before the VM is powered off.
+const resizeShutdownTimeout = 5 * time.Minute
+
+// reconcileResize applies changes to the CPU and memory of the spec to the
+// VM when the resize policy is InPlace. The change is hot-plugged if the VM
+// supports it, otherwise the node is drained and the VM is shut down,
+// resized and powered on again. A VM whose guest does not shut down within
+// resizeShutdownTimeout is powered off. It returns false while a resize is in
+// progress.
+func (vms *VMService) reconcileResize(ctx *virtualMachineContext) (bool, error) {
+	spec := &ctx.ICSVM.Spec.VirtualMachineCloneSpec
+	resizing := conditions.Get(ctx.ICSVM, infrav1.VMResizingCondition)
+	if spec.ResizePolicy != infrav1.ResizePolicyInPlace && resizing == nil {
+		return true, nil
+	}
+
+	vmObj, err := ctx.Obj.GetVM(ctx, ctx.Ref.Value)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get vm %s", ctx)
+	}
+
+	diff := diffCompute(spec, vmObj)
+	if len(diff) == 0 || spec.ResizePolicy != infrav1.ResizePolicyInPlace {
+		if resizing == nil {
+			return true, nil
+		}
+		// Let the VM be powered on again before the resize is completed.
+		if vmObj.Status != "STARTED" {
+			return true, nil
+		}
+		if resizing.Reason != infrav1.HotPluggingReason {
+			if err := uncordonNode(ctx); err != nil {
+				return false, err
+			}
+		}
+		conditions.Delete(ctx.ICSVM, infrav1.VMResizingCondition)
+		ctx.Recorder.Eventf(ctx.ICSVM, "Resized", "Resized vm to %d CPUs and %d MiB of memory", vmObj.CPUNum, vmObj.Memory)
+		return true, nil
+	}
+
+	msg := strings.Join(diff, "; ")
+	switch {
+	case vmObj.Status == "STOPPED":
+		return setVMCompute(ctx, spec, vmObj, infrav1.ResizingReason, msg)
+	case vmObj.Status == "STARTED" && canHotPlug(spec, vmObj):
+		return setVMCompute(ctx, spec, vmObj, infrav1.HotPluggingReason, msg)
+	case vmObj.Status != "STARTED":
+		ctx.Logger.Info("wait for vm to settle before resizing", "status", vmObj.Status)
+		return false, nil
+	}
+
+	// The change cannot be hot-plugged, so the VM has to be rebooted. Move
+	// the workloads away from the node first.
+	drained, err := drainNode(ctx)
+	if err != nil {
+		markResizing(ctx, infrav1.ResizeFailedReason, err.Error())
+		return false, err
+	}
+	if !drained {
+		markResizing(ctx, infrav1.DrainingForResizeReason, msg)
+		ctx.Logger.Info("wait for node to be drained before resizing")
+		return false, nil
+	}
+
+	if resizing == nil || resizing.Reason != infrav1.ShuttingDownForResizeReason {
+		task, err := ctx.Obj.ShutdownVM(ctx, ctx.Ref.Value)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to trigger shutdown op for vm %s", ctx)
+		}
+		markResizing(ctx, infrav1.ShuttingDownForResizeReason, msg)
+		ctx.ICSVM.Status.TaskRef = task.TaskId
+		ctx.Logger.Info("wait for vm to shut down before resizing")
+		return false, nil
+	}
+
+	// Only power the VM off once its guest had the time to shut down.
+	if time.Since(resizing.LastTransitionTime.Time) < resizeShutdownTimeout {
+		ctx.Logger.Info("wait for vm to shut down before resizing")
+		return false, nil
+	}
+	markResizing(ctx, infrav1.PoweringOffForResizeReason, msg)
+	task, err := ctx.Obj.PowerOffVM(ctx, ctx.Ref.Value)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to trigger power off op for vm %s", ctx)
+	}
+	ctx.ICSVM.Status.TaskRef = task.TaskId
+	ctx.Recorder.Warnf(ctx.ICSVM, "ShutdownTimedOut", "VM did not shut down within %s, powering it off", resizeShutdownTimeout)
+	ctx.Logger.Info("wait for vm to be powered off before resizing")
+	return false, nil
+}
+
+func setVMCompute(ctx *virtualMachineContext, spec *infrav1.VirtualMachineCloneSpec, vmObj *basetypv1.VirtualMachine, reason, msg string) (bool, error) {
+	task, err := ctx.Obj.SetVM(ctx, withSpecCompute(spec, *vmObj))
+	if err != nil {
+		markResizing(ctx, infrav1.ResizeFailedReason, err.Error())
+		ctx.Recorder.Warnf(ctx.ICSVM, "ResizeFailed", "Failed to resize vm: %v", err)
+		return false, errors.Wrapf(err, "failed to resize vm %s", ctx)
+	}
+	markResizing(ctx, reason, msg)
+	ctx.ICSVM.Status.TaskRef = task.TaskId
+	ctx.Logger.Info("wait for vm to be resized", "reason", reason)
+	return false, nil
+}
+
+func markResizing(ctx *virtualMachineContext, reason, msg string) {
+	conditions.Set(ctx.ICSVM, &clusterv1.Condition{
+		Type:    infrav1.VMResizingCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  reason,
+		Message: msg,
+	})
+}
+
+// canHotPlug returns true if the CPU and memory of the spec can be applied
+// to the running VM without a reboot.
+func canHotPlug(spec *infrav1.VirtualMachineCloneSpec, vmObj *basetypv1.VirtualMachine) bool {
+	guest := vmObj.GuestOsInfo
+	if cpus := int(spec.NumCPUs); cpus > 0 && cpus != vmObj.CPUNum {
+		if !vmObj.CPUHotplugEnabled {
+			return false
+		}
+		if cpus > vmObj.CPUNum && (!guest.SupportCPUHotPlug || (vmObj.MaxCPUNum > 0 && cpus > vmObj.MaxCPUNum)) {
+			return false
+		}
+		if cpus < vmObj.CPUNum && !guest.SupportCpuHotReduce {
+			return false
+		}
+	}
+	if memory := int(spec.MemoryMiB); memory > 0 && memory != vmObj.Memory {
+		if !vmObj.MemHotplugEnabled {
+			return false
+		}
+		if memory > vmObj.Memory && (!guest.SupportMemHotPlug || memory > vmObj.MaxMemory) {
+			return false
+		}
+		if memory < vmObj.Memory && !guest.SupportMemHotReduce {
+			return false
+		}
+	}
+	return true
+}
+
+// nodeClient returns a client for the workload cluster of the ICSVM.
+func nodeClient(ctx *virtualMachineContext) (kubernetes.Interface, error) {
+	cluster, err := clusterutilv1.GetClusterFromMetadata(ctx, ctx.Client, ctx.ICSVM.ObjectMeta)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get cluster of %s", ctx)
+	}
+	kubeClient, err := infrautilv1.NewKubeClient(ctx, ctx.Client, cluster)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get client for cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+	return kubeClient, nil
+}
+
+// nodeName returns the name of the node of the VM. The node of a machine is
+// taken from the NodeRef of its Machine, the node of a VM of a machine pool
+// is looked up by its provider ID. It returns an empty name for VMs that do
+// not back a node.
+func nodeName(ctx *virtualMachineContext, kubeClient kubernetes.Interface) (string, error) {
+	icsMachine, err := infrautilv1.GetOwnerICSMachine(ctx, ctx.Client, ctx.ICSVM.ObjectMeta)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get owner ICSMachine of %s", ctx)
+	}
+	if icsMachine != nil {
+		machine, err := clusterutilv1.GetOwnerMachine(ctx, ctx.Client, icsMachine.ObjectMeta)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get owner Machine of %s", ctx)
+		}
+		if machine == nil || machine.Status.NodeRef == nil {
+			return "", errors.Errorf("node of %s is not known yet", ctx)
+		}
+		return machine.Status.NodeRef.Name, nil
+	}
+
+	if !infrautilv1.IsMachinePoolVM(ctx.ICSVM) {
+		return "", nil
+	}
+	providerID := infrautilv1.ConvertUUIDToProviderID(ctx.ICSVM.Spec.BiosUUID)
+	if providerID == "" {
+		return "", errors.Errorf("node of %s is not known yet", ctx)
+	}
+	nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to list nodes")
+	}
+	for i := range nodes.Items {
+		if strings.EqualFold(nodes.Items[i].Spec.ProviderID, providerID) {
+			return nodes.Items[i].Name, nil
+		}
+	}
+	return "", errors.Errorf("no node with provider ID %s", providerID)
+}
+
+// drainNode cordons the node of the VM and evicts its pods. Pods of
+// DaemonSets and static pods are left in place. It returns true once no
+// other pods are left on the node. A node that cannot be found is an error,
+// the VM is not rebooted without knowing its workloads are gone.
+func drainNode(ctx *virtualMachineContext) (bool, error) {
+	kubeClient, err := nodeClient(ctx)
+	if err != nil {
+		return false, err
+	}
+	name, err := nodeName(ctx, kubeClient)
+	if err != nil || name == "" {
+		return err == nil, err
+	}
+	node, err := kubeClient.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get node %s", name)
+	}
+
+	if !node.Spec.Unschedulable {
+		node.Spec.Unschedulable = true
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+		node.Annotations[resizeCordonAnnotation] = "true"
+		if _, err := kubeClient.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{}); err != nil {
+			return false, errors.Wrapf(err, "failed to cordon node %s", node.Name)
+		}
+		ctx.Logger.Info("cordoned node", "node", node.Name)
+	}
+
+	pods, err := kubeClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("spec.nodeName=%s", node.Name),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list pods of node %s", node.Name)
+	}
+	remaining := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !needsEviction(pod) {
+			continue
+		}
+		remaining++
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		eviction := &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+		}
+		err := kubeClient.PolicyV1().Evictions(pod.Namespace).Evict(ctx, eviction)
+		switch {
+		case apierrors.IsNotFound(err):
+			remaining--
+		case apierrors.IsTooManyRequests(err):
+			ctx.Logger.Info("eviction blocked by disruption budget", "pod", pod.Namespace+"/"+pod.Name)
+		case err != nil:
+			return false, errors.Wrapf(err, "failed to evict pod %s/%s", pod.Namespace, pod.Name)
+		}
+	}
+	return remaining == 0, nil
+}
+
+// needsEviction returns true if the pod has to leave the node before it is
+// rebooted.
+func needsEviction(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if owner := metav1.GetControllerOf(pod); owner != nil && owner.Kind == "DaemonSet" {
+		return false
+	}
+	return true
+}
+
+// uncordonNode makes the node of the VM schedulable again if it was cordoned
+// for a resize.
+func uncordonNode(ctx *virtualMachineContext) error {
+	kubeClient, err := nodeClient(ctx)
+	if err != nil {
+		return err
+	}
+	name, err := nodeName(ctx, kubeClient)
+	if err != nil || name == "" {
+		return err
+	}
+	node, err := kubeClient.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get node %s", name)
+	}
+	if _, ok := node.Annotations[resizeCordonAnnotation]; !ok {
+		return nil
+	}
+	node.Spec.Unschedulable = false
+	delete(node.Annotations, resizeCordonAnnotation)
+	if _, err := kubeClient.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to uncordon node %s", node.Name)
+	}
+	ctx.Logger.Info("uncordoned node", "node", node.Name)
+	return nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructure
+
+import (
+	goctx "context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+)
+
+func TestNodeName(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+
+	ownerRef := func(apiVersion, kind, name string) metav1.OwnerReference {
+		return metav1.OwnerReference{APIVersion: apiVersion, Kind: kind, Name: name}
+	}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "machine-0"},
+		Status:     clusterv1.MachineStatus{NodeRef: &corev1.ObjectReference{Name: "node-0"}},
+	}
+	pendingMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "machine-1"},
+	}
+	icsMachines := []client.Object{
+		&infrav1.ICSMachine{ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            "icsmachine-0",
+			OwnerReferences: []metav1.OwnerReference{ownerRef(clusterv1.GroupVersion.String(), "Machine", "machine-0")},
+		}},
+		&infrav1.ICSMachine{ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            "icsmachine-1",
+			OwnerReferences: []metav1.OwnerReference{ownerRef(clusterv1.GroupVersion.String(), "Machine", "machine-1")},
+		}},
+	}
+	ctrlClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(icsMachines, machine, pendingMachine)...).Build()
+	kubeClient := kubefake.NewSimpleClientset(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool-node-0"},
+		Spec:       corev1.NodeSpec{ProviderID: "ics://42a1b2c3-0000-1111-2222-333344445555"},
+	})
+
+	testCases := []struct {
+		name      string
+		ownerRef  metav1.OwnerReference
+		biosUUID  string
+		want      string
+		expectErr bool
+	}{
+		{
+			name:     "vm of a machine",
+			ownerRef: ownerRef(infrav1.GroupVersion.String(), "ICSMachine", "icsmachine-0"),
+			want:     "node-0",
+		},
+		{
+			name:      "vm of a machine without node",
+			ownerRef:  ownerRef(infrav1.GroupVersion.String(), "ICSMachine", "icsmachine-1"),
+			expectErr: true,
+		},
+		{
+			name:     "vm of a machine pool",
+			ownerRef: ownerRef(infrav1.GroupVersion.String(), "ICSMachinePool", "pool"),
+			biosUUID: "42A1B2C3-0000-1111-2222-333344445555",
+			want:     "pool-node-0",
+		},
+		{
+			name:      "vm of a machine pool without node",
+			ownerRef:  ownerRef(infrav1.GroupVersion.String(), "ICSMachinePool", "pool"),
+			biosUUID:  "42a1b2c3-0000-1111-2222-999999999999",
+			expectErr: true,
+		},
+		{
+			name:     "vm of a load balancer",
+			ownerRef: ownerRef(infrav1.GroupVersion.String(), "ICSHAProxyLoadBalancer", "lb"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &virtualMachineContext{VMContext: context.VMContext{
+				ControllerContext: &context.ControllerContext{
+					ControllerManagerContext: &context.ControllerManagerContext{
+						Context: goctx.Background(),
+						Client:  ctrlClient,
+					},
+				},
+				ICSVM: &infrav1.ICSVM{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:       "default",
+						Name:            "vm-0",
+						OwnerReferences: []metav1.OwnerReference{tc.ownerRef},
+					},
+					Spec: infrav1.ICSVMSpec{BiosUUID: tc.biosUUID},
+				},
+			}}
+			got, err := nodeName(ctx, kubeClient)
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("got error %v, expected error %t", err, tc.expectErr)
+			}
+			if got != tc.want {
+				t.Errorf("got node %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
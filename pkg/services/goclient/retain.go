@@ -0,0 +1,180 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructure
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+	basevolv1 "github.com/ics-sigs/ics-go-sdk/volume"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
+)
+
+// markRetained records in the ownership metadata of the VM that it is kept
+// after the deletion of its ICSVM. It returns false while the description is
+// being updated.
+func (vms *VMService) markRetained(ctx *virtualMachineContext) (bool, error) {
+	vmObj, err := ctx.Obj.GetVM(ctx, ctx.Ref.Value)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get vm %s", ctx)
+	}
+
+	description, changed := retainedDescription(vmObj.Description, ctx.ICSVM)
+	if !changed {
+		return true, nil
+	}
+	vmObj.Description = description
+	task, err := ctx.Obj.SetVM(ctx, *vmObj)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to mark vm %s as retained", ctx)
+	}
+	ctx.ICSVM.Status.TaskRef = task.TaskId
+	ctx.Logger.Info("wait for vm to be marked as retained")
+	return false, nil
+}
+
+// detachDataDisks detaches all but the system disk from the VM so that the
+// data volumes survive the deletion of the VM. Every data volume is marked as
+// retained in its own description in iCenter before it is detached, which is
+// the durable record of where the data of the machine went. It returns true
+// while a volume is being marked or the volumes are being detached, with the
+// task recorded in the TaskRef of the ICSVM. Once the volumes are detached,
+// they are checked to still exist outside of the VM before the VM may be
+// deleted.
+func (vms *VMService) detachDataDisks(ctx *virtualMachineContext) (bool, error) {
+	vmObj, err := ctx.Obj.GetVM(ctx, ctx.Ref.Value)
+	if err != nil {
+		ctx.Logger.Error(err, "fail to get vm info from ics")
+		return false, err
+	}
+
+	volumeService := basevolv1.NewVolumeService(ctx.Session.Client)
+	if len(vmObj.Disks) <= 1 {
+		for _, volumeID := range ctx.ICSVM.Status.RetainedVolumes {
+			volume, err := volumeService.GetVolumeInfoById(ctx, volumeID)
+			if err != nil {
+				return false, errors.Wrapf(err, "failed to get retained volume %s of vm %s", volumeID, ctx)
+			}
+			if err := checkDetachedVolume(vmObj, &volume); err != nil {
+				ctx.Recorder.Warnf(ctx.ICSVM, "RetainedVolumeCheckFailed", "Not deleting VM %s: %v", ctx.Ref.Value, err)
+				return false, err
+			}
+		}
+		return false, nil
+	}
+
+	// The volumes are marked one at a time, each with its own task. A volume
+	// whose task failed is still unmarked when it is read again on the next
+	// pass and is marked again.
+	for _, disk := range vmObj.Disks[1:] {
+		volume, err := volumeService.GetVolumeInfoById(ctx, disk.Volume.ID)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get volume %s of vm %s", disk.Volume.ID, ctx)
+		}
+		description, changed := retainedDescription(volume.Description, ctx.ICSVM)
+		if !changed {
+			continue
+		}
+		volume.Description = description
+		task, err := volumeService.SetVolume(ctx, volume.ID, volume)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to mark volume %s of vm %s as retained", volume.ID, ctx)
+		}
+		ctx.ICSVM.Status.TaskRef = task.TaskId
+		ctx.Logger.Info("wait for volume to be marked as retained", "volume-id", volume.ID)
+		return true, nil
+	}
+
+	detached, volumeIDs := withoutDataDisks(vmObj)
+	task, err := ctx.Obj.SetVM(ctx, *detached)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to detach data disks from vm %s", ctx)
+	}
+	ctx.ICSVM.Status.TaskRef = task.TaskId
+	ctx.ICSVM.Status.RetainedVolumes = mergeVolumeIDs(ctx.ICSVM.Status.RetainedVolumes, volumeIDs)
+	ctx.Logger.Info("wait for data disks to be detached", "volume-ids", volumeIDs)
+	ctx.Recorder.Eventf(ctx.ICSVM, "RetainedVolumes", "Detached data volumes %s from VM %s", strings.Join(volumeIDs, ","), ctx.Ref.Value)
+	return true, nil
+}
+
+// retainedDescription returns the description of a VM or volume with an
+// ownership line that marks it as retained from the ICSVM. It returns false
+// if the description already does.
+func retainedDescription(description string, icsVM *infrav1.ICSVM) (string, bool) {
+	owner, ok := infrautilv1.ParseVMOwner(description)
+	if ok && owner.Retained {
+		return description, false
+	}
+	owner = infrautilv1.VMOwnerFor(icsVM)
+	owner.Retained = true
+	return infrautilv1.SetVMOwnerDescription(description, owner), true
+}
+
+// withoutDataDisks returns a copy of the VM that only keeps its system disk,
+// and the IDs of the volumes of the dropped data disks.
+func withoutDataDisks(vmObj *basetypv1.VirtualMachine) (*basetypv1.VirtualMachine, []string) {
+	detached := *vmObj
+	if len(vmObj.Disks) <= 1 {
+		return &detached, nil
+	}
+	volumeIDs := make([]string, 0, len(vmObj.Disks)-1)
+	for _, disk := range vmObj.Disks[1:] {
+		volumeIDs = append(volumeIDs, disk.Volume.ID)
+	}
+	detached.Disks = []basetypv1.Disk{vmObj.Disks[0]}
+	return &detached, volumeIDs
+}
+
+// checkDetachedVolume returns an error unless the volume still exists and is
+// no longer attached to the VM.
+func checkDetachedVolume(vmObj *basetypv1.VirtualMachine, volume *basetypv1.Volume) error {
+	if volume.ID == "" {
+		return errors.New("retained volume no longer exists")
+	}
+	for _, disk := range vmObj.Disks {
+		if disk.Volume.ID == volume.ID {
+			return errors.Errorf("volume %s is still attached", volume.ID)
+		}
+	}
+	for _, related := range volume.RelatedVms {
+		if related.ID == vmObj.ID {
+			return errors.Errorf("volume %s is still attached", volume.ID)
+		}
+	}
+	return nil
+}
+
+// mergeVolumeIDs appends the volume IDs that are not recorded yet.
+func mergeVolumeIDs(recorded, volumeIDs []string) []string {
+	for _, volumeID := range volumeIDs {
+		found := false
+		for _, id := range recorded {
+			if id == volumeID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			recorded = append(recorded, volumeID)
+		}
+	}
+	return recorded
+}
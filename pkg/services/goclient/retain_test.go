@@ -0,0 +1,161 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructure
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
+)
+
+func newRetainTestICSVM() *infrav1.ICSVM {
+	return &infrav1.ICSVM{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "worker-0",
+			UID:       "icsvm-uid",
+			Labels:    map[string]string{clusterv1.ClusterLabelName: "c1"},
+		},
+	}
+}
+
+func TestRetainedDescription(t *testing.T) {
+	icsVM := newRetainTestICSVM()
+	owner := infrautilv1.VMOwnerFor(icsVM)
+
+	testCases := []struct {
+		name        string
+		description string
+		wantChanged bool
+	}{
+		{
+			name:        "vm created by the provider",
+			description: owner.Description() + "\nnotes",
+			wantChanged: true,
+		},
+		{
+			name:        "volume without ownership metadata",
+			description: "data",
+			wantChanged: true,
+		},
+		{
+			name:        "already retained",
+			description: func() string { o := owner; o.Retained = true; return o.Description() }(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			description, changed := retainedDescription(tc.description, icsVM)
+			if changed != tc.wantChanged {
+				t.Fatalf("got changed %t, want %t", changed, tc.wantChanged)
+			}
+			got, ok := infrautilv1.ParseVMOwner(description)
+			if !ok || !got.Retained || got.UID != owner.UID || got.Namespace != owner.Namespace || got.Name != owner.Name {
+				t.Errorf("got owner %+v from %q, want retained %+v", got, description, owner)
+			}
+		})
+	}
+}
+
+func TestWithoutDataDisks(t *testing.T) {
+	vmObj := &basetypv1.VirtualMachine{
+		ID: "vm-1",
+		Disks: []basetypv1.Disk{
+			{Volume: basetypv1.Volume{ID: "sys"}},
+			{Volume: basetypv1.Volume{ID: "data-1"}},
+			{Volume: basetypv1.Volume{ID: "data-2"}},
+		},
+	}
+
+	detached, volumeIDs := withoutDataDisks(vmObj)
+	if !reflect.DeepEqual(volumeIDs, []string{"data-1", "data-2"}) {
+		t.Errorf("got volume IDs %v", volumeIDs)
+	}
+	if len(detached.Disks) != 1 || detached.Disks[0].Volume.ID != "sys" {
+		t.Errorf("got disks %+v, want only the system disk", detached.Disks)
+	}
+	if len(vmObj.Disks) != 3 {
+		t.Errorf("the disks of the vm were modified: %+v", vmObj.Disks)
+	}
+
+	if _, volumeIDs := withoutDataDisks(detached); volumeIDs != nil {
+		t.Errorf("got volume IDs %v for a vm without data disks", volumeIDs)
+	}
+}
+
+func TestCheckDetachedVolume(t *testing.T) {
+	vmObj := &basetypv1.VirtualMachine{
+		ID:    "vm-1",
+		Disks: []basetypv1.Disk{{Volume: basetypv1.Volume{ID: "sys"}}},
+	}
+
+	testCases := []struct {
+		name      string
+		volume    basetypv1.Volume
+		expectErr bool
+	}{
+		{
+			name:   "detached volume",
+			volume: basetypv1.Volume{ID: "data-1"},
+		},
+		{
+			name:   "volume attached to another vm",
+			volume: basetypv1.Volume{ID: "data-1", RelatedVms: []basetypv1.RelatedVmInfo{{ID: "vm-2"}}},
+		},
+		{
+			name:      "deleted volume",
+			volume:    basetypv1.Volume{},
+			expectErr: true,
+		},
+		{
+			name:      "volume still related to the vm",
+			volume:    basetypv1.Volume{ID: "data-1", RelatedVms: []basetypv1.RelatedVmInfo{{ID: "vm-1"}}},
+			expectErr: true,
+		},
+		{
+			name:      "volume still a disk of the vm",
+			volume:    basetypv1.Volume{ID: "sys"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkDetachedVolume(vmObj, &tc.volume)
+			if (err != nil) != tc.expectErr {
+				t.Errorf("got error %v, expected error %t", err, tc.expectErr)
+			}
+		})
+	}
+}
+
+func TestMergeVolumeIDs(t *testing.T) {
+	got := mergeVolumeIDs([]string{"data-1"}, []string{"data-1", "data-2"})
+	if !reflect.DeepEqual(got, []string{"data-1", "data-2"}) {
+		t.Errorf("got %v", got)
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdn
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/ics-sigs/ics-go-sdk/client/methods"
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+)
+
+// LoadBalancer is a load balancer of the SDN.
+type LoadBalancer struct {
+	ID                 string     `json:"id,omitempty"`
+	Name               string     `json:"name"`
+	NetworkID          string     `json:"networkId,omitempty"`
+	SubnetID           string     `json:"subnetId"`
+	VIPAddress         string     `json:"vipAddress,omitempty"`
+	ProvisioningStatus string     `json:"provisioningStatus,omitempty"`
+	Listeners          []Listener `json:"listeners,omitempty"`
+}
+
+// Listener is a listener of a load balancer, forwarding the traffic of a
+// port to a backend pool.
+type Listener struct {
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name"`
+	Protocol     string `json:"protocol"`
+	ProtocolPort int32  `json:"protocolPort"`
+	PoolID       string `json:"poolId,omitempty"`
+}
+
+// PoolMember is a backend of the pool of a listener.
+type PoolMember struct {
+	ID           string `json:"id,omitempty"`
+	Address      string `json:"address"`
+	ProtocolPort int32  `json:"protocolPort"`
+}
+
+type listenerRequest struct {
+	Listener
+	LBAlgorithm   string        `json:"lbAlgorithm"`
+	HealthMonitor healthMonitor `json:"healthMonitor"`
+}
+
+type healthMonitor struct {
+	Type       string `json:"type"`
+	Delay      int    `json:"delay"`
+	Timeout    int    `json:"timeout"`
+	MaxRetries int    `json:"maxRetries"`
+}
+
+type loadBalancerPageResponse struct {
+	Items []LoadBalancer `json:"items"`
+}
+
+type poolMemberPageResponse struct {
+	Items []PoolMember `json:"items"`
+}
+
+// EnsureLoadBalancer returns the load balancer with the name of the spec,
+// creating it from the spec if it does not exist. It returns nil while a
+// created load balancer is not listed yet.
+func EnsureLoadBalancer(ctx sdnContext, spec LoadBalancer) (*LoadBalancer, error) {
+	lb, err := findLoadBalancer(ctx, spec.Name)
+	if err != nil || lb != nil {
+		return lb, err
+	}
+	if err := post(ctx, "/loadbalancers", spec); err != nil {
+		return nil, errors.Wrapf(err, "failed to create load balancer %q", spec.Name)
+	}
+	ctx.GetLogger().Info("created load balancer", "load-balancer", spec.Name, "subnet-id", spec.SubnetID)
+	return nil, nil
+}
+
+// EnsureListener returns the TCP listener of the load balancer for the port,
+// creating it with a round robin backend pool and a TCP health monitor if it
+// does not exist. It returns nil while the listener or its pool is being
+// created.
+func EnsureListener(ctx sdnContext, lb *LoadBalancer, port int32) (*Listener, error) {
+	name := fmt.Sprintf("%s-%d", lb.Name, port)
+	for i := range lb.Listeners {
+		if lb.Listeners[i].Name != name {
+			continue
+		}
+		if lb.Listeners[i].PoolID == "" {
+			return nil, nil
+		}
+		return &lb.Listeners[i], nil
+	}
+	request := listenerRequest{
+		Listener:      Listener{Name: name, Protocol: "TCP", ProtocolPort: port},
+		LBAlgorithm:   "ROUND_ROBIN",
+		HealthMonitor: healthMonitor{Type: "TCP", Delay: 5, Timeout: 5, MaxRetries: 3},
+	}
+	if err := post(ctx, fmt.Sprintf("/loadbalancers/%s/listeners", lb.ID), request); err != nil {
+		return nil, errors.Wrapf(err, "failed to create listener %q of load balancer %s", name, lb.ID)
+	}
+	ctx.GetLogger().Info("created load balancer listener", "load-balancer-id", lb.ID, "listener", name)
+	return nil, nil
+}
+
+// ReconcilePoolMembers makes the members of the pool match the addresses.
+// Members with other addresses are removed and missing addresses are added
+// with the port. It returns true if the pool was changed.
+func ReconcilePoolMembers(ctx sdnContext, poolID string, addresses []string, port int32) (bool, error) {
+	api := basetypv1.ICSApi{Api: fmt.Sprintf("/pools/%s/members", poolID), Token: true}
+	resp, err := ctx.GetSession().Client.GetTrip(ctx, api, nil)
+	body, err := methods.HandleResponse(resp, err)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list members of pool %s", poolID)
+	}
+	members := poolMemberPageResponse{}
+	if err := json.Unmarshal(body, &members); err != nil {
+		return false, errors.Wrapf(err, "failed to decode members of pool %s", poolID)
+	}
+
+	wanted := map[string]bool{}
+	for _, address := range addresses {
+		wanted[address] = true
+	}
+	changed := false
+	existing := map[string]bool{}
+	for _, member := range members.Items {
+		if wanted[member.Address] && member.ProtocolPort == port && !existing[member.Address] {
+			existing[member.Address] = true
+			continue
+		}
+		if err := remove(ctx, fmt.Sprintf("/pools/%s/members/%s", poolID, member.ID)); err != nil {
+			return changed, errors.Wrapf(err, "failed to remove member %s from pool %s", member.Address, poolID)
+		}
+		ctx.GetLogger().Info("removed load balancer member", "pool-id", poolID, "address", member.Address)
+		changed = true
+	}
+	for _, address := range addresses {
+		if existing[address] {
+			continue
+		}
+		member := PoolMember{Address: address, ProtocolPort: port}
+		if err := post(ctx, fmt.Sprintf("/pools/%s/members", poolID), member); err != nil {
+			return changed, errors.Wrapf(err, "failed to add member %s to pool %s", address, poolID)
+		}
+		existing[address] = true
+		ctx.GetLogger().Info("added load balancer member", "pool-id", poolID, "address", address)
+		changed = true
+	}
+	return changed, nil
+}
+
+// DeleteLoadBalancer deletes the load balancer with the name, along with its
+// listeners and pools. It returns true once the load balancer is gone.
+func DeleteLoadBalancer(ctx sdnContext, name string) (bool, error) {
+	lb, err := findLoadBalancer(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if lb == nil {
+		return true, nil
+	}
+	if err := remove(ctx, fmt.Sprintf("/loadbalancers/%s?cascade=true", lb.ID)); err != nil {
+		return false, errors.Wrapf(err, "failed to delete load balancer %s", lb.ID)
+	}
+	ctx.GetLogger().Info("deleted load balancer", "load-balancer-id", lb.ID)
+	return false, nil
+}
+
+func findLoadBalancer(ctx sdnContext, name string) (*LoadBalancer, error) {
+	api := basetypv1.ICSApi{Api: "/loadbalancers", Token: true}
+	resp, err := ctx.GetSession().Client.GetTrip(ctx, api, nil)
+	body, err := methods.HandleResponse(resp, err)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list load balancers")
+	}
+	lbs := loadBalancerPageResponse{}
+	if err := json.Unmarshal(body, &lbs); err != nil {
+		return nil, errors.Wrap(err, "failed to decode load balancers")
+	}
+	for i := range lbs.Items {
+		if lbs.Items[i].Name == name {
+			return &lbs.Items[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdn
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestReconcilePoolMembers(t *testing.T) {
+	testCases := []struct {
+		name      string
+		members   []PoolMember
+		addresses []string
+		requests  []string
+		added     []PoolMember
+	}{
+		{
+			name:      "adds the missing members",
+			members:   []PoolMember{{ID: "m-1", Address: "10.6.0.10", ProtocolPort: 6443}},
+			addresses: []string{"10.6.0.10", "10.6.0.11", "10.6.0.12"},
+			requests:  []string{"POST /pools/pool-1/members", "POST /pools/pool-1/members"},
+			added:     []PoolMember{{Address: "10.6.0.11", ProtocolPort: 6443}, {Address: "10.6.0.12", ProtocolPort: 6443}},
+		},
+		{
+			name: "removes the members of deleted machines",
+			members: []PoolMember{
+				{ID: "m-1", Address: "10.6.0.10", ProtocolPort: 6443},
+				{ID: "m-2", Address: "10.6.0.11", ProtocolPort: 6443},
+			},
+			addresses: []string{"10.6.0.11"},
+			requests:  []string{"DELETE /pools/pool-1/members/m-1"},
+		},
+		{
+			name: "removes the duplicated members",
+			members: []PoolMember{
+				{ID: "m-1", Address: "10.6.0.10", ProtocolPort: 6443},
+				{ID: "m-2", Address: "10.6.0.10", ProtocolPort: 6443},
+			},
+			addresses: []string{"10.6.0.10"},
+			requests:  []string{"DELETE /pools/pool-1/members/m-2"},
+		},
+		{
+			name:      "replaces the members on another port",
+			members:   []PoolMember{{ID: "m-1", Address: "10.6.0.10", ProtocolPort: 443}},
+			addresses: []string{"10.6.0.10"},
+			requests:  []string{"DELETE /pools/pool-1/members/m-1", "POST /pools/pool-1/members"},
+			added:     []PoolMember{{Address: "10.6.0.10", ProtocolPort: 6443}},
+		},
+		{
+			name: "keeps the members unchanged",
+			members: []PoolMember{
+				{ID: "m-1", Address: "10.6.0.10", ProtocolPort: 6443},
+				{ID: "m-2", Address: "10.6.0.11", ProtocolPort: 6443},
+			},
+			addresses: []string{"10.6.0.11", "10.6.0.10"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sdn := &fakeSDN{responses: map[string]interface{}{"GET /pools/pool-1/members": items(tc.members)}}
+			changed, err := ReconcilePoolMembers(newTestContext(t, sdn), "pool-1", tc.addresses, 6443)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if changed != (len(tc.requests) > 0) {
+				t.Errorf("got changed %t with requests %v", changed, sdn.requests)
+			}
+			if !reflect.DeepEqual(sdn.requests, tc.requests) {
+				t.Fatalf("got requests %v, want %v", sdn.requests, tc.requests)
+			}
+			var added []PoolMember
+			for i, request := range sdn.requests {
+				if request != "POST /pools/pool-1/members" {
+					continue
+				}
+				member := PoolMember{}
+				if err := json.Unmarshal([]byte(sdn.bodies[i]), &member); err != nil {
+					t.Fatal(err)
+				}
+				added = append(added, member)
+			}
+			if !reflect.DeepEqual(added, tc.added) {
+				t.Errorf("got added members %+v, want %+v", added, tc.added)
+			}
+		})
+	}
+}
+
+func TestEnsureLoadBalancer(t *testing.T) {
+	spec := LoadBalancer{Name: "test", SubnetID: "subnet-1"}
+	existing := LoadBalancer{ID: "lb-1", Name: "test", SubnetID: "subnet-1", VIPAddress: "10.6.0.5"}
+
+	testCases := []struct {
+		name     string
+		lbs      []LoadBalancer
+		expected *LoadBalancer
+		requests []string
+	}{
+		{
+			name:     "creates the missing load balancer",
+			lbs:      []LoadBalancer{{ID: "lb-2", Name: "other"}},
+			requests: []string{"POST /loadbalancers"},
+		},
+		{
+			name:     "returns the existing load balancer",
+			lbs:      []LoadBalancer{existing},
+			expected: &existing,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sdn := &fakeSDN{responses: map[string]interface{}{"GET /loadbalancers": items(tc.lbs)}}
+			lb, err := EnsureLoadBalancer(newTestContext(t, sdn), spec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(lb, tc.expected) {
+				t.Errorf("got load balancer %+v, want %+v", lb, tc.expected)
+			}
+			if !reflect.DeepEqual(sdn.requests, tc.requests) {
+				t.Errorf("got requests %v, want %v", sdn.requests, tc.requests)
+			}
+		})
+	}
+}
+
+func TestEnsureListener(t *testing.T) {
+	listener := Listener{ID: "listener-1", Name: "test-6443", Protocol: "TCP", ProtocolPort: 6443, PoolID: "pool-1"}
+	pending := listener
+	pending.PoolID = ""
+
+	testCases := []struct {
+		name      string
+		listeners []Listener
+		expected  *Listener
+		requests  []string
+	}{
+		{
+			name:      "creates the missing listener",
+			listeners: []Listener{{ID: "listener-2", Name: "test-443", PoolID: "pool-2"}},
+			requests:  []string{"POST /loadbalancers/lb-1/listeners"},
+		},
+		{
+			name:      "waits for the pool of the listener",
+			listeners: []Listener{pending},
+		},
+		{
+			name:      "returns the existing listener",
+			listeners: []Listener{listener},
+			expected:  &listener,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sdn := &fakeSDN{}
+			lb := &LoadBalancer{ID: "lb-1", Name: "test", Listeners: tc.listeners}
+			actual, err := EnsureListener(newTestContext(t, sdn), lb, 6443)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("got listener %+v, want %+v", actual, tc.expected)
+			}
+			if !reflect.DeepEqual(sdn.requests, tc.requests) {
+				t.Errorf("got requests %v, want %v", sdn.requests, tc.requests)
+			}
+		})
+	}
+}
+
+func TestEnsureListenerCreatesPool(t *testing.T) {
+	sdn := &fakeSDN{}
+	lb := &LoadBalancer{ID: "lb-1", Name: "test"}
+	if _, err := EnsureListener(newTestContext(t, sdn), lb, 6443); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sdn.bodies) != 1 {
+		t.Fatalf("got requests %v, want one", sdn.requests)
+	}
+	expected, _ := json.Marshal(listenerRequest{
+		Listener:      Listener{Name: "test-6443", Protocol: "TCP", ProtocolPort: 6443},
+		LBAlgorithm:   "ROUND_ROBIN",
+		HealthMonitor: healthMonitor{Type: "TCP", Delay: 5, Timeout: 5, MaxRetries: 3},
+	})
+	if sdn.bodies[0] != string(expected) {
+		t.Errorf("got body %s, want %s", sdn.bodies[0], expected)
+	}
+}
+
+// TestReconcileLoadBalancerIdempotent checks that reconciling a load
+// balancer whose listener and members are in place changes nothing.
+func TestReconcileLoadBalancerIdempotent(t *testing.T) {
+	listener := Listener{ID: "listener-1", Name: "test-6443", Protocol: "TCP", ProtocolPort: 6443, PoolID: "pool-1"}
+	existing := LoadBalancer{ID: "lb-1", Name: "test", SubnetID: "subnet-1", Listeners: []Listener{listener}}
+	sdn := &fakeSDN{responses: map[string]interface{}{
+		"GET /loadbalancers":        items([]LoadBalancer{existing}),
+		"GET /pools/pool-1/members": items([]PoolMember{{ID: "m-1", Address: "10.6.0.10", ProtocolPort: 6443}}),
+	}}
+	ctx := newTestContext(t, sdn)
+
+	for i := 0; i < 2; i++ {
+		lb, err := EnsureLoadBalancer(ctx, LoadBalancer{Name: "test", SubnetID: "subnet-1"})
+		if err != nil || lb == nil {
+			t.Fatalf("got load balancer %+v and error %v", lb, err)
+		}
+		actual, err := EnsureListener(ctx, lb, 6443)
+		if err != nil || actual == nil {
+			t.Fatalf("got listener %+v and error %v", actual, err)
+		}
+		changed, err := ReconcilePoolMembers(ctx, actual.PoolID, []string{"10.6.0.10"}, 6443)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if changed {
+			t.Error("expected the pool to be unchanged")
+		}
+	}
+	if len(sdn.requests) != 0 {
+		t.Errorf("got requests %v, want none", sdn.requests)
+	}
+}
+
+func TestDeleteLoadBalancer(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lbs      []LoadBalancer
+		deleted  bool
+		requests []string
+	}{
+		{
+			name:     "deletes the load balancer with its listeners and pools",
+			lbs:      []LoadBalancer{{ID: "lb-1", Name: "test"}},
+			requests: []string{"DELETE /loadbalancers/lb-1?cascade=true"},
+		},
+		{
+			name:    "load balancer is gone",
+			lbs:     []LoadBalancer{{ID: "lb-2", Name: "other"}},
+			deleted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sdn := &fakeSDN{responses: map[string]interface{}{"GET /loadbalancers": items(tc.lbs)}}
+			deleted, err := DeleteLoadBalancer(newTestContext(t, sdn), "test")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if deleted != tc.deleted {
+				t.Errorf("got deleted %t, want %t", deleted, tc.deleted)
+			}
+			if !reflect.DeepEqual(sdn.requests, tc.requests) {
+				t.Errorf("got requests %v, want %v", sdn.requests, tc.requests)
+			}
+		})
+	}
+}
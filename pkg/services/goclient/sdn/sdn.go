@@ -0,0 +1,256 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	"github.com/ics-sigs/ics-go-sdk/client/methods"
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+	basenetv1 "github.com/ics-sigs/ics-go-sdk/network"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
+)
+
+type sdnContext interface {
+	context.Context
+	GetLogger() logr.Logger
+	GetSession() *session.Session
+}
+
+type networkRequest struct {
+	Name        string `json:"name"`
+	NetworkType string `json:"networkType,omitempty"`
+}
+
+type subnetRequest struct {
+	Name           string   `json:"name"`
+	Cidr           string   `json:"cidr"`
+	Gateway        string   `json:"gateway"`
+	EnableDHCP     bool     `json:"enableDHCP"`
+	DNSNameservers []string `json:"dnsNameservers,omitempty"`
+}
+
+type router struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	ExternalNetworkID string `json:"externalNetworkId,omitempty"`
+}
+
+type routerPageResponse struct {
+	Items []router `json:"items"`
+}
+
+type routerInterface struct {
+	SubnetID string `json:"subnetId"`
+}
+
+// ReconcileNetwork makes sure the SDN network, subnet and router with the
+// name exist and that the subnet is attached to the router. Missing
+// resources are created one at a time. It returns the IDs of the resources
+// that exist and true once all of them are in place.
+func ReconcileNetwork(ctx sdnContext, name string, spec *infrav1.ClusterNetworkSpec) (*infrav1.ClusterNetworkStatus, bool, error) {
+	status := &infrav1.ClusterNetworkStatus{}
+
+	network, err := findNetwork(ctx, name)
+	if err != nil {
+		return status, false, err
+	}
+	if network == nil {
+		request := networkRequest{Name: name, NetworkType: spec.NetworkType}
+		if err := post(ctx, "/networks?type=extension", request); err != nil {
+			return status, false, errors.Wrapf(err, "failed to create sdn network %q", name)
+		}
+		ctx.GetLogger().Info("created sdn network", "network", name)
+		return status, false, nil
+	}
+	status.NetworkID = network.ID
+	status.NetworkName = network.Name
+
+	subnet := findSubnet(network, name)
+	if subnet == nil {
+		gateway := spec.Gateway
+		if gateway == "" {
+			if gateway, err = firstAddress(spec.CIDR); err != nil {
+				return status, false, err
+			}
+		}
+		request := subnetRequest{
+			Name:           name,
+			Cidr:           spec.CIDR,
+			Gateway:        gateway,
+			EnableDHCP:     true,
+			DNSNameservers: spec.DNSServers,
+		}
+		if err := post(ctx, fmt.Sprintf("/networks/%s/subnets?type=extension", network.ID), request); err != nil {
+			return status, false, errors.Wrapf(err, "failed to create subnet %q of sdn network %s", name, network.ID)
+		}
+		ctx.GetLogger().Info("created sdn subnet", "network-id", network.ID, "subnet", name, "cidr", spec.CIDR)
+		return status, false, nil
+	}
+	status.SubnetID = subnet.ID
+	status.SubnetName = subnet.Name
+
+	r, err := findRouter(ctx, name)
+	if err != nil {
+		return status, false, err
+	}
+	if r == nil {
+		request := router{Name: name, ExternalNetworkID: spec.ExternalNetworkID}
+		if err := post(ctx, "/routers", request); err != nil {
+			return status, false, errors.Wrapf(err, "failed to create router %q", name)
+		}
+		ctx.GetLogger().Info("created router", "router", name)
+		return status, false, nil
+	}
+	status.RouterID = r.ID
+
+	if subnet.RouterID != r.ID {
+		api := basetypv1.ICSApi{Api: fmt.Sprintf("/routers/%s/interfaces", r.ID), Token: true}
+		resp, err := ctx.GetSession().Client.PutTrip(ctx, api, routerInterface{SubnetID: subnet.ID})
+		if _, err := methods.HandleResponse(resp, err); err != nil {
+			return status, false, errors.Wrapf(err, "failed to attach subnet %s to router %s", subnet.ID, r.ID)
+		}
+		ctx.GetLogger().Info("attached subnet to router", "subnet-id", subnet.ID, "router-id", r.ID)
+		return status, false, nil
+	}
+	return status, true, nil
+}
+
+// DeleteNetwork detaches the subnet from the router and deletes the router,
+// the subnet and the SDN network with the name, one at a time. It returns
+// true once none of them is left.
+func DeleteNetwork(ctx sdnContext, name string) (bool, error) {
+	network, err := findNetwork(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	var subnet *basetypv1.SdnSubnet
+	if network != nil {
+		subnet = findSubnet(network, name)
+	}
+	r, err := findRouter(ctx, name)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case r != nil && subnet != nil && subnet.RouterID == r.ID:
+		api := basetypv1.ICSApi{Api: fmt.Sprintf("/routers/%s/interfaces", r.ID), Token: true}
+		resp, err := ctx.GetSession().Client.DeleteTrip(ctx, api, routerInterface{SubnetID: subnet.ID})
+		if _, err := methods.HandleResponse(resp, err); err != nil {
+			return false, errors.Wrapf(err, "failed to detach subnet %s from router %s", subnet.ID, r.ID)
+		}
+		ctx.GetLogger().Info("detached subnet from router", "subnet-id", subnet.ID, "router-id", r.ID)
+	case r != nil:
+		if err := remove(ctx, fmt.Sprintf("/routers/%s", r.ID)); err != nil {
+			return false, errors.Wrapf(err, "failed to delete router %s", r.ID)
+		}
+		ctx.GetLogger().Info("deleted router", "router-id", r.ID)
+	case subnet != nil:
+		if err := remove(ctx, fmt.Sprintf("/networks/%s/subnets/%s?type=extension", network.ID, subnet.ID)); err != nil {
+			return false, errors.Wrapf(err, "failed to delete subnet %s of sdn network %s", subnet.ID, network.ID)
+		}
+		ctx.GetLogger().Info("deleted sdn subnet", "network-id", network.ID, "subnet-id", subnet.ID)
+	case network != nil:
+		if err := remove(ctx, fmt.Sprintf("/networks/%s?type=extension", network.ID)); err != nil {
+			return false, errors.Wrapf(err, "failed to delete sdn network %s", network.ID)
+		}
+		ctx.GetLogger().Info("deleted sdn network", "network-id", network.ID)
+	default:
+		return true, nil
+	}
+	return false, nil
+}
+
+func findNetwork(ctx sdnContext, name string) (*basetypv1.SdnNetwork, error) {
+	networks, err := basenetv1.NewNetworkService(ctx.GetSession().Client).GetSdnNetworkList(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sdn networks")
+	}
+	for i := range networks {
+		if networks[i].Name == name {
+			return &networks[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func findSubnet(network *basetypv1.SdnNetwork, name string) *basetypv1.SdnSubnet {
+	for i := range network.SubnetKeys {
+		if network.SubnetKeys[i].Name == name {
+			return &network.SubnetKeys[i]
+		}
+	}
+	return nil
+}
+
+func findRouter(ctx sdnContext, name string) (*router, error) {
+	api := basetypv1.ICSApi{Api: "/routers", Token: true}
+	resp, err := ctx.GetSession().Client.GetTrip(ctx, api, nil)
+	body, err := methods.HandleResponse(resp, err)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list routers")
+	}
+	routers := routerPageResponse{}
+	if err := json.Unmarshal(body, &routers); err != nil {
+		return nil, errors.Wrap(err, "failed to decode routers")
+	}
+	for i := range routers.Items {
+		if routers.Items[i].Name == name {
+			return &routers.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func post(ctx sdnContext, path string, request interface{}) error {
+	api := basetypv1.ICSApi{Api: path, Token: true}
+	resp, err := ctx.GetSession().Client.PostTrip(ctx, api, request)
+	_, err = methods.HandleResponse(resp, err)
+	return err
+}
+
+func remove(ctx sdnContext, path string) error {
+	api := basetypv1.ICSApi{Api: path, Token: true}
+	resp, err := ctx.GetSession().Client.DeleteTrip(ctx, api, nil)
+	_, err = methods.HandleResponse(resp, err)
+	return err
+}
+
+// firstAddress returns the first host address of the CIDR.
+func firstAddress(cidr string) (string, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid cidr %q", cidr)
+	}
+	ip := append(net.IP{}, ipNet.IP...)
+	for i := len(ip) - 1; i >= 0; i-- {
+		ip[i]++
+		if ip[i] != 0 {
+			break
+		}
+	}
+	return ip.String(), nil
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdn
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	basegov1 "github.com/ics-sigs/ics-go-sdk"
+	basecltv1 "github.com/ics-sigs/ics-go-sdk/client"
+	"github.com/ics-sigs/ics-go-sdk/client/restful"
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
+)
+
+// fakeSDN answers the GET requests of iCenter with the responses it holds and
+// every other request with an empty body. It records the other requests in
+// the order they are received.
+type fakeSDN struct {
+	mu        sync.Mutex
+	responses map[string]interface{}
+	requests  []string
+	bodies    []string
+}
+
+func (f *fakeSDN) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	request := r.Method + " " + r.URL.RequestURI()
+	if r.Method == http.MethodGet {
+		response, ok := f.responses[request]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(response)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	f.requests = append(f.requests, request)
+	f.bodies = append(f.bodies, string(body))
+	_, _ = w.Write([]byte("{}"))
+}
+
+// testContext is the context the SDN helpers are called with in the tests.
+type testContext struct {
+	context.Context
+	session *session.Session
+}
+
+func (c *testContext) GetLogger() logr.Logger {
+	return log.Log
+}
+
+func (c *testContext) GetSession() *session.Session {
+	return c.session
+}
+
+// newTestContext returns a context whose session talks to the fake SDN.
+func newTestContext(t *testing.T, sdn *fakeSDN) *testContext {
+	t.Helper()
+	server := httptest.NewServer(sdn)
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restClient := restful.NewClient(u, true)
+	return &testContext{
+		Context: context.Background(),
+		session: &session.Session{ICSConnection: &basegov1.ICSConnection{
+			Client: &basecltv1.Client{Client: restClient, RestAPITripper: restClient},
+		}},
+	}
+}
+
+func items(values interface{}) map[string]interface{} {
+	return map[string]interface{}{"items": values}
+}
+
+func TestFirstAddress(t *testing.T) {
+	testCases := []struct {
+		cidr      string
+		expected  string
+		expectErr bool
+	}{
+		{cidr: "10.6.0.0/24", expected: "10.6.0.1"},
+		{cidr: "10.6.0.128/25", expected: "10.6.0.129"},
+		{cidr: "10.6.0.7/24", expected: "10.6.0.1"},
+		{cidr: "10.6.255.255/32", expected: "10.7.0.0"},
+		{cidr: "fd00::/64", expected: "fd00::1"},
+		{cidr: "10.6.0.0", expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.cidr, func(t *testing.T) {
+			actual, err := firstAddress(tc.cidr)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != tc.expected {
+				t.Errorf("got %q, want %q", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReconcileNetwork(t *testing.T) {
+	const name = "capics-default-test"
+	spec := &infrav1.ClusterNetworkSpec{CIDR: "10.6.0.0/24", DNSServers: []string{"10.0.0.2"}, ExternalNetworkID: "ext-1"}
+	network := basetypv1.SdnNetwork{ID: "net-1", Name: name}
+	subnet := basetypv1.SdnSubnet{ID: "subnet-1", Name: name}
+	attached := subnet
+	attached.RouterID = "router-1"
+	withSubnet := network
+	withSubnet.SubnetKeys = []basetypv1.SdnSubnet{subnet}
+	withAttached := network
+	withAttached.SubnetKeys = []basetypv1.SdnSubnet{attached}
+	routers := items([]router{{ID: "router-1", Name: name}})
+
+	testCases := []struct {
+		name      string
+		responses map[string]interface{}
+		request   string
+		body      interface{}
+		expected  infrav1.ClusterNetworkStatus
+		ready     bool
+	}{
+		{
+			name:      "creates the network",
+			responses: map[string]interface{}{"GET /networks?type=extension": items([]basetypv1.SdnNetwork{{ID: "other", Name: "other"}})},
+			request:   "POST /networks?type=extension",
+			body:      networkRequest{Name: name},
+		},
+		{
+			name:      "creates the subnet with the first address as gateway",
+			responses: map[string]interface{}{"GET /networks?type=extension": items([]basetypv1.SdnNetwork{network})},
+			request:   "POST /networks/net-1/subnets?type=extension",
+			body:      subnetRequest{Name: name, Cidr: "10.6.0.0/24", Gateway: "10.6.0.1", EnableDHCP: true, DNSNameservers: []string{"10.0.0.2"}},
+			expected:  infrav1.ClusterNetworkStatus{NetworkID: "net-1", NetworkName: name},
+		},
+		{
+			name: "creates the router",
+			responses: map[string]interface{}{
+				"GET /networks?type=extension": items([]basetypv1.SdnNetwork{withSubnet}),
+				"GET /routers":                 items([]router{}),
+			},
+			request:  "POST /routers",
+			body:     router{Name: name, ExternalNetworkID: "ext-1"},
+			expected: infrav1.ClusterNetworkStatus{NetworkID: "net-1", NetworkName: name, SubnetID: "subnet-1", SubnetName: name},
+		},
+		{
+			name: "attaches the subnet to the router",
+			responses: map[string]interface{}{
+				"GET /networks?type=extension": items([]basetypv1.SdnNetwork{withSubnet}),
+				"GET /routers":                 routers,
+			},
+			request:  "PUT /routers/router-1/interfaces",
+			body:     routerInterface{SubnetID: "subnet-1"},
+			expected: infrav1.ClusterNetworkStatus{NetworkID: "net-1", NetworkName: name, SubnetID: "subnet-1", SubnetName: name, RouterID: "router-1"},
+		},
+		{
+			name: "adopts the existing resources",
+			responses: map[string]interface{}{
+				"GET /networks?type=extension": items([]basetypv1.SdnNetwork{withAttached}),
+				"GET /routers":                 routers,
+			},
+			expected: infrav1.ClusterNetworkStatus{NetworkID: "net-1", NetworkName: name, SubnetID: "subnet-1", SubnetName: name, RouterID: "router-1"},
+			ready:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sdn := &fakeSDN{responses: tc.responses}
+			status, ready, err := ReconcileNetwork(newTestContext(t, sdn), name, spec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ready != tc.ready {
+				t.Errorf("got ready %t, want %t", ready, tc.ready)
+			}
+			if *status != tc.expected {
+				t.Errorf("got status %+v, want %+v", *status, tc.expected)
+			}
+
+			// At most one resource is created per call.
+			if tc.request == "" {
+				if len(sdn.requests) != 0 {
+					t.Errorf("got requests %v, want none", sdn.requests)
+				}
+				return
+			}
+			if !reflect.DeepEqual(sdn.requests, []string{tc.request}) {
+				t.Fatalf("got requests %v, want %s", sdn.requests, tc.request)
+			}
+			expectedBody, _ := json.Marshal(tc.body)
+			if sdn.bodies[0] != string(expectedBody) {
+				t.Errorf("got body %s, want %s", sdn.bodies[0], expectedBody)
+			}
+		})
+	}
+}
+
+func TestDeleteNetwork(t *testing.T) {
+	const name = "capics-default-test"
+	subnet := basetypv1.SdnSubnet{ID: "subnet-1", Name: name}
+	attached := subnet
+	attached.RouterID = "router-1"
+	network := basetypv1.SdnNetwork{ID: "net-1", Name: name}
+	withSubnet := network
+	withSubnet.SubnetKeys = []basetypv1.SdnSubnet{subnet}
+	withAttached := network
+	withAttached.SubnetKeys = []basetypv1.SdnSubnet{attached}
+	routers := items([]router{{ID: "router-1", Name: name}})
+	noRouters := items([]router{})
+
+	// Each step deletes one resource, the next step sees the SDN without it.
+	steps := []struct {
+		name     string
+		networks []basetypv1.SdnNetwork
+		routers  interface{}
+		request  string
+		deleted  bool
+	}{
+		{
+			name:     "detaches the subnet from the router",
+			networks: []basetypv1.SdnNetwork{withAttached},
+			routers:  routers,
+			request:  "DELETE /routers/router-1/interfaces",
+		},
+		{
+			name:     "deletes the router",
+			networks: []basetypv1.SdnNetwork{withSubnet},
+			routers:  routers,
+			request:  "DELETE /routers/router-1",
+		},
+		{
+			name:     "deletes the subnet",
+			networks: []basetypv1.SdnNetwork{withSubnet},
+			routers:  noRouters,
+			request:  "DELETE /networks/net-1/subnets/subnet-1?type=extension",
+		},
+		{
+			name:     "deletes the network",
+			networks: []basetypv1.SdnNetwork{network},
+			routers:  noRouters,
+			request:  "DELETE /networks/net-1?type=extension",
+		},
+		{
+			name:    "nothing left",
+			routers: noRouters,
+			deleted: true,
+		},
+	}
+
+	for _, step := range steps {
+		step := step
+		t.Run(step.name, func(t *testing.T) {
+			sdn := &fakeSDN{responses: map[string]interface{}{
+				"GET /networks?type=extension": items(step.networks),
+				"GET /routers":                 step.routers,
+			}}
+			deleted, err := DeleteNetwork(newTestContext(t, sdn), name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if deleted != step.deleted {
+				t.Errorf("got deleted %t, want %t", deleted, step.deleted)
+			}
+			var expected []string
+			if step.request != "" {
+				expected = []string{step.request}
+			}
+			if !reflect.DeepEqual(sdn.requests, expected) {
+				t.Errorf("got requests %v, want %v", sdn.requests, expected)
+			}
+		})
+	}
+}
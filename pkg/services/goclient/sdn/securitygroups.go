@@ -0,0 +1,147 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdn
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/ics-sigs/ics-go-sdk/client/methods"
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+)
+
+// SecurityGroup is a security group of the SDN.
+type SecurityGroup struct {
+	ID          string              `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Rules       []SecurityGroupRule `json:"rules,omitempty"`
+}
+
+// SecurityGroupRule is a rule of a security group. An empty protocol matches
+// all protocols, and a rule without a remote IP prefix or group matches all
+// addresses.
+type SecurityGroupRule struct {
+	ID             string `json:"id,omitempty"`
+	Description    string `json:"description,omitempty"`
+	Direction      string `json:"direction"`
+	Protocol       string `json:"protocol,omitempty"`
+	PortRangeMin   int32  `json:"portRangeMin,omitempty"`
+	PortRangeMax   int32  `json:"portRangeMax,omitempty"`
+	RemoteIPPrefix string `json:"remoteIpPrefix,omitempty"`
+	RemoteGroupID  string `json:"remoteGroupId,omitempty"`
+}
+
+// key identifies the traffic matched by the rule.
+func (r SecurityGroupRule) key() string {
+	return fmt.Sprintf("%s/%s/%d-%d/%s/%s", r.Direction, r.Protocol, r.PortRangeMin, r.PortRangeMax, r.RemoteIPPrefix, r.RemoteGroupID)
+}
+
+type securityGroupPageResponse struct {
+	Items []SecurityGroup `json:"items"`
+}
+
+// EnsureSecurityGroup returns the security group with the name, creating it
+// if it does not exist. It returns nil while a created group is not listed
+// yet.
+func EnsureSecurityGroup(ctx sdnContext, name string) (*SecurityGroup, error) {
+	group, err := findSecurityGroup(ctx, name)
+	if err != nil || group != nil {
+		return group, err
+	}
+	request := SecurityGroup{Name: name, Description: "Managed by cluster-api-provider-ics"}
+	if err := post(ctx, "/security-groups", request); err != nil {
+		return nil, errors.Wrapf(err, "failed to create security group %q", name)
+	}
+	ctx.GetLogger().Info("created security group", "security-group", name)
+	return nil, nil
+}
+
+// ReconcileSecurityGroupRules makes the rules of the security group match
+// the rules. Rules of the group that are not in the list are deleted and
+// missing rules are created. It returns true if the group was changed.
+func ReconcileSecurityGroupRules(ctx sdnContext, group *SecurityGroup, rules []SecurityGroupRule) (bool, error) {
+	wanted := map[string]bool{}
+	for _, rule := range rules {
+		wanted[rule.key()] = true
+	}
+
+	changed := false
+	existing := map[string]bool{}
+	for _, rule := range group.Rules {
+		if wanted[rule.key()] && !existing[rule.key()] {
+			existing[rule.key()] = true
+			continue
+		}
+		if err := remove(ctx, fmt.Sprintf("/security-groups/%s/rules/%s", group.ID, rule.ID)); err != nil {
+			return changed, errors.Wrapf(err, "failed to delete rule %s of security group %s", rule.ID, group.ID)
+		}
+		ctx.GetLogger().Info("deleted security group rule", "security-group-id", group.ID, "rule", rule.key())
+		changed = true
+	}
+	for _, rule := range rules {
+		if existing[rule.key()] {
+			continue
+		}
+		rule.ID = ""
+		if err := post(ctx, fmt.Sprintf("/security-groups/%s/rules", group.ID), rule); err != nil {
+			return changed, errors.Wrapf(err, "failed to create rule %s of security group %s", rule.key(), group.ID)
+		}
+		existing[rule.key()] = true
+		ctx.GetLogger().Info("created security group rule", "security-group-id", group.ID, "rule", rule.key())
+		changed = true
+	}
+	return changed, nil
+}
+
+// DeleteSecurityGroup deletes the security group with the name. It returns
+// true once the group is gone.
+func DeleteSecurityGroup(ctx sdnContext, name string) (bool, error) {
+	group, err := findSecurityGroup(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if group == nil {
+		return true, nil
+	}
+	if err := remove(ctx, fmt.Sprintf("/security-groups/%s", group.ID)); err != nil {
+		return false, errors.Wrapf(err, "failed to delete security group %s", group.ID)
+	}
+	ctx.GetLogger().Info("deleted security group", "security-group-id", group.ID)
+	return false, nil
+}
+
+func findSecurityGroup(ctx sdnContext, name string) (*SecurityGroup, error) {
+	api := basetypv1.ICSApi{Api: "/security-groups", Token: true}
+	resp, err := ctx.GetSession().Client.GetTrip(ctx, api, nil)
+	body, err := methods.HandleResponse(resp, err)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list security groups")
+	}
+	groups := securityGroupPageResponse{}
+	if err := json.Unmarshal(body, &groups); err != nil {
+		return nil, errors.Wrap(err, "failed to decode security groups")
+	}
+	for i := range groups.Items {
+		if groups.Items[i].Name == name {
+			return &groups.Items[i], nil
+		}
+	}
+	return nil, nil
+}
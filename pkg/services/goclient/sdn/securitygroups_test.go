@@ -0,0 +1,145 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdn
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestReconcileSecurityGroupRules(t *testing.T) {
+	egress := SecurityGroupRule{Direction: "egress"}
+	ssh := SecurityGroupRule{Direction: "ingress", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22}
+	kubelet := SecurityGroupRule{Direction: "ingress", Protocol: "tcp", PortRangeMin: 10250, PortRangeMax: 10250, RemoteGroupID: "cp"}
+	withID := func(rule SecurityGroupRule, id string) SecurityGroupRule {
+		rule.ID = id
+		return rule
+	}
+	// The description is not part of the traffic matched by a rule.
+	described := withID(ssh, "rule-4")
+	described.Description = "SSH"
+
+	testCases := []struct {
+		name     string
+		existing []SecurityGroupRule
+		rules    []SecurityGroupRule
+		requests []string
+		created  []SecurityGroupRule
+	}{
+		{
+			name:     "adds the missing rules",
+			existing: []SecurityGroupRule{withID(egress, "rule-1")},
+			rules:    []SecurityGroupRule{egress, ssh, kubelet},
+			requests: []string{"POST /security-groups/sg-1/rules", "POST /security-groups/sg-1/rules"},
+			created:  []SecurityGroupRule{ssh, kubelet},
+		},
+		{
+			name:     "removes the unwanted rules",
+			existing: []SecurityGroupRule{withID(egress, "rule-1"), withID(ssh, "rule-2"), withID(kubelet, "rule-3")},
+			rules:    []SecurityGroupRule{egress, kubelet},
+			requests: []string{"DELETE /security-groups/sg-1/rules/rule-2"},
+		},
+		{
+			name:     "removes the duplicated rules",
+			existing: []SecurityGroupRule{withID(ssh, "rule-2"), described},
+			rules:    []SecurityGroupRule{ssh},
+			requests: []string{"DELETE /security-groups/sg-1/rules/rule-4"},
+		},
+		{
+			name:     "adds and removes rules",
+			existing: []SecurityGroupRule{withID(egress, "rule-1"), withID(ssh, "rule-2")},
+			rules:    []SecurityGroupRule{egress, kubelet},
+			requests: []string{"DELETE /security-groups/sg-1/rules/rule-2", "POST /security-groups/sg-1/rules"},
+			created:  []SecurityGroupRule{kubelet},
+		},
+		{
+			name:     "keeps the rules unchanged",
+			existing: []SecurityGroupRule{withID(egress, "rule-1"), described},
+			rules:    []SecurityGroupRule{egress, ssh},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sdn := &fakeSDN{}
+			group := &SecurityGroup{ID: "sg-1", Name: "test", Rules: tc.existing}
+			changed, err := ReconcileSecurityGroupRules(newTestContext(t, sdn), group, tc.rules)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if changed != (len(tc.requests) > 0) {
+				t.Errorf("got changed %t with requests %v", changed, sdn.requests)
+			}
+			if !reflect.DeepEqual(sdn.requests, tc.requests) {
+				t.Fatalf("got requests %v, want %v", sdn.requests, tc.requests)
+			}
+			var created []SecurityGroupRule
+			for i, request := range sdn.requests {
+				if request != "POST /security-groups/sg-1/rules" {
+					continue
+				}
+				rule := SecurityGroupRule{}
+				if err := json.Unmarshal([]byte(sdn.bodies[i]), &rule); err != nil {
+					t.Fatal(err)
+				}
+				created = append(created, rule)
+			}
+			if !reflect.DeepEqual(created, tc.created) {
+				t.Errorf("got created rules %+v, want %+v", created, tc.created)
+			}
+		})
+	}
+}
+
+func TestEnsureSecurityGroup(t *testing.T) {
+	testCases := []struct {
+		name     string
+		groups   []SecurityGroup
+		expected *SecurityGroup
+		requests []string
+	}{
+		{
+			name:     "creates the missing group",
+			groups:   []SecurityGroup{{ID: "sg-2", Name: "other"}},
+			requests: []string{"POST /security-groups"},
+		},
+		{
+			name:     "returns the existing group",
+			groups:   []SecurityGroup{{ID: "sg-1", Name: "test"}},
+			expected: &SecurityGroup{ID: "sg-1", Name: "test"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sdn := &fakeSDN{responses: map[string]interface{}{"GET /security-groups": items(tc.groups)}}
+			group, err := EnsureSecurityGroup(newTestContext(t, sdn), "test")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(group, tc.expected) {
+				t.Errorf("got group %+v, want %+v", group, tc.expected)
+			}
+			if !reflect.DeepEqual(sdn.requests, tc.requests) {
+				t.Errorf("got requests %v, want %v", sdn.requests, tc.requests)
+			}
+		})
+	}
+}
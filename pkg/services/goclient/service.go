@@ -24,6 +24,8 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 
 	basehstv1 "github.com/ics-sigs/ics-go-sdk/host"
 	basetkv1 "github.com/ics-sigs/ics-go-sdk/task"
@@ -33,6 +35,7 @@ import (
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
 	basev1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/icenter"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/net"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/tags"
 	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
 )
 
@@ -63,11 +66,23 @@ func (vms *VMService) ReconcileVM(ctx *context.VMContext) (vm infrav1.VirtualMac
 	// event is triggered.
 	defer reconcileICSVMOnTaskCompletion(ctx)
 
+	// A pre-existing VM that is being adopted must never be replaced by a
+	// clone, so remember the request before looking the VM up.
+	adopt := isAdoptionRequested(ctx)
+	if adopt {
+		prepareAdoption(ctx)
+	}
+
 	// Before going further, we need the VM's managed object reference.
 	vmRef, err := findVM(ctx)
 	if err != nil {
 		ctx.Logger.Error(err, "fail to get vm object reference")
 
+		if adopt {
+			conditions.MarkFalse(ctx.ICSVM, infrav1.VMAdoptedCondition, infrav1.AdoptionTargetNotFoundReason, clusterv1.ConditionSeverityError, err.Error())
+			return vm, errors.Wrapf(err, "failed to find vm to adopt for %s", ctx)
+		}
+
 		// Get the bootstrap data.
 		metadata, err := vms.getBootstrapData(ctx)
 		if err != nil {
@@ -93,16 +108,43 @@ func (vms *VMService) ReconcileVM(ctx *context.VMContext) (vm infrav1.VirtualMac
 		State:     &vm,
 	}
 
+	if adopt && !conditions.IsTrue(ctx.ICSVM, infrav1.VMAdoptedCondition) {
+		if err := vms.reconcileAdoption(vmCtx); err != nil {
+			return vm, err
+		}
+	}
+
 	vms.reconcileUUID(vmCtx)
 
+	if ok, err := vms.reconcileOwnership(vmCtx); err != nil || !ok {
+		return vm, err
+	}
+
+	if err := vms.reconcileTags(vmCtx); err != nil {
+		conditions.MarkFalse(ctx.ICSVM, infrav1.VMProvisionedCondition, infrav1.TagsAttachmentFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return vm, err
+	}
+
 	if err := vms.reconcileNetworkStatus(vmCtx); err != nil {
 		return vm, err
 	}
 
+	if ok, err := vms.reconcileResize(vmCtx); err != nil || !ok {
+		return vm, err
+	}
+
+	if ok, err := vms.reconcileDisks(vmCtx); err != nil || !ok {
+		return vm, err
+	}
+
 	if ok, err := vms.reconcilePowerState(vmCtx); err != nil || !ok {
 		return vm, err
 	}
 
+	if ok, err := vms.reconcileDrift(vmCtx); err != nil || !ok {
+		return vm, err
+	}
+
 	vm.State = infrav1.VirtualMachineStateReady
 	return vm, nil
 }
@@ -174,6 +216,24 @@ func (vms *VMService) DestroyVM(ctx *context.VMContext) (infrav1.VirtualMachine,
 		return vm, nil
 	}
 
+	switch ctx.ICSVM.Spec.DeletionPolicy {
+	case infrav1.DeletionPolicyRetainPoweredOff:
+		// The VM is kept powered off for later analysis, which is the desired
+		// state for this deletion policy. It is marked as retained first so
+		// that the orphan garbage collector leaves it alone.
+		if retained, err := vms.markRetained(vmCtx); err != nil || !retained {
+			return vm, err
+		}
+		ctx.Logger.Info("retaining powered off vm", "vm-id", vmRef.Value)
+		ctx.Recorder.Eventf(ctx.ICSVM, "RetainedVM", "VM %s was powered off and retained", vmRef.Value)
+		vm.State = infrav1.VirtualMachineStateNotFound
+		return vm, nil
+	case infrav1.DeletionPolicyRetainDataDisks:
+		if detached, err := vms.detachDataDisks(vmCtx); err != nil || detached {
+			return vm, err
+		}
+	}
+
 	// At this point the VM is not powered on and can be destroyed. Store the
 	// destroy task's reference and return a requeue error.
 	task, err := vmCtx.Obj.DeleteVMWithCheckParams(ctx, vmRef.Value, true, true, ctx.Session.Password)
@@ -299,6 +359,38 @@ func (vms *VMService) reconcileCloudInit(ctx *virtualMachineContext) (bool, erro
 	return true, nil
 }
 
+// reconcileOwnership makes sure the description of the VM records the
+// cluster, namespace, ICSVM and role that own the VM. It returns false while
+// the description is being updated.
+func (vms *VMService) reconcileOwnership(ctx *virtualMachineContext) (bool, error) {
+	vmObj, err := ctx.Obj.GetVM(ctx, ctx.Ref.Value)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get vm %s", ctx)
+	}
+
+	owner := infrautilv1.VMOwnerFor(ctx.ICSVM)
+	if current, ok := infrautilv1.ParseVMOwner(vmObj.Description); ok && current == owner {
+		return true, nil
+	}
+
+	vmObj.Description = infrautilv1.SetVMOwnerDescription(vmObj.Description, owner)
+	task, err := ctx.Obj.SetVM(ctx, *vmObj)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to update ownership metadata of vm %s", ctx)
+	}
+	ctx.ICSVM.Status.TaskRef = task.TaskId
+	ctx.Logger.Info("wait for ownership metadata of vm to be updated", "owner", owner)
+	return false, nil
+}
+
+// reconcileTags attaches the tags of the ICSVM spec to the VM.
+func (vms *VMService) reconcileTags(ctx *virtualMachineContext) error {
+	if err := tags.AttachVMTags(&ctx.VMContext, ctx.Ref.Value, ctx.ICSVM.Spec.Tags); err != nil {
+		return errors.Wrapf(err, "failed to attach tags to vm %s", ctx)
+	}
+	return nil
+}
+
 func (vms *VMService) reconcileUUID(ctx *virtualMachineContext) {
 	vm, err := ctx.Obj.GetVM(ctx, ctx.Ref.Value)
 	if err != nil {
@@ -0,0 +1,138 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tags
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	"github.com/ics-sigs/ics-go-sdk/client/methods"
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
+)
+
+// Tag source types of the objects tags are attached to.
+const (
+	vmTagSourceType        = "VM"
+	datastoreTagSourceType = "STORAGE"
+)
+
+type tagsContext interface {
+	context.Context
+	GetLogger() logr.Logger
+	GetSession() *session.Session
+}
+
+// AttachVMTags makes sure all the named tags are attached to the VM. Tags
+// that do not exist yet are created. Tags attached to the VM that are not in
+// the list are left untouched.
+func AttachVMTags(ctx tagsContext, vmID string, tagNames []string) error {
+	if len(tagNames) == 0 {
+		return nil
+	}
+
+	// The tree of bindings lists every tag, flagging the ones already
+	// attached to the VM.
+	tree, err := methods.ListAttachedTags(ctx, ctx.GetSession().Client, vmTagSourceType, vmID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list tags of vm %s", vmID)
+	}
+	existing := map[string]basetypv1.TreeItem{}
+	if len(tree) == 1 {
+		for _, item := range tree[0].Children {
+			existing[item.Text] = item
+		}
+	}
+
+	bound := []basetypv1.Tag{}
+	missing := false
+	for _, item := range existing {
+		if item.Checked {
+			bound = append(bound, basetypv1.Tag{ID: item.ID, Name: item.Text})
+		}
+	}
+	for _, name := range tagNames {
+		item, ok := existing[name]
+		if ok && item.Checked {
+			continue
+		}
+		missing = true
+		if !ok {
+			tag, err := createTag(ctx, name)
+			if err != nil {
+				return err
+			}
+			ctx.GetLogger().Info("created tag", "tag", name, "tag-id", tag.ID)
+			item = basetypv1.TreeItem{ID: tag.ID, Text: name}
+		}
+		bound = append(bound, basetypv1.Tag{ID: item.ID, Name: name})
+	}
+	if !missing {
+		return nil
+	}
+
+	binding := basetypv1.TagBinding{
+		Tags:          bound,
+		SourceIds:     []string{vmID},
+		TagSourceType: vmTagSourceType,
+	}
+	api := basetypv1.ICSApi{Api: "/tags/bindings", Token: true}
+	resp, err := ctx.GetSession().Client.PostTrip(ctx, api, binding)
+	if _, err := methods.HandleResponse(resp, err); err != nil {
+		return errors.Wrapf(err, "failed to attach tags %v to vm %s", tagNames, vmID)
+	}
+	return nil
+}
+
+// DatastoreHasTag returns true if the named tag is attached to the
+// datastore.
+func DatastoreHasTag(ctx tagsContext, datastoreID, tagName string) (bool, error) {
+	tree, err := methods.ListAttachedTags(ctx, ctx.GetSession().Client, datastoreTagSourceType, datastoreID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list tags of datastore %s", datastoreID)
+	}
+	if len(tree) != 1 {
+		return false, nil
+	}
+	for _, item := range tree[0].Children {
+		if item.Text == tagName && item.Checked {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func createTag(ctx tagsContext, name string) (*basetypv1.Tag, error) {
+	api := basetypv1.ICSApi{Api: "/tags", Token: true}
+	resp, err := ctx.GetSession().Client.PostTrip(ctx, api, basetypv1.Tag{Name: name})
+	body, err := methods.HandleResponse(resp, err)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create tag %q", name)
+	}
+	tag := &basetypv1.Tag{}
+	if err := json.Unmarshal(body, tag); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode tag %q", name)
+	}
+	if tag.ID == "" {
+		return nil, errors.Errorf("tag %q was created without an id", name)
+	}
+	return tag, nil
+}
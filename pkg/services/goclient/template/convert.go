@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ics-sigs/ics-go-sdk/client/methods"
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+	basehstv1 "github.com/ics-sigs/ics-go-sdk/host"
+	basestv1 "github.com/ics-sigs/ics-go-sdk/storage"
+	basevmv1 "github.com/ics-sigs/ics-go-sdk/vm"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/image"
+)
+
+// importRateLimit is the rate limit of the import of an OVA image in percent.
+const importRateLimit = 100
+
+// FindVM returns the virtual machine with the name, or nil if there is none.
+func FindVM(ctx tplContext, name string) (*basetypv1.VirtualMachine, error) {
+	vms, err := basevmv1.NewVirtualMachineService(ctx.GetSession().Client).GetVMList(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list virtual machines")
+	}
+	for i := range vms {
+		if vms[i].Name == name {
+			return &vms[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// ImportTemplate imports the OVA image as a powered off virtual machine with
+// the name and without network devices on a connected host of the datastore
+// of the template spec. The returned task imports the virtual machine, it is
+// turned into a template by ConvertToTemplate once the task is finished.
+func ImportTemplate(ctx tplContext, ovaImage *basetypv1.ImageFileInfo, name string, spec *infrav1.ICSVMImageTemplateSpec) (*basetypv1.Task, error) {
+	dataStore, err := basestv1.NewStorageService(ctx.GetSession().Client).GetStorageInfoByName(ctx, spec.Datastore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to find datastore %q", spec.Datastore)
+	}
+	hosts, err := basehstv1.NewHostService(ctx.GetSession().Client).GetHostListByStorageID(ctx, dataStore.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list the hosts of datastore %q", spec.Datastore)
+	}
+	var host *basetypv1.Host
+	for i := range hosts {
+		if hosts[i].ID == "" || hosts[i].Status != "CONNECTED" {
+			continue
+		}
+		if spec.Cluster != "" && hosts[i].ClusterID != spec.Cluster {
+			continue
+		}
+		host = &hosts[i]
+		break
+	}
+	if host == nil {
+		return nil, errors.Errorf("no connected host of datastore %q to import ova image %q on", spec.Datastore, ovaImage.Name)
+	}
+
+	ovaFilePath := ovaImage.Path + "/" + ovaImage.Name
+	ovaConfig, err := image.GetVMForm(ctx, ovaFilePath, host.ID, ovaImage.ServerID)
+	if err != nil {
+		return nil, err
+	}
+	vmForm := *ovaConfig
+	vmForm.UUID = uuid.New().String()
+	vmForm.Name = name
+	vmForm.HostID = host.ID
+	vmForm.HostName = host.HostName
+	vmForm.HostIP = host.Name
+	vmForm.DataStoreID = dataStore.ID
+	// The virtual machines cloned from the template are connected to the
+	// networks of their own spec.
+	vmForm.Nics = nil
+	vmForm.Disks = nil
+	for _, disk := range ovaConfig.Disks {
+		disk.Volume.Format = "RAW"
+		disk.Volume.DataStoreID = dataStore.ID
+		disk.Volume.DataStoreName = dataStore.Name
+		disk.Volume.DataStoreType = dataStore.DataStoreType
+		vmForm.Disks = append(vmForm.Disks, disk)
+	}
+
+	task, err := basevmv1.NewVirtualMachineService(ctx.GetSession().Client).ImportVM(ctx, vmForm, ovaFilePath, host.ID, importRateLimit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to import ova image %q as %q", ovaImage.Name, name)
+	}
+	ctx.GetLogger().Info("importing ova image to convert it into a template", "image", ovaImage.Name, "template", name, "host-id", host.ID, "task-id", task.TaskId)
+	return task, nil
+}
+
+// ConvertToTemplate converts the powered off virtual machine into a
+// template.
+func ConvertToTemplate(ctx tplContext, vm *basetypv1.VirtualMachine) error {
+	api := basetypv1.ICSApi{Api: fmt.Sprintf("/vms/%s?action=totemplate", vm.ID), Token: true}
+	resp, err := ctx.GetSession().Client.PutTrip(ctx, api, nil)
+	if _, err := methods.HandleResponse(resp, err); err != nil {
+		return errors.Wrapf(err, "unable to convert virtual machine %s into a template", vm.ID)
+	}
+	ctx.GetLogger().Info("converted virtual machine into a template", "vm-id", vm.ID, "template", vm.Name)
+	return nil
+}
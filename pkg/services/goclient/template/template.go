@@ -24,8 +24,11 @@ import (
 	"github.com/pkg/errors"
 
 	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+	basehstv1 "github.com/ics-sigs/ics-go-sdk/host"
 	basevmv1 "github.com/ics-sigs/ics-go-sdk/vm"
 
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/image"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/session"
 )
 
@@ -47,6 +50,58 @@ func FindTemplate(ctx tplContext, templateID string) (*basetypv1.VirtualMachine,
 	return findTemplateByName(ctx, templateID)
 }
 
+// FindSourceVM returns the configuration of the source the virtual machines
+// of the clone spec are created from: the template, or the configuration of
+// the OVA image when the clone mode is ImportVM.
+func FindSourceVM(ctx tplContext, spec *infrav1.VirtualMachineCloneSpec) (*basetypv1.VirtualMachine, error) {
+	if spec.CloneMode != infrav1.ImportVM {
+		tpl, err := FindTemplate(ctx, spec.Template)
+		if err != nil {
+			return nil, err
+		}
+		if tpl == nil {
+			return nil, errors.Errorf("template %q not found", spec.Template)
+		}
+		return tpl, nil
+	}
+
+	ovaImage, err := image.FindOvaImageByName(ctx, spec.Template)
+	if err != nil {
+		return nil, err
+	}
+	if ovaImage == nil {
+		return nil, errors.Errorf("ova image %q not found", spec.Template)
+	}
+
+	// The configuration of an OVA image is read on behalf of a host, any
+	// connected host of the cluster of the virtual machines does.
+	hostService := basehstv1.NewHostService(ctx.GetSession().Client)
+	hosts, err := hostService.GetHostList(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list hosts")
+	}
+	hostID := connectedHost(hosts, spec.Cluster)
+	if hostID == "" {
+		return nil, errors.Errorf("no connected host to read ova image %q on", spec.Template)
+	}
+	return image.GetVMForm(ctx, ovaImage.Path+"/"+ovaImage.Name, hostID, ovaImage.ServerID)
+}
+
+// connectedHost returns the ID of the first connected host of the cluster,
+// or of any cluster when the cluster is empty.
+func connectedHost(hosts []basetypv1.Host, cluster string) string {
+	for _, host := range hosts {
+		if host.ID == "" || host.Status != "CONNECTED" {
+			continue
+		}
+		if cluster != "" && host.ClusterID != cluster {
+			continue
+		}
+		return host.ID
+	}
+	return ""
+}
+
 func findTemplateByInstanceUUID(ctx tplContext, templateID string) (*basetypv1.VirtualMachine, error) {
 	if !isValidUUID(templateID) {
 		return nil, nil
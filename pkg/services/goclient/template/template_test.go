@@ -0,0 +1,68 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"testing"
+
+	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
+)
+
+func TestConnectedHost(t *testing.T) {
+	hosts := []basetypv1.Host{
+		{ID: "host-1", ClusterID: "cluster-1", Status: "DISCONNECTED"},
+		{ID: "", ClusterID: "cluster-1", Status: "CONNECTED"},
+		{ID: "host-2", ClusterID: "cluster-1", Status: "CONNECTED"},
+		{ID: "host-3", ClusterID: "cluster-2", Status: "CONNECTED"},
+	}
+
+	testCases := []struct {
+		name     string
+		hosts    []basetypv1.Host
+		cluster  string
+		expected string
+	}{
+		{
+			name:     "any cluster",
+			hosts:    hosts,
+			expected: "host-2",
+		},
+		{
+			name:     "host of the cluster",
+			hosts:    hosts,
+			cluster:  "cluster-2",
+			expected: "host-3",
+		},
+		{
+			name:    "no connected host in the cluster",
+			hosts:   hosts,
+			cluster: "cluster-3",
+		},
+		{
+			name: "no hosts",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := connectedHost(tc.hosts, tc.cluster); actual != tc.expected {
+				t.Errorf("got host %q, want %q", actual, tc.expected)
+			}
+		})
+	}
+}
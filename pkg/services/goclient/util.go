@@ -23,14 +23,17 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	basetypv1 "github.com/ics-sigs/ics-go-sdk/client/types"
 	basetkv1 "github.com/ics-sigs/ics-go-sdk/task"
 	basevmv1 "github.com/ics-sigs/ics-go-sdk/vm"
 
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
 	"github.com/ics-sigs/cluster-api-provider-ics/pkg/services/goclient/net"
+	infrautilv1 "github.com/ics-sigs/cluster-api-provider-ics/pkg/util"
 )
 
 func sanitizeIPAddrs(ctx *context.VMContext, ipAddrs []string) []string {
@@ -54,6 +57,10 @@ func sanitizeIPAddrs(ctx *context.VMContext, ipAddrs []string) []string {
 //      which was assigned the value of the ICSVM resource's UID string.
 //   3. If it is not found by instance UUID, fallback to an inventory path search
 //      using the vm cluster path and the ICSVM name
+//   4. Finally, if the ICSVM records that it had a VM, look for a VM whose
+//      ownership metadata names the ICSVM, e.g. a VM renamed in iCenter.
+// A VM that is being adopted is only looked up by its ID, see
+// findAdoptionTarget.
 func findVM(ctx *context.VMContext) (basetypv1.ManagedObjectReference, error) {
 	virtualMachineService := basevmv1.NewVirtualMachineService(ctx.Session.Client)
 	if biosUUID := ctx.ICSVM.Spec.BiosUUID; biosUUID != "" {
@@ -73,6 +80,10 @@ func findVM(ctx *context.VMContext) (basetypv1.ManagedObjectReference, error) {
 		return reference, nil
 	}
 
+	if isAdoptionRequested(ctx) {
+		return findAdoptionTarget(ctx, virtualMachineService)
+	}
+
 	objRef := &basetypv1.VirtualMachine{}
 	instanceUUID := ctx.ICSVM.Spec.UID
 	if instanceUUID != "" {
@@ -86,11 +97,11 @@ func findVM(ctx *context.VMContext) (basetypv1.ManagedObjectReference, error) {
 	}
 	if objRef == nil || objRef.ID == "" {
 		vm, err := virtualMachineService.GetVMByName(ctx, ctx.ICSVM.Name)
-		if err != nil {
-			return basetypv1.ManagedObjectReference{}, errNotFound{byInventoryPath: ctx.ICSVM.Name}
-		}
-		if vm == nil || vm.ID == "" {
-			return basetypv1.ManagedObjectReference{}, errNotFound{byInventoryPath: ctx.ICSVM.Name}
+		if err != nil || vm == nil || vm.ID == "" {
+			if !hadVM(ctx) {
+				return basetypv1.ManagedObjectReference{}, errNotFound{byInventoryPath: ctx.ICSVM.Name}
+			}
+			return findVMByOwner(ctx, virtualMachineService)
 		}
 		reference := basetypv1.ManagedObjectReference{
 			Type:  "id",
@@ -107,6 +118,64 @@ func findVM(ctx *context.VMContext) (basetypv1.ManagedObjectReference, error) {
 	return reference, nil
 }
 
+// findAdoptionTarget looks up the VM to adopt by the ID in Spec.UID. There is
+// no fallback to the name or ownership metadata of the ICSVM, an unrelated
+// VM that happens to match them must never be adopted.
+func findAdoptionTarget(ctx *context.VMContext, virtualMachineService *basevmv1.VirtualMachineService) (basetypv1.ManagedObjectReference, error) {
+	id := ctx.ICSVM.Spec.UID
+	if id == "" {
+		return basetypv1.ManagedObjectReference{}, errNotFound{id: id}
+	}
+	vmObj, err := virtualMachineService.GetVM(ctx, id)
+	if err != nil || vmObj == nil || vmObj.ID == "" {
+		if err != nil {
+			ctx.Logger.Error(err, "fail to get vm to adopt", "vm-id", id)
+		}
+		return basetypv1.ManagedObjectReference{}, errNotFound{id: id}
+	}
+	reference := basetypv1.ManagedObjectReference{
+		Type:  "id",
+		Value: vmObj.ID,
+	}
+	ctx.Logger.Info("vm to adopt found by id", "vmref", reference)
+	return reference, nil
+}
+
+// hadVM returns true if the ICSVM records that a VM was provisioned for it.
+// New ICSVMs, and ICSVMs deleted before their VM was created, are not looked
+// for by ownership metadata, as that lists every VM of iCenter.
+func hadVM(ctx *context.VMContext) bool {
+	return ctx.ICSVM.Status.Ready ||
+		conditions.IsTrue(ctx.ICSVM, infrav1.VMProvisionedCondition) ||
+		len(ctx.ICSVM.Status.Addresses) > 0 ||
+		len(ctx.ICSVM.Status.Disks) > 0
+}
+
+// findVMByOwner searches for the VM whose ownership metadata records the
+// UID of the ICSVM.
+func findVMByOwner(ctx *context.VMContext, virtualMachineService *basevmv1.VirtualMachineService) (basetypv1.ManagedObjectReference, error) {
+	notFound := errNotFound{byInventoryPath: ctx.ICSVM.Name}
+	if ctx.ICSVM.UID == "" {
+		return basetypv1.ManagedObjectReference{}, notFound
+	}
+	vms, err := virtualMachineService.GetVMList(ctx)
+	if err != nil {
+		ctx.Logger.Error(err, "fail to list vms")
+		return basetypv1.ManagedObjectReference{}, notFound
+	}
+	for _, vm := range vms {
+		if owner, ok := infrautilv1.ParseVMOwner(vm.Description); ok && owner.UID == string(ctx.ICSVM.UID) {
+			reference := basetypv1.ManagedObjectReference{
+				Type:  "id",
+				Value: vm.ID,
+			}
+			ctx.Logger.Info("vm found by owner", "vmref", reference)
+			return reference, nil
+		}
+	}
+	return basetypv1.ManagedObjectReference{}, notFound
+}
+
 func getTask(ctx *context.VMContext) *basetypv1.TaskInfo {
 	if ctx.ICSVM.Status.TaskRef == "" {
 		return nil
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructure
+
+import (
+	"testing"
+
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+)
+
+func TestHadVM(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(icsVM *infrav1.ICSVM)
+		want   bool
+	}{
+		{
+			name:   "new icsvm",
+			modify: func(icsVM *infrav1.ICSVM) {},
+		},
+		{
+			name: "icsvm waiting for its vm",
+			modify: func(icsVM *infrav1.ICSVM) {
+				conditions.MarkFalse(icsVM, infrav1.VMProvisionedCondition, infrav1.WaitingForStaticIPAllocationReason, "", "")
+			},
+		},
+		{
+			name: "provisioned icsvm",
+			modify: func(icsVM *infrav1.ICSVM) {
+				conditions.MarkTrue(icsVM, infrav1.VMProvisionedCondition)
+			},
+			want: true,
+		},
+		{
+			name: "icsvm with disks",
+			modify: func(icsVM *infrav1.ICSVM) {
+				icsVM.Status.Disks = []infrav1.DiskStatus{{VolumeID: "sys"}}
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			icsVM := &infrav1.ICSVM{}
+			tc.modify(icsVM)
+			if got := hadVM(&context.VMContext{ICSVM: icsVM}); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package haproxy
+
+import (
+	"bytes"
+	"encoding/base64"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+// BootstrapConfig is the configuration written to a load balancer VM when
+// it is created.
+type BootstrapConfig struct {
+	// Port is the port of the frontend.
+	Port int32
+	// DataPlaneAPIPort is the port of the data plane API.
+	DataPlaneAPIPort int32
+	// Username and Password are the credentials of the data plane API. Only
+	// the hash of the password is written to the VM.
+	Username string
+	Password string
+	// Cert and Key are the PEM encoded serving certificate and key of the
+	// data plane API.
+	Cert []byte
+	Key  []byte
+}
+
+// The backend starts without servers. They are added through the data
+// plane API once the addresses of the control plane machines are known.
+var cloudConfigTemplate = template.Must(template.New("cloud-config").Parse(`#cloud-config
+write_files:
+- path: /etc/haproxy/dataplaneapi.crt
+  owner: root:root
+  permissions: "0644"
+  encoding: b64
+  content: {{ .Cert }}
+- path: /etc/haproxy/dataplaneapi.key
+  owner: root:root
+  permissions: "0600"
+  encoding: b64
+  content: {{ .Key }}
+- path: /etc/haproxy/haproxy.cfg
+  owner: root:root
+  permissions: "0640"
+  content: |
+    global
+      log stdout format raw local0 info
+      master-worker
+
+    defaults
+      mode tcp
+      log global
+      option tcplog
+      option dontlognull
+      timeout connect 5s
+      timeout client 1h
+      timeout server 1h
+
+    userlist controller
+      user {{ .Username }} password {{ .PasswordHash }}
+
+    program api
+      command /usr/local/bin/dataplaneapi --scheme https --tls-host 0.0.0.0 --tls-port {{ .DataPlaneAPIPort }} --tls-certificate /etc/haproxy/dataplaneapi.crt --tls-key /etc/haproxy/dataplaneapi.key --haproxy-bin /usr/sbin/haproxy --config-file /etc/haproxy/haproxy.cfg --reload-cmd "systemctl reload haproxy" --reload-delay 5 --userlist controller
+      no option start-on-reload
+
+    frontend {{ .Backend }}
+      bind *:{{ .Port }}
+      default_backend {{ .Backend }}
+
+    backend {{ .Backend }}
+      balance roundrobin
+      option tcp-check
+runcmd:
+- systemctl enable haproxy
+- systemctl restart haproxy
+`))
+
+// CloudConfig returns the cloud-config that configures HAProxy and its data
+// plane API on a load balancer VM. The data plane API is served over TLS with
+// the certificate of the config.
+func CloudConfig(config BootstrapConfig) ([]byte, error) {
+	passwordHash, err := hashPassword(config.Password)
+	if err != nil {
+		return nil, err
+	}
+	data := struct {
+		Port             int32
+		DataPlaneAPIPort int32
+		Username         string
+		PasswordHash     string
+		Cert             string
+		Key              string
+		Backend          string
+	}{
+		Port:             config.Port,
+		DataPlaneAPIPort: config.DataPlaneAPIPort,
+		Username:         config.Username,
+		PasswordHash:     passwordHash,
+		Cert:             base64.StdEncoding.EncodeToString(config.Cert),
+		Key:              base64.StdEncoding.EncodeToString(config.Key),
+		Backend:          BackendName,
+	}
+	buf := &bytes.Buffer{}
+	if err := cloudConfigTemplate.Execute(buf, data); err != nil {
+		return nil, errors.Wrap(err, "failed to render haproxy cloud-config")
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package haproxy
+
+import (
+	"bytes"
+	"encoding/base64"
+	"regexp"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+type cloudConfigFile struct {
+	Path        string `json:"path"`
+	Permissions string `json:"permissions"`
+	Encoding    string `json:"encoding"`
+	Content     string `json:"content"`
+}
+
+type cloudConfigDocument struct {
+	WriteFiles []cloudConfigFile `json:"write_files"`
+	RunCmd     []string          `json:"runcmd"`
+}
+
+func TestCloudConfig(t *testing.T) {
+	certificates := newTestCertificates(t)
+	config := BootstrapConfig{
+		Port:             6443,
+		DataPlaneAPIPort: 5556,
+		Username:         "capics",
+		Password:         "0123456789abcdef",
+		Cert:             certificates.Cert,
+		Key:              certificates.Key,
+	}
+	data, err := CloudConfig(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.HasPrefix(data, []byte("#cloud-config\n")) {
+		t.Errorf("got %q, want a cloud-config", data)
+	}
+	document := cloudConfigDocument{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		t.Fatalf("cloud-config is not valid YAML: %v", err)
+	}
+	files := map[string]cloudConfigFile{}
+	for _, file := range document.WriteFiles {
+		files[file.Path] = file
+	}
+
+	for path, want := range map[string]struct {
+		permissions string
+		content     []byte
+	}{
+		"/etc/haproxy/dataplaneapi.crt": {"0644", certificates.Cert},
+		"/etc/haproxy/dataplaneapi.key": {"0600", certificates.Key},
+	} {
+		file, ok := files[path]
+		if !ok {
+			t.Errorf("missing file %s", path)
+			continue
+		}
+		if file.Permissions != want.permissions || file.Encoding != "b64" {
+			t.Errorf("got %s with permissions %s and encoding %q", path, file.Permissions, file.Encoding)
+		}
+		content, err := base64.StdEncoding.DecodeString(file.Content)
+		if err != nil || !bytes.Equal(content, want.content) {
+			t.Errorf("got content %q of %s, want %q", content, path, want.content)
+		}
+	}
+
+	haproxyConfig := files["/etc/haproxy/haproxy.cfg"].Content
+	for _, want := range []string{
+		"frontend kube-apiserver\n  bind *:6443\n  default_backend kube-apiserver\n",
+		"backend kube-apiserver\n",
+		"--scheme https --tls-host 0.0.0.0 --tls-port 5556",
+		"--tls-certificate /etc/haproxy/dataplaneapi.crt --tls-key /etc/haproxy/dataplaneapi.key",
+		"--userlist controller",
+	} {
+		if !strings.Contains(haproxyConfig, want) {
+			t.Errorf("got haproxy.cfg\n%s\nwant it to contain %q", haproxyConfig, want)
+		}
+	}
+	for _, unwanted := range []string{"insecure-password", config.Password, "--host", "--port"} {
+		if strings.Contains(haproxyConfig, unwanted) {
+			t.Errorf("got haproxy.cfg\n%s\nwant it not to contain %q", haproxyConfig, unwanted)
+		}
+	}
+
+	user := regexp.MustCompile(`user capics password (\$6\$([^$]+)\$\S+)\n`).FindStringSubmatch(haproxyConfig)
+	if user == nil {
+		t.Fatalf("got haproxy.cfg\n%s\nwant a user with a SHA-512 crypt password", haproxyConfig)
+	}
+	if expected := sha512Crypt(config.Password, user[2]); user[1] != expected {
+		t.Errorf("got password hash %q, want %q", user[1], expected)
+	}
+
+	if len(document.RunCmd) == 0 || document.RunCmd[len(document.RunCmd)-1] != "systemctl restart haproxy" {
+		t.Errorf("got runcmd %v, want haproxy to be restarted", document.RunCmd)
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package haproxy
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api/util/certs"
+)
+
+// DataPlaneAPIServerName is the name the serving certificate of the data
+// plane API is issued for. The address of the load balancer VM is not known
+// when the certificate is issued, so clients verify this name instead.
+const DataPlaneAPIServerName = "dataplaneapi"
+
+// The load balancer VM is configured once when it is created, so its
+// certificates are not rotated.
+const certificateDuration = 10 * 365 * 24 * time.Hour
+
+// Certificates are the PEM encoded certificates of the data plane API.
+type Certificates struct {
+	// CACert is the certificate of the CA the client pins.
+	CACert []byte
+	// Cert and Key are the serving certificate and key of the data plane
+	// API, issued by the CA.
+	Cert []byte
+	Key  []byte
+}
+
+// NewCertificates generates a CA and a serving certificate of the data plane
+// API issued by it. The key of the CA is discarded, as no other certificate
+// is ever issued by it.
+func NewCertificates() (*Certificates, error) {
+	now := time.Now().UTC()
+	caKey, err := certs.NewPrivateKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate CA key")
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(0),
+		Subject:               pkix.Name{CommonName: "haproxy-dataplaneapi-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certificateDuration),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, caKey.Public(), caKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create CA certificate")
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse CA certificate")
+	}
+
+	key, err := certs.NewPrivateKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate serving key")
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate serial number")
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: DataPlaneAPIServerName},
+		DNSNames:     []string{DataPlaneAPIServerName},
+		NotBefore:    caCert.NotBefore,
+		NotAfter:     caCert.NotAfter,
+		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, key.Public(), caKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create serving certificate")
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse serving certificate")
+	}
+
+	return &Certificates{
+		CACert: certs.EncodeCertPEM(caCert),
+		Cert:   certs.EncodeCertPEM(cert),
+		Key:    certs.EncodePrivateKeyPEM(key),
+	}, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package haproxy
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// cryptAlphabet is the alphabet of the salts and hashes of crypt(3).
+const cryptAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// hashPassword returns the SHA-512 crypt(3) hash of the password with a
+// random salt, as accepted by the password keyword of HAProxy userlists and
+// by the data plane API.
+func hashPassword(password string) (string, error) {
+	salt := make([]byte, 16)
+	for i := range salt {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(cryptAlphabet))))
+		if err != nil {
+			return "", errors.Wrap(err, "failed to generate password salt")
+		}
+		salt[i] = cryptAlphabet[n.Int64()]
+	}
+	return sha512Crypt(password, string(salt)), nil
+}
+
+// sha512Crypt returns the SHA-512 crypt(3) hash of the password with the
+// salt and the default 5000 rounds, as specified by Ulrich Drepper in
+// "Unix crypt using SHA-256 and SHA-512".
+func sha512Crypt(password, salt string) string {
+	if len(salt) > 16 {
+		salt = salt[:16]
+	}
+	key := []byte(password)
+
+	alternate := sha512.New()
+	alternate.Write(key)
+	alternate.Write([]byte(salt))
+	alternate.Write(key)
+	alternateSum := alternate.Sum(nil)
+
+	digest := sha512.New()
+	digest.Write(key)
+	digest.Write([]byte(salt))
+	digest.Write(repeat(alternateSum, len(key)))
+	for n := len(key); n > 0; n >>= 1 {
+		if n&1 != 0 {
+			digest.Write(alternateSum)
+		} else {
+			digest.Write(key)
+		}
+	}
+	sum := digest.Sum(nil)
+
+	p := sha512.New()
+	for range key {
+		p.Write(key)
+	}
+	pBytes := repeat(p.Sum(nil), len(key))
+
+	s := sha512.New()
+	for i := 0; i < 16+int(sum[0]); i++ {
+		s.Write([]byte(salt))
+	}
+	sBytes := repeat(s.Sum(nil), len(salt))
+
+	for i := 0; i < 5000; i++ {
+		round := sha512.New()
+		if i&1 != 0 {
+			round.Write(pBytes)
+		} else {
+			round.Write(sum)
+		}
+		if i%3 != 0 {
+			round.Write(sBytes)
+		}
+		if i%7 != 0 {
+			round.Write(pBytes)
+		}
+		if i&1 != 0 {
+			round.Write(sum)
+		} else {
+			round.Write(pBytes)
+		}
+		sum = round.Sum(nil)
+	}
+
+	hash := &strings.Builder{}
+	hash.WriteString("$6$" + salt + "$")
+	for i := 0; i < 21; i++ {
+		encode24(hash, sum[i], sum[i+21], sum[i+42], 4, i%3)
+	}
+	encode24(hash, 0, 0, sum[63], 2, 0)
+	return hash.String()
+}
+
+// repeat returns the bytes repeated up to the length.
+func repeat(b []byte, length int) []byte {
+	out := make([]byte, 0, length)
+	for len(out) < length {
+		n := length - len(out)
+		if n > len(b) {
+			n = len(b)
+		}
+		out = append(out, b[:n]...)
+	}
+	return out
+}
+
+// encode24 writes n characters of the 24 bits of the bytes, rotated so that
+// the byte at position rotation is the most significant.
+func encode24(hash *strings.Builder, b0, b1, b2 byte, n, rotation int) {
+	bytes := [3]byte{b0, b1, b2}
+	w := uint(bytes[rotation])<<16 | uint(bytes[(rotation+1)%3])<<8 | uint(bytes[(rotation+2)%3])
+	for ; n > 0; n-- {
+		hash.WriteByte(cryptAlphabet[w&0x3f])
+		w >>= 6
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package haproxy
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSHA512Crypt(t *testing.T) {
+	testCases := []struct {
+		password string
+		salt     string
+		expected string
+	}{
+		{
+			password: "Hello world!",
+			salt:     "saltstring",
+			expected: "$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1",
+		},
+		{
+			password: "x",
+			salt:     "abcdefghijklmnopqrstuvwxyz",
+			expected: "$6$abcdefghijklmnop$4MjOne1jVRKP4IuHRPFedC4vlQHNjvcBfQJVlfB8ciQ5YUzW2g81Pyw4sptw4femJ071/eApI0WDsBAOOCmTl0",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.password, func(t *testing.T) {
+			if actual := sha512Crypt(tc.password, tc.salt); actual != tc.expected {
+				t.Errorf("got %q, want %q", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestHashPassword(t *testing.T) {
+	hash, err := hashPassword("secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[1] != "6" || len(parts[2]) != 16 {
+		t.Fatalf("got %q, want a SHA-512 crypt hash with a 16 character salt", hash)
+	}
+	if expected := sha512Crypt("secret", parts[2]); hash != expected {
+		t.Errorf("got %q, want %q", hash, expected)
+	}
+	other, err := hashPassword("secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if other == hash {
+		t.Error("expected hashes with different salts")
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package haproxy configures the HAProxy load balancer VMs of clusters.
+package haproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// BackendName is the name of the HAProxy frontend and backend of the
+// control plane.
+const BackendName = "kube-apiserver"
+
+// Server is a server of an HAProxy backend.
+type Server struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Port    int32  `json:"port"`
+	Check   string `json:"check,omitempty"`
+}
+
+type serverList struct {
+	Data []Server `json:"data"`
+}
+
+type transaction struct {
+	ID string `json:"id"`
+}
+
+// Client is a client of the HAProxy data plane API.
+type Client struct {
+	endpoint   string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// NewClient returns a client of the data plane API listening on the port of
+// the address. The serving certificate of the API must be issued for
+// DataPlaneAPIServerName by the PEM encoded CA certificate, no other CA is
+// trusted.
+func NewClient(address string, port int32, username, password string, caCert []byte) (*Client, error) {
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("failed to parse the CA certificate of the data plane API")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    rootCAs,
+		ServerName: DataPlaneAPIServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	return &Client{
+		endpoint:   "https://" + net.JoinHostPort(address, strconv.Itoa(int(port))) + "/v2/services/haproxy",
+		username:   username,
+		password:   password,
+		httpClient: &http.Client{Timeout: 10 * time.Second, Transport: transport},
+	}, nil
+}
+
+// ReconcileServers makes the servers of the backend match the addresses,
+// each forwarding to the port. The changes are made in a single transaction
+// so that HAProxy is reloaded once. It returns true if the backend was
+// changed.
+func (c *Client) ReconcileServers(ctx context.Context, backend string, addresses []string, port int32) (bool, error) {
+	list := serverList{}
+	if err := c.do(ctx, http.MethodGet, "/configuration/servers", url.Values{"backend": {backend}}, nil, &list); err != nil {
+		return false, errors.Wrapf(err, "failed to list servers of backend %s", backend)
+	}
+
+	wanted := map[string]Server{}
+	for _, address := range addresses {
+		wanted[serverName(address)] = Server{Name: serverName(address), Address: address, Port: port, Check: "enabled"}
+	}
+	var toDelete []string
+	existing := map[string]bool{}
+	for _, server := range list.Data {
+		if want, ok := wanted[server.Name]; ok && want.Address == server.Address && want.Port == server.Port {
+			existing[server.Name] = true
+			continue
+		}
+		toDelete = append(toDelete, server.Name)
+	}
+	var toCreate []Server
+	for name, server := range wanted {
+		if !existing[name] {
+			toCreate = append(toCreate, server)
+		}
+	}
+	if len(toDelete) == 0 && len(toCreate) == 0 {
+		return false, nil
+	}
+	sort.Strings(toDelete)
+	sort.Slice(toCreate, func(i, j int) bool { return toCreate[i].Name < toCreate[j].Name })
+
+	var version int64
+	if err := c.do(ctx, http.MethodGet, "/configuration/version", nil, nil, &version); err != nil {
+		return false, errors.Wrap(err, "failed to get configuration version")
+	}
+	tx := transaction{}
+	if err := c.do(ctx, http.MethodPost, "/transactions", url.Values{"version": {strconv.FormatInt(version, 10)}}, nil, &tx); err != nil {
+		return false, errors.Wrap(err, "failed to start transaction")
+	}
+	query := url.Values{"backend": {backend}, "transaction_id": {tx.ID}}
+	for _, name := range toDelete {
+		if err := c.do(ctx, http.MethodDelete, "/configuration/servers/"+url.PathEscape(name), query, nil, nil); err != nil {
+			c.abort(ctx, tx.ID)
+			return false, errors.Wrapf(err, "failed to delete server %s of backend %s", name, backend)
+		}
+	}
+	for _, server := range toCreate {
+		if err := c.do(ctx, http.MethodPost, "/configuration/servers", query, server, nil); err != nil {
+			c.abort(ctx, tx.ID)
+			return false, errors.Wrapf(err, "failed to create server %s of backend %s", server.Name, backend)
+		}
+	}
+	if err := c.do(ctx, http.MethodPut, "/transactions/"+url.PathEscape(tx.ID), nil, nil, nil); err != nil {
+		return false, errors.Wrapf(err, "failed to commit transaction %s", tx.ID)
+	}
+	return true, nil
+}
+
+// abort deletes a transaction that could not be completed. Errors are
+// ignored as HAProxy discards stale transactions on its own.
+func (c *Client) abort(ctx context.Context, id string) {
+	_ = c.do(ctx, http.MethodDelete, "/transactions/"+url.PathEscape(id), nil, nil, nil)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, in, out interface{}) error {
+	u := c.endpoint + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("%s %s: %s: %s", method, path, resp.Status, bytes.TrimSpace(data))
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// serverName returns the name of the server of the address.
+func serverName(address string) string {
+	return "apiserver-" + strings.NewReplacer(".", "-", ":", "-").Replace(address)
+}
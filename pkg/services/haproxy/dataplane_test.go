@@ -0,0 +1,238 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package haproxy
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeDataPlane is a data plane API holding the servers of a backend. It
+// records the requests that change the configuration.
+type fakeDataPlane struct {
+	mu       sync.Mutex
+	servers  []Server
+	requests []string
+	created  []Server
+}
+
+func (f *fakeDataPlane) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if username, password, ok := r.BasicAuth(); !ok || username != "capics" || password != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	request := r.Method + " " + strings.TrimPrefix(r.URL.RequestURI(), "/v2/services/haproxy")
+	switch {
+	case request == "GET /configuration/servers?backend=kube-apiserver":
+		_ = json.NewEncoder(w).Encode(serverList{Data: f.servers})
+	case request == "GET /configuration/version":
+		_, _ = w.Write([]byte("3"))
+	case request == "POST /transactions?version=3":
+		f.requests = append(f.requests, request)
+		_ = json.NewEncoder(w).Encode(transaction{ID: "tx-1"})
+	case r.Method == http.MethodPost && strings.HasPrefix(request, "POST /configuration/servers?"):
+		server := Server{}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &server); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.requests = append(f.requests, request)
+		f.created = append(f.created, server)
+		w.WriteHeader(http.StatusAccepted)
+	case r.Method == http.MethodDelete || r.Method == http.MethodPut:
+		f.requests = append(f.requests, request)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// newTestClient returns a client of a data plane API served over TLS with a
+// certificate issued by the CA of certificates. The client pins caCert.
+func newTestClient(t *testing.T, handler http.Handler, certificates *Certificates, caCert []byte) *Client {
+	t.Helper()
+	keyPair, err := tls.X509KeyPair(certificates.Cert, certificates.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{keyPair}, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewClient(host, int32(portNumber), "capics", "secret", caCert)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return client
+}
+
+func newTestCertificates(t *testing.T) *Certificates {
+	t.Helper()
+	certificates, err := NewCertificates()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return certificates
+}
+
+func TestReconcileServers(t *testing.T) {
+	server := func(address string, port int32) Server {
+		return Server{Name: serverName(address), Address: address, Port: port, Check: "enabled"}
+	}
+	certificates := newTestCertificates(t)
+
+	testCases := []struct {
+		name      string
+		servers   []Server
+		addresses []string
+		requests  []string
+		created   []Server
+	}{
+		{
+			name:      "adds the servers of new machines",
+			servers:   []Server{server("10.6.0.10", 6443)},
+			addresses: []string{"10.6.0.12", "10.6.0.10", "10.6.0.11"},
+			requests: []string{
+				"POST /transactions?version=3",
+				"POST /configuration/servers?backend=kube-apiserver&transaction_id=tx-1",
+				"POST /configuration/servers?backend=kube-apiserver&transaction_id=tx-1",
+				"PUT /transactions/tx-1",
+			},
+			created: []Server{server("10.6.0.11", 6443), server("10.6.0.12", 6443)},
+		},
+		{
+			name:      "removes the servers of deleted machines",
+			servers:   []Server{server("10.6.0.10", 6443), server("10.6.0.11", 6443)},
+			addresses: []string{"10.6.0.11"},
+			requests: []string{
+				"POST /transactions?version=3",
+				"DELETE /configuration/servers/apiserver-10-6-0-10?backend=kube-apiserver&transaction_id=tx-1",
+				"PUT /transactions/tx-1",
+			},
+		},
+		{
+			name:      "replaces the servers on another port",
+			servers:   []Server{server("10.6.0.10", 8443)},
+			addresses: []string{"10.6.0.10"},
+			requests: []string{
+				"POST /transactions?version=3",
+				"DELETE /configuration/servers/apiserver-10-6-0-10?backend=kube-apiserver&transaction_id=tx-1",
+				"POST /configuration/servers?backend=kube-apiserver&transaction_id=tx-1",
+				"PUT /transactions/tx-1",
+			},
+			created: []Server{server("10.6.0.10", 6443)},
+		},
+		{
+			name:      "keeps the servers unchanged",
+			servers:   []Server{server("10.6.0.10", 6443), server("10.6.0.11", 6443)},
+			addresses: []string{"10.6.0.11", "10.6.0.10"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			dataPlane := &fakeDataPlane{servers: tc.servers}
+			client := newTestClient(t, dataPlane, certificates, certificates.CACert)
+			changed, err := client.ReconcileServers(context.Background(), BackendName, tc.addresses, 6443)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if changed != (len(tc.requests) > 0) {
+				t.Errorf("got changed %t with requests %v", changed, dataPlane.requests)
+			}
+			if !reflect.DeepEqual(dataPlane.requests, tc.requests) {
+				t.Errorf("got requests %v, want %v", dataPlane.requests, tc.requests)
+			}
+			if !reflect.DeepEqual(dataPlane.created, tc.created) {
+				t.Errorf("got created servers %+v, want %+v", dataPlane.created, tc.created)
+			}
+		})
+	}
+}
+
+func TestReconcileServersError(t *testing.T) {
+	certificates := newTestCertificates(t)
+	dataPlane := &fakeDataPlane{}
+	client := newTestClient(t, dataPlane, certificates, certificates.CACert)
+	client.password = "wrong"
+	if _, err := client.ReconcileServers(context.Background(), BackendName, []string{"10.6.0.10"}, 6443); err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(dataPlane.requests) != 0 {
+		t.Errorf("got requests %v, want none", dataPlane.requests)
+	}
+}
+
+func TestClientPinsCA(t *testing.T) {
+	certificates := newTestCertificates(t)
+	other := newTestCertificates(t)
+	dataPlane := &fakeDataPlane{}
+	client := newTestClient(t, dataPlane, certificates, other.CACert)
+	if _, err := client.ReconcileServers(context.Background(), BackendName, []string{"10.6.0.10"}, 6443); err == nil {
+		t.Fatal("expected a certificate issued by another CA to be rejected")
+	}
+	if len(dataPlane.requests) != 0 {
+		t.Errorf("got requests %v, want none", dataPlane.requests)
+	}
+
+	if _, err := NewClient("10.6.0.5", 5556, "capics", "secret", []byte("not a certificate")); err == nil {
+		t.Error("expected an invalid CA certificate to be rejected")
+	}
+}
+
+func TestServerName(t *testing.T) {
+	testCases := []struct {
+		address  string
+		expected string
+	}{
+		{address: "10.6.0.10", expected: "apiserver-10-6-0-10"},
+		{address: "fd00::10", expected: "apiserver-fd00--10"},
+		{address: "fd00:0:0:1::a", expected: "apiserver-fd00-0-0-1--a"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.address, func(t *testing.T) {
+			if actual := serverName(tc.address); actual != tc.expected {
+				t.Errorf("got %q, want %q", actual, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package services
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	clusterutilv1 "sigs.k8s.io/cluster-api/util"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+	"github.com/ics-sigs/cluster-api-provider-ics/pkg/context"
+)
+
+const (
+	defaultKubeVIPImage     = "ghcr.io/kube-vip/kube-vip:v0.5.0"
+	defaultKubeVIPInterface = "eth0"
+	kubeVIPManifestPath     = "/etc/kubernetes/manifests/kube-vip.yaml"
+)
+
+// ensureKubeVIPBootstrapData creates or updates a copy of the bootstrap data
+// of the machine with a kube-vip static pod added to it, and returns the
+// name of the secret holding the copy.
+func ensureKubeVIPBootstrapData(ctx *context.VIMMachineContext) (string, error) {
+	source := &corev1.Secret{}
+	sourceKey := types.NamespacedName{
+		Namespace: ctx.Machine.Namespace,
+		Name:      *ctx.Machine.Spec.Bootstrap.DataSecretName,
+	}
+	if err := ctx.Client.Get(ctx, sourceKey, source); err != nil {
+		return "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for %s", ctx)
+	}
+	if format, ok := source.Data["format"]; ok && string(format) != "cloud-config" {
+		return "", errors.Errorf("cannot add kube-vip to bootstrap data of format %q", format)
+	}
+	manifest, err := kubeVIPManifest(ctx.ICSCluster)
+	if err != nil {
+		return "", err
+	}
+	value, err := addCloudConfigFile(source.Data["value"], kubeVIPManifestPath, manifest)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to add kube-vip to bootstrap data of %s", ctx)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: source.Namespace,
+			Name:      fmt.Sprintf("%s-kube-vip", source.Name),
+		},
+	}
+	mutateFn := func() error {
+		secret.SetOwnerReferences(clusterutilv1.EnsureOwnerRef(
+			secret.OwnerReferences,
+			metav1.OwnerReference{
+				APIVersion: ctx.ICSMachine.APIVersion,
+				Kind:       ctx.ICSMachine.Kind,
+				Name:       ctx.ICSMachine.Name,
+				UID:        ctx.ICSMachine.UID,
+			}))
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[clusterv1.ClusterLabelName] = ctx.Machine.Labels[clusterv1.ClusterLabelName]
+		secret.Type = source.Type
+		secret.Data = map[string][]byte{"value": value}
+		if format, ok := source.Data["format"]; ok {
+			secret.Data["format"] = format
+		}
+		return nil
+	}
+	if _, err := ctrlutil.CreateOrPatch(ctx, ctx.Client, secret, mutateFn); err != nil {
+		return "", errors.Wrapf(err, "failed to create kube-vip bootstrap data secret for %s", ctx)
+	}
+	return secret.Name, nil
+}
+
+// kubeVIPManifest returns the static pod manifest of kube-vip announcing the
+// control plane virtual IP of the cluster with ARP and leader election.
+func kubeVIPManifest(icsCluster *infrav1.ICSCluster) ([]byte, error) {
+	vip := icsCluster.Spec.ControlPlaneVIP
+	endpoint := icsCluster.Spec.ControlPlaneEndpoint
+	if endpoint.Host == "" {
+		return nil, errors.New("control plane virtual IP is not allocated yet")
+	}
+	image := vip.Image
+	if image == "" {
+		image = defaultKubeVIPImage
+	}
+	iface := vip.Interface
+	if iface == "" {
+		iface = defaultKubeVIPInterface
+	}
+	// kube-vip announces the virtual IP as a single host address.
+	cidr := "32"
+	if ip := net.ParseIP(endpoint.Host); ip != nil && ip.To4() == nil {
+		cidr = "128"
+	}
+
+	env := []corev1.EnvVar{
+		{Name: "vip_arp", Value: "true"},
+		{Name: "port", Value: fmt.Sprintf("%d", endpoint.Port)},
+		{Name: "vip_interface", Value: iface},
+		{Name: "vip_cidr", Value: cidr},
+		{Name: "cp_enable", Value: "true"},
+		{Name: "cp_namespace", Value: metav1.NamespaceSystem},
+		{Name: "vip_leaderelection", Value: "true"},
+		{Name: "vip_leaseduration", Value: "15"},
+		{Name: "vip_renewdeadline", Value: "10"},
+		{Name: "vip_retryperiod", Value: "2"},
+		{Name: "address", Value: endpoint.Host},
+	}
+	hostPathType := corev1.HostPathFileOrCreate
+	pod := corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kube-vip",
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:            "kube-vip",
+				Image:           image,
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				Args:            []string{"manager"},
+				Env:             env,
+				SecurityContext: &corev1.SecurityContext{
+					Capabilities: &corev1.Capabilities{
+						Add: []corev1.Capability{"NET_ADMIN", "NET_RAW"},
+					},
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "kubeconfig",
+					MountPath: "/etc/kubernetes/admin.conf",
+				}},
+			}},
+			HostAliases: []corev1.HostAlias{{
+				IP:        "127.0.0.1",
+				Hostnames: []string{"kubernetes"},
+			}},
+			HostNetwork: true,
+			Volumes: []corev1.Volume{{
+				Name: "kubeconfig",
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{
+						Path: "/etc/kubernetes/admin.conf",
+						Type: &hostPathType,
+					},
+				},
+			}},
+		},
+	}
+	return yaml.Marshal(pod)
+}
+
+// addCloudConfigFile adds a file to the write_files of the cloud-config,
+// replacing any file with the same path. The comment lines at the top, such
+// as "## template: jinja" and "#cloud-config", are kept as cloud-init relies
+// on them.
+func addCloudConfigFile(data []byte, path string, content []byte) ([]byte, error) {
+	var header []string
+	body := string(data)
+	for strings.HasPrefix(body, "#") {
+		line, rest := body, ""
+		if i := strings.IndexByte(body, '\n'); i >= 0 {
+			line, rest = body[:i], body[i+1:]
+		}
+		header = append(header, line)
+		body = rest
+	}
+	if !strings.Contains(strings.Join(header, "\n"), "#cloud-config") {
+		return nil, errors.New("bootstrap data is not a cloud-config")
+	}
+
+	config := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(body), &config); err != nil {
+		return nil, errors.Wrap(err, "failed to parse cloud-config")
+	}
+	files := []interface{}{}
+	if existing, ok := config["write_files"].([]interface{}); ok {
+		for _, file := range existing {
+			if f, ok := file.(map[string]interface{}); ok && f["path"] == path {
+				continue
+			}
+			files = append(files, file)
+		}
+	}
+	config["write_files"] = append(files, map[string]interface{}{
+		"path":        path,
+		"owner":       "root:root",
+		"permissions": "0644",
+		"content":     string(content),
+	})
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode cloud-config")
+	}
+	return []byte(strings.Join(header, "\n") + "\n" + string(out)), nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package services
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+func TestKubeVIPManifest(t *testing.T) {
+	testCases := []struct {
+		name      string
+		vip       infrav1.ControlPlaneVIPSpec
+		endpoint  clusterv1.APIEndpoint
+		image     string
+		iface     string
+		cidr      string
+		expectErr bool
+	}{
+		{
+			name:     "defaults",
+			endpoint: clusterv1.APIEndpoint{Host: "10.0.0.10", Port: 6443},
+			image:    defaultKubeVIPImage,
+			iface:    defaultKubeVIPInterface,
+			cidr:     "32",
+		},
+		{
+			name:     "ipv6 virtual IP",
+			endpoint: clusterv1.APIEndpoint{Host: "fd00::10", Port: 6443},
+			image:    defaultKubeVIPImage,
+			iface:    defaultKubeVIPInterface,
+			cidr:     "128",
+		},
+		{
+			name:     "image and interface from the spec",
+			vip:      infrav1.ControlPlaneVIPSpec{Image: "registry.local/kube-vip:v0.6.0", Interface: "ens192"},
+			endpoint: clusterv1.APIEndpoint{Host: "10.0.0.10", Port: 8443},
+			image:    "registry.local/kube-vip:v0.6.0",
+			iface:    "ens192",
+			cidr:     "32",
+		},
+		{
+			name:      "endpoint not allocated",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			icsCluster := &infrav1.ICSCluster{
+				Spec: infrav1.ICSClusterSpec{
+					ControlPlaneVIP:      &tc.vip,
+					ControlPlaneEndpoint: tc.endpoint,
+				},
+			}
+			manifest, err := kubeVIPManifest(icsCluster)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			pod := &corev1.Pod{}
+			if err := yaml.Unmarshal(manifest, pod); err != nil {
+				t.Fatalf("manifest is not a pod: %v", err)
+			}
+			if len(pod.Spec.Containers) != 1 {
+				t.Fatalf("got %d containers, want 1", len(pod.Spec.Containers))
+			}
+			container := pod.Spec.Containers[0]
+			if container.Image != tc.image {
+				t.Errorf("got image %q, want %q", container.Image, tc.image)
+			}
+			env := map[string]string{}
+			for _, v := range container.Env {
+				env[v.Name] = v.Value
+			}
+			want := map[string]string{
+				"address":       tc.endpoint.Host,
+				"port":          fmt.Sprintf("%d", tc.endpoint.Port),
+				"vip_interface": tc.iface,
+				"vip_cidr":      tc.cidr,
+				"vip_arp":       "true",
+				"cp_enable":     "true",
+			}
+			for name, value := range want {
+				if env[name] != value {
+					t.Errorf("got env %s=%q, want %q", name, env[name], value)
+				}
+			}
+			if !pod.Spec.HostNetwork {
+				t.Error("expected kube-vip to run on the host network")
+			}
+		})
+	}
+}
+
+func TestAddCloudConfigFile(t *testing.T) {
+	testCases := []struct {
+		name      string
+		data      string
+		header    string
+		files     []string
+		expectErr bool
+	}{
+		{
+			name:   "adds to the existing files",
+			data:   "#cloud-config\nwrite_files:\n- path: /etc/a\n  content: a\nruncmd:\n- kubeadm init\n",
+			header: "#cloud-config\n",
+			files:  []string{"/etc/a", "/etc/kubernetes/manifests/kube-vip.yaml"},
+		},
+		{
+			name:   "keeps the jinja header",
+			data:   "## template: jinja\n#cloud-config\nruncmd:\n- kubeadm init\n",
+			header: "## template: jinja\n#cloud-config\n",
+			files:  []string{"/etc/kubernetes/manifests/kube-vip.yaml"},
+		},
+		{
+			name:   "replaces a file with the same path",
+			data:   "#cloud-config\nwrite_files:\n- path: /etc/kubernetes/manifests/kube-vip.yaml\n  content: old\n- path: /etc/a\n  content: a\n",
+			header: "#cloud-config\n",
+			files:  []string{"/etc/a", "/etc/kubernetes/manifests/kube-vip.yaml"},
+		},
+		{
+			name:      "not a cloud-config",
+			data:      "#!/bin/bash\nkubeadm init\n",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := addCloudConfigFile([]byte(tc.data), kubeVIPManifestPath, []byte("kind: Pod\n"))
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.HasPrefix(string(out), tc.header) {
+				t.Errorf("got %q, want it to start with %q", out, tc.header)
+			}
+
+			config := struct {
+				WriteFiles []struct {
+					Path    string `json:"path"`
+					Content string `json:"content"`
+				} `json:"write_files"`
+				RunCmd []string `json:"runcmd"`
+			}{}
+			if err := yaml.Unmarshal(out, &config); err != nil {
+				t.Fatalf("output is not valid yaml: %v", err)
+			}
+			if len(config.WriteFiles) != len(tc.files) {
+				t.Fatalf("got %d files, want %v", len(config.WriteFiles), tc.files)
+			}
+			for i, path := range tc.files {
+				if config.WriteFiles[i].Path != path {
+					t.Errorf("got file %d at %q, want %q", i, config.WriteFiles[i].Path, path)
+				}
+			}
+			last := config.WriteFiles[len(config.WriteFiles)-1]
+			if last.Content != "kind: Pod\n" {
+				t.Errorf("got kube-vip content %q, want the manifest", last.Content)
+			}
+			if strings.Contains(tc.data, "runcmd") && len(config.RunCmd) != 1 {
+				t.Errorf("got runcmd %v, want it kept", config.RunCmd)
+			}
+		})
+	}
+}
@@ -331,6 +331,18 @@ func (v *VimMachineService) createOrPatchICSVM(ctx *context.VIMMachineContext, i
 			Name:      ctx.Machine.Name,
 		},
 	}
+
+	// Control plane machines announcing the control plane VIP with kube-vip
+	// boot from a copy of the bootstrap data carrying the kube-vip manifest.
+	bootstrapSecretName := *ctx.Machine.Spec.Bootstrap.DataSecretName
+	if ctx.ICSCluster.Spec.ControlPlaneVIP != nil && infrautilv1.IsControlPlaneMachine(ctx.ICSMachine) {
+		name, err := ensureKubeVIPBootstrapData(ctx)
+		if err != nil {
+			return nil, err
+		}
+		bootstrapSecretName = name
+	}
+
 	mutateFn := func() (err error) {
 		// Ensure the ICSMachine is marked as an owner of the ICSVM.
 		vm.SetOwnerReferences(clusterutilv1.EnsureOwnerRef(
@@ -347,7 +359,7 @@ func (v *VimMachineService) createOrPatchICSVM(ctx *context.VIMMachineContext, i
 		vm.Spec.BootstrapRef = &corev1.ObjectReference{
 			APIVersion: "v1",
 			Kind:       "Secret",
-			Name:       *ctx.Machine.Spec.Bootstrap.DataSecretName,
+			Name:       bootstrapSecretName,
 			Namespace:  ctx.Machine.ObjectMeta.Namespace,
 		}
 
@@ -366,6 +378,15 @@ func (v *VimMachineService) createOrPatchICSVM(ctx *context.VIMMachineContext, i
 			vm.Labels[clusterv1.MachineControlPlaneLabelName] = val
 		}
 
+		// Pass an adoption request on to the ICSVM, which is where the
+		// existing VM is looked up and validated.
+		if id, ok := ctx.ICSMachine.Annotations[infrav1.AdoptVMAnnotation]; ok {
+			if vm.Annotations == nil {
+				vm.Annotations = map[string]string{}
+			}
+			vm.Annotations[infrav1.AdoptVMAnnotation] = id
+		}
+
 		// Copy the ICSMachine's VM clone spec into the ICSVM's
 		// clone spec.
 		ctx.ICSMachine.Spec.VirtualMachineCloneSpec.DeepCopyInto(&vm.Spec.VirtualMachineCloneSpec)
@@ -376,11 +397,14 @@ func (v *VimMachineService) createOrPatchICSVM(ctx *context.VIMMachineContext, i
 		//   1. From the Machine.Spec.FailureDomain
 		//   2. From the ICSMachine.Spec (the DeepCopyInto above)
 		//   3. From the ICSCluster.Spec
-		if vm.Spec.CloudName == "" {
-			vm.Spec.CloudName = ctx.ICSCluster.Spec.CloudName
-		}
+		SetClusterDefaults(&vm.Spec.VirtualMachineCloneSpec, ctx.ICSCluster, infrautilv1.IsControlPlaneMachine(ctx.ICSMachine))
 		if icsVM != nil {
 			vm.Spec.BiosUUID = icsVM.Spec.BiosUUID
+			// Keep the source the ICSVM controller resolved from the image.
+			if vm.Spec.Template == "" && vm.Spec.ImageRef != nil {
+				vm.Spec.Template = icsVM.Spec.Template
+				vm.Spec.CloneMode = icsVM.Spec.CloneMode
+			}
 		}
 		return nil
 	}
@@ -436,3 +460,92 @@ func (v *VimMachineService) createOrPatchICSVM(ctx *context.VIMMachineContext, i
 
 	return vm, nil
 }
+
+// clusterNetworkDevice returns a network device connected to the subnet of
+// the cluster network.
+func clusterNetworkDevice(network *infrav1.ClusterNetworkStatus) infrav1.NetworkDeviceSpec {
+	return infrav1.NetworkDeviceSpec{
+		SwitchType:  infrav1.ExtSDNSwitch,
+		NetworkID:   network.NetworkID,
+		NetworkName: network.NetworkName,
+		DeviceID:    network.SubnetID,
+		DeviceName:  network.SubnetName,
+		DHCP4:       true,
+	}
+}
+
+// SetMachineDefaults sets the properties of the clone spec of a machine that
+// are left empty to the ones of the spec of the ICSCluster: the cloud, the
+// identity and the machine defaults. The default template is not used by
+// machines created from an image.
+func SetMachineDefaults(spec *infrav1.VirtualMachineCloneSpec, icsCluster *infrav1.ICSCluster) {
+	if spec.CloudName == "" {
+		spec.CloudName = icsCluster.Spec.CloudName
+	}
+	if spec.IdentityRef == nil && icsCluster.Spec.IdentityRef != nil {
+		spec.IdentityRef = icsCluster.Spec.IdentityRef.DeepCopy()
+	}
+
+	defaults := icsCluster.Spec.MachineDefaults
+	if defaults == nil {
+		return
+	}
+	if spec.Datacenter == "" {
+		spec.Datacenter = defaults.Datacenter
+	}
+	if spec.Cluster == "" {
+		spec.Cluster = defaults.Cluster
+	}
+	if spec.Datastore == "" && spec.DatastoreSelector == nil {
+		spec.Datastore = defaults.Datastore
+	}
+	if spec.Template == "" && spec.ImageRef == nil {
+		spec.Template = defaults.Template
+	}
+	if len(spec.Network.Devices) == 0 && icsCluster.Spec.Network == nil {
+		for i := range defaults.NetworkDevices {
+			spec.Network.Devices = append(spec.Network.Devices, *defaults.NetworkDevices[i].DeepCopy())
+		}
+	}
+}
+
+// SetClusterDefaults sets the properties of the clone spec of a machine that
+// are left empty to the ones derived from the ICSCluster: the ones of
+// SetMachineDefaults, the cluster network and the managed security group of
+// the role of the machine.
+func SetClusterDefaults(spec *infrav1.VirtualMachineCloneSpec, icsCluster *infrav1.ICSCluster, controlPlane bool) {
+	SetMachineDefaults(spec, icsCluster)
+	if len(spec.Network.Devices) == 0 && icsCluster.Status.Network != nil {
+		spec.Network.Devices = []infrav1.NetworkDeviceSpec{clusterNetworkDevice(icsCluster.Status.Network)}
+	}
+	if groupID := managedSecurityGroupID(icsCluster, controlPlane); groupID != "" {
+		for i := range spec.Network.Devices {
+			device := &spec.Network.Devices[i]
+			if isSDNDevice(device) && len(device.SecurityGroups) == 0 {
+				device.SecurityGroups = []string{groupID}
+			}
+		}
+	}
+}
+
+// managedSecurityGroupID returns the ID of the managed security group of the
+// role of the machine, or an empty string if the cluster has none.
+func managedSecurityGroupID(icsCluster *infrav1.ICSCluster, controlPlane bool) string {
+	groups := icsCluster.Status.SecurityGroups
+	if groups == nil {
+		return ""
+	}
+	group := groups.Worker
+	if controlPlane {
+		group = groups.ControlPlane
+	}
+	if group == nil {
+		return ""
+	}
+	return group.ID
+}
+
+// isSDNDevice returns true if the device is connected to an SDN network.
+func isSDNDevice(device *infrav1.NetworkDeviceSpec) bool {
+	return device.SwitchType == infrav1.SDNSwitch || device.SwitchType == infrav1.ExtSDNSwitch
+}
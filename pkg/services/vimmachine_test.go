@@ -0,0 +1,226 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+func newDefaultsICSCluster() *infrav1.ICSCluster {
+	return &infrav1.ICSCluster{
+		Spec: infrav1.ICSClusterSpec{
+			CloudName:   "cluster-cloud",
+			IdentityRef: &infrav1.ICSIdentityReference{Kind: infrav1.SecretKind, Name: "cluster-identity"},
+			MachineDefaults: &infrav1.MachineDefaults{
+				Datacenter: "dc-1",
+				Cluster:    "cluster-1",
+				Datastore:  "fast",
+				Template:   "ubuntu",
+				NetworkDevices: []infrav1.NetworkDeviceSpec{
+					{NetworkName: "vlan10", SwitchType: infrav1.SDNSwitch},
+				},
+			},
+		},
+	}
+}
+
+func TestSetMachineDefaults(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       infrav1.VirtualMachineCloneSpec
+		icsCluster func(icsCluster *infrav1.ICSCluster)
+		expected   infrav1.VirtualMachineCloneSpec
+	}{
+		{
+			name: "empty spec",
+			expected: infrav1.VirtualMachineCloneSpec{
+				CloudName:   "cluster-cloud",
+				IdentityRef: &infrav1.ICSIdentityReference{Kind: infrav1.SecretKind, Name: "cluster-identity"},
+				Datacenter:  "dc-1",
+				Cluster:     "cluster-1",
+				Datastore:   "fast",
+				Template:    "ubuntu",
+				Network: infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{
+					{NetworkName: "vlan10", SwitchType: infrav1.SDNSwitch},
+				}},
+			},
+		},
+		{
+			name: "spec takes precedence",
+			spec: infrav1.VirtualMachineCloneSpec{
+				CloudName:   "machine-cloud",
+				IdentityRef: &infrav1.ICSIdentityReference{Kind: infrav1.SecretKind, Name: "machine-identity"},
+				Datacenter:  "dc-2",
+				Cluster:     "cluster-2",
+				Datastore:   "slow",
+				Template:    "centos",
+				Network: infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{
+					{NetworkName: "vlan20"},
+				}},
+			},
+			expected: infrav1.VirtualMachineCloneSpec{
+				CloudName:   "machine-cloud",
+				IdentityRef: &infrav1.ICSIdentityReference{Kind: infrav1.SecretKind, Name: "machine-identity"},
+				Datacenter:  "dc-2",
+				Cluster:     "cluster-2",
+				Datastore:   "slow",
+				Template:    "centos",
+				Network: infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{
+					{NetworkName: "vlan20"},
+				}},
+			},
+		},
+		{
+			name: "datastore selector and image",
+			spec: infrav1.VirtualMachineCloneSpec{
+				DatastoreSelector: &infrav1.DatastoreSelector{},
+				ImageRef:          &corev1.LocalObjectReference{Name: "ubuntu-image"},
+			},
+			expected: infrav1.VirtualMachineCloneSpec{
+				CloudName:         "cluster-cloud",
+				IdentityRef:       &infrav1.ICSIdentityReference{Kind: infrav1.SecretKind, Name: "cluster-identity"},
+				Datacenter:        "dc-1",
+				Cluster:           "cluster-1",
+				DatastoreSelector: &infrav1.DatastoreSelector{},
+				ImageRef:          &corev1.LocalObjectReference{Name: "ubuntu-image"},
+				Network: infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{
+					{NetworkName: "vlan10", SwitchType: infrav1.SDNSwitch},
+				}},
+			},
+		},
+		{
+			name: "cluster network instead of the default devices",
+			icsCluster: func(icsCluster *infrav1.ICSCluster) {
+				icsCluster.Spec.Network = &infrav1.ClusterNetworkSpec{CIDR: "10.6.0.0/24"}
+			},
+			expected: infrav1.VirtualMachineCloneSpec{
+				CloudName:   "cluster-cloud",
+				IdentityRef: &infrav1.ICSIdentityReference{Kind: infrav1.SecretKind, Name: "cluster-identity"},
+				Datacenter:  "dc-1",
+				Cluster:     "cluster-1",
+				Datastore:   "fast",
+				Template:    "ubuntu",
+			},
+		},
+		{
+			name: "no machine defaults",
+			icsCluster: func(icsCluster *infrav1.ICSCluster) {
+				icsCluster.Spec.MachineDefaults = nil
+			},
+			expected: infrav1.VirtualMachineCloneSpec{
+				CloudName:   "cluster-cloud",
+				IdentityRef: &infrav1.ICSIdentityReference{Kind: infrav1.SecretKind, Name: "cluster-identity"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			icsCluster := newDefaultsICSCluster()
+			if tc.icsCluster != nil {
+				tc.icsCluster(icsCluster)
+			}
+			spec := tc.spec.DeepCopy()
+			SetMachineDefaults(spec, icsCluster)
+			if !reflect.DeepEqual(*spec, tc.expected) {
+				t.Errorf("got spec %+v, want %+v", *spec, tc.expected)
+			}
+		})
+	}
+}
+
+func TestSetMachineDefaultsDoesNotShareCluster(t *testing.T) {
+	icsCluster := newDefaultsICSCluster()
+	spec := &infrav1.VirtualMachineCloneSpec{}
+	SetMachineDefaults(spec, icsCluster)
+
+	spec.IdentityRef.Name = "changed"
+	spec.Network.Devices[0].NetworkName = "changed"
+	if icsCluster.Spec.IdentityRef.Name != "cluster-identity" || icsCluster.Spec.MachineDefaults.NetworkDevices[0].NetworkName != "vlan10" {
+		t.Error("expected the defaults to be copied from the ICSCluster")
+	}
+}
+
+func TestSetClusterDefaults(t *testing.T) {
+	icsCluster := newDefaultsICSCluster()
+	icsCluster.Spec.MachineDefaults.NetworkDevices = nil
+	icsCluster.Spec.Network = &infrav1.ClusterNetworkSpec{CIDR: "10.6.0.0/24"}
+	icsCluster.Status.Network = &infrav1.ClusterNetworkStatus{NetworkID: "net-1", NetworkName: "cluster-net", SubnetID: "subnet-1", SubnetName: "cluster-subnet"}
+	icsCluster.Status.SecurityGroups = &infrav1.ClusterSecurityGroupsStatus{
+		ControlPlane: &infrav1.SecurityGroupStatus{ID: "sg-cp"},
+		Worker:       &infrav1.SecurityGroupStatus{ID: "sg-worker"},
+	}
+
+	testCases := []struct {
+		name           string
+		spec           infrav1.VirtualMachineCloneSpec
+		controlPlane   bool
+		expectedNet    string
+		expectedGroups []string
+	}{
+		{
+			name:           "control plane on the cluster network",
+			controlPlane:   true,
+			expectedNet:    "cluster-net",
+			expectedGroups: []string{"sg-cp"},
+		},
+		{
+			name:           "worker on the cluster network",
+			expectedNet:    "cluster-net",
+			expectedGroups: []string{"sg-worker"},
+		},
+		{
+			name: "security groups of the spec",
+			spec: infrav1.VirtualMachineCloneSpec{Network: infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{
+				{NetworkName: "vlan20", SwitchType: infrav1.SDNSwitch, SecurityGroups: []string{"sg-own"}},
+			}}},
+			expectedNet:    "vlan20",
+			expectedGroups: []string{"sg-own"},
+		},
+		{
+			name: "device of a normal switch",
+			spec: infrav1.VirtualMachineCloneSpec{Network: infrav1.NetworkSpec{Devices: []infrav1.NetworkDeviceSpec{
+				{NetworkName: "vlan30"},
+			}}},
+			expectedNet: "vlan30",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			spec := tc.spec.DeepCopy()
+			SetClusterDefaults(spec, icsCluster, tc.controlPlane)
+			if len(spec.Network.Devices) != 1 {
+				t.Fatalf("got devices %+v, want one", spec.Network.Devices)
+			}
+			device := spec.Network.Devices[0]
+			if device.NetworkName != tc.expectedNet {
+				t.Errorf("got network %q, want %q", device.NetworkName, tc.expectedNet)
+			}
+			if !reflect.DeepEqual(device.SecurityGroups, tc.expectedGroups) {
+				t.Errorf("got security groups %v, want %v", device.SecurityGroups, tc.expectedGroups)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package services
+
+import (
+	goctx "context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+// GetVMImage returns the ICSVMImage the clone spec references.
+func GetVMImage(ctx goctx.Context, c client.Client, namespace string, spec *infrav1.VirtualMachineCloneSpec) (*infrav1.ICSVMImage, error) {
+	image := &infrav1.ICSVMImage{}
+	key := client.ObjectKey{Namespace: namespace, Name: spec.ImageRef.Name}
+	if err := c.Get(ctx, key, image); err != nil {
+		return nil, err
+	}
+	return image, nil
+}
+
+// SetVMImageSource sets the template of the clone spec to the template of
+// the ready image, or to its OVA image with the ImportVM clone mode when the
+// image was not converted into a template.
+func SetVMImageSource(spec *infrav1.VirtualMachineCloneSpec, image *infrav1.ICSVMImage) {
+	if image.Status.TemplateName != "" {
+		spec.Template = image.Status.TemplateName
+		if spec.CloneMode == infrav1.ImportVM {
+			spec.CloneMode = ""
+		}
+		return
+	}
+	spec.Template = image.Status.ImageName
+	spec.CloneMode = infrav1.ImportVM
+}
@@ -51,3 +51,28 @@ func GetICSClusterFromICSMachine(ctx context.Context, c client.Client, machine *
 	err := c.Get(ctx, icsClusterKey, icsCluster)
 	return icsCluster, err
 }
+
+// GetICSClusterFromObject gets the infrastructure.cluster.x-k8s.io.ICSCluster
+// resource of the cluster the given object is labeled with.
+func GetICSClusterFromObject(ctx context.Context, c client.Client, obj client.Object) (*infrav1.ICSCluster, error) {
+	clusterName := obj.GetLabels()[clusterv1.ClusterLabelName]
+	if clusterName == "" {
+		return nil, errors.Errorf("error getting cluster name from %s/%s",
+			obj.GetNamespace(), obj.GetName())
+	}
+	cluster := &clusterv1.Cluster{}
+	if err := c.Get(ctx, apitypes.NamespacedName{Namespace: obj.GetNamespace(), Name: clusterName}, cluster); err != nil {
+		return nil, err
+	}
+	if cluster.Spec.InfrastructureRef == nil {
+		return nil, errors.Errorf("cluster %s/%s has no infrastructure", cluster.Namespace, cluster.Name)
+	}
+
+	icsClusterKey := apitypes.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	icsCluster := &infrav1.ICSCluster{}
+	err := c.Get(ctx, icsClusterKey, icsCluster)
+	return icsCluster, err
+}
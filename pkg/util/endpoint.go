@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	kubeadmtypes "sigs.k8s.io/cluster-api/bootstrap/kubeadm/types"
+	"sigs.k8s.io/cluster-api/util/version"
+)
+
+// EndpointAddress returns the host and port of the endpoint, with IPv6
+// addresses in brackets.
+func EndpointAddress(endpoint clusterv1.APIEndpoint) string {
+	return net.JoinHostPort(endpoint.Host, strconv.Itoa(int(endpoint.Port)))
+}
+
+// EndpointURL returns the URL of the API server at the endpoint.
+func EndpointURL(endpoint clusterv1.APIEndpoint) string {
+	return "https://" + EndpointAddress(endpoint)
+}
+
+// SetKubeconfigServer sets the server of every cluster of the kubeconfig.
+// It returns the kubeconfig and true if it was changed. The kubeconfig is
+// returned as is when it already points to the server.
+func SetKubeconfigServer(kubeconfig []byte, server string) ([]byte, bool, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to parse kubeconfig")
+	}
+	if len(config.Clusters) == 0 {
+		return nil, false, errors.New("kubeconfig has no clusters")
+	}
+	changed := false
+	for _, cluster := range config.Clusters {
+		if cluster.Server != server {
+			cluster.Server = server
+			changed = true
+		}
+	}
+	if !changed {
+		return kubeconfig, false, nil
+	}
+	out, err := clientcmd.Write(*config)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to encode kubeconfig")
+	}
+	return out, true, nil
+}
+
+// SetClusterConfigurationEndpoint sets the control plane endpoint of a kubeadm
+// ClusterConfiguration of any of the kubeadm API versions. The configuration
+// is written back in the API version of its Kubernetes version. It returns
+// the configuration and true if it was changed.
+func SetClusterConfigurationEndpoint(clusterConfiguration, endpoint string) (string, bool, error) {
+	config, err := kubeadmtypes.UnmarshalClusterConfiguration(clusterConfiguration)
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to parse kubeadm ClusterConfiguration")
+	}
+	if config.ControlPlaneEndpoint == endpoint {
+		return clusterConfiguration, false, nil
+	}
+	kubernetesVersion, err := version.ParseMajorMinorPatchTolerant(config.KubernetesVersion)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "failed to parse kubernetes version %q of kubeadm ClusterConfiguration", config.KubernetesVersion)
+	}
+	config.ControlPlaneEndpoint = endpoint
+	out, err := kubeadmtypes.MarshalClusterConfigurationForVersion(config, kubernetesVersion)
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to encode kubeadm ClusterConfiguration")
+	}
+	return out, true, nil
+}
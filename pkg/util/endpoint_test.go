@@ -0,0 +1,223 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	kubeadmtypes "sigs.k8s.io/cluster-api/bootstrap/kubeadm/types"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    certificate-authority-data: Y2E=
+    server: https://127.0.0.1:6443
+  name: test
+contexts:
+- context:
+    cluster: test
+    user: test-admin
+  name: test-admin@test
+current-context: test-admin@test
+users:
+- name: test-admin
+  user:
+    token: secret
+`
+
+func TestEndpointURL(t *testing.T) {
+	testCases := []struct {
+		name     string
+		endpoint clusterv1.APIEndpoint
+		expected string
+	}{
+		{
+			name:     "ipv4",
+			endpoint: clusterv1.APIEndpoint{Host: "10.0.0.10", Port: 6443},
+			expected: "https://10.0.0.10:6443",
+		},
+		{
+			name:     "ipv6",
+			endpoint: clusterv1.APIEndpoint{Host: "fd00::10", Port: 6443},
+			expected: "https://[fd00::10]:6443",
+		},
+		{
+			name:     "host name",
+			endpoint: clusterv1.APIEndpoint{Host: "api.example.com", Port: 443},
+			expected: "https://api.example.com:443",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := EndpointURL(tc.endpoint); actual != tc.expected {
+				t.Errorf("got %q, want %q", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestSetKubeconfigServer(t *testing.T) {
+	testCases := []struct {
+		name       string
+		kubeconfig string
+		server     string
+		changed    bool
+		expectErr  bool
+	}{
+		{
+			name:       "rewrites the server",
+			kubeconfig: testKubeconfig,
+			server:     "https://10.0.0.10:6443",
+			changed:    true,
+		},
+		{
+			name:       "already points to the server",
+			kubeconfig: testKubeconfig,
+			server:     "https://127.0.0.1:6443",
+		},
+		{
+			name:       "no clusters",
+			kubeconfig: "apiVersion: v1\nkind: Config\n",
+			server:     "https://10.0.0.10:6443",
+			expectErr:  true,
+		},
+		{
+			name:       "not a kubeconfig",
+			kubeconfig: "clusters: [",
+			server:     "https://10.0.0.10:6443",
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, changed, err := SetKubeconfigServer([]byte(tc.kubeconfig), tc.server)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if changed != tc.changed {
+				t.Errorf("got changed %t, want %t", changed, tc.changed)
+			}
+			if !changed && string(out) != tc.kubeconfig {
+				t.Error("expected an unchanged kubeconfig to be returned as is")
+			}
+			config, err := clientcmd.Load(out)
+			if err != nil {
+				t.Fatalf("output is not a kubeconfig: %v", err)
+			}
+			cluster := config.Clusters["test"]
+			if cluster == nil || cluster.Server != tc.server {
+				t.Fatalf("got cluster %+v, want server %q", cluster, tc.server)
+			}
+			if string(cluster.CertificateAuthorityData) != "ca" || config.AuthInfos["test-admin"].Token != "secret" {
+				t.Error("expected the credentials of the kubeconfig to be kept")
+			}
+		})
+	}
+}
+
+func TestSetClusterConfigurationEndpoint(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     string
+		endpoint   string
+		apiVersion string
+		changed    bool
+		expectErr  bool
+	}{
+		{
+			name:       "v1beta2",
+			config:     "apiVersion: kubeadm.k8s.io/v1beta2\nkind: ClusterConfiguration\nkubernetesVersion: v1.21.2\ncontrolPlaneEndpoint: 127.0.0.1:6443\nclusterName: test\n",
+			endpoint:   "10.0.0.10:6443",
+			apiVersion: "kubeadm.k8s.io/v1beta2",
+			changed:    true,
+		},
+		{
+			name:       "v1beta3",
+			config:     "apiVersion: kubeadm.k8s.io/v1beta3\nkind: ClusterConfiguration\nkubernetesVersion: v1.23.5\ncontrolPlaneEndpoint: 127.0.0.1:6443\nclusterName: test\n",
+			endpoint:   "[fd00::10]:6443",
+			apiVersion: "kubeadm.k8s.io/v1beta3",
+			changed:    true,
+		},
+		{
+			name:     "already set",
+			config:   "apiVersion: kubeadm.k8s.io/v1beta3\nkind: ClusterConfiguration\nkubernetesVersion: v1.23.5\ncontrolPlaneEndpoint: 10.0.0.10:6443\n",
+			endpoint: "10.0.0.10:6443",
+		},
+		{
+			name:      "missing kubernetes version",
+			config:    "apiVersion: kubeadm.k8s.io/v1beta3\nkind: ClusterConfiguration\ncontrolPlaneEndpoint: 127.0.0.1:6443\n",
+			endpoint:  "10.0.0.10:6443",
+			expectErr: true,
+		},
+		{
+			name:      "not a ClusterConfiguration",
+			config:    "controlPlaneEndpoint: [",
+			endpoint:  "10.0.0.10:6443",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, changed, err := SetClusterConfigurationEndpoint(tc.config, tc.endpoint)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if changed != tc.changed {
+				t.Errorf("got changed %t, want %t", changed, tc.changed)
+			}
+			if !changed {
+				if out != tc.config {
+					t.Error("expected an unchanged configuration to be returned as is")
+				}
+				return
+			}
+			if !strings.Contains(out, "apiVersion: "+tc.apiVersion) {
+				t.Errorf("got %q, want it written as %s", out, tc.apiVersion)
+			}
+			config, err := kubeadmtypes.UnmarshalClusterConfiguration(out)
+			if err != nil {
+				t.Fatalf("output is not a ClusterConfiguration: %v", err)
+			}
+			if config.ControlPlaneEndpoint != tc.endpoint || config.ClusterName != "test" {
+				t.Errorf("got endpoint %q and cluster name %q, want %q and test", config.ControlPlaneEndpoint, config.ClusterName, tc.endpoint)
+			}
+		})
+	}
+}
@@ -180,6 +180,45 @@ func GetIPAddressByCIDR(cidr string, filter map[string]string) (net.IP, error) {
 	return nil, nil
 }
 
+// GetFreeIPFromPool returns the first address of the pool that is not in
+// the filter. The pool holds IP addresses and CIDRs; the network and
+// broadcast addresses of IPv4 CIDRs are never returned.
+func GetFreeIPFromPool(pool []string, filter map[string]string) (string, error) {
+	for _, entry := range pool {
+		if ip := net.ParseIP(entry); ip != nil {
+			if _, ok := filter[ip.String()]; !ok {
+				return ip.String(), nil
+			}
+			continue
+		}
+		ip, ipnet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid address pool entry %q", entry)
+		}
+		ones, bits := ipnet.Mask.Size()
+		skipEnds := ip.To4() != nil && bits-ones > 1
+		for ip := ip.Mask(ipnet.Mask); ipnet.Contains(ip); addOffsetToIP(ip) {
+			if skipEnds && (ip.Equal(ipnet.IP) || isBroadcast(ip, ipnet)) {
+				continue
+			}
+			if _, ok := filter[ip.String()]; !ok {
+				return ip.String(), nil
+			}
+		}
+	}
+	return "", errors.New("no free address left in the pool")
+}
+
+func isBroadcast(ip net.IP, ipnet *net.IPNet) bool {
+	ip4 := ip.To4()
+	for i := range ip4 {
+		if ip4[i]|ipnet.Mask[len(ipnet.Mask)-len(ip4)+i] != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
 // addOffsetToIP computes the value of the IP address with the offset.
 func addOffsetToIP(ip net.IP) {
 	for j := len(ip) - 1; j >= 0; j-- {
@@ -80,6 +80,28 @@ func GetICSMachinesInCluster(ctx context.Context, controllerClient client.Client
 	return machines, nil
 }
 
+// GetICSVMsInCluster gets a cluster's ICSVM resources, including the ones of
+// machine pools and load balancers that have no ICSMachine.
+func GetICSVMsInCluster(ctx context.Context, controllerClient client.Client,
+	namespace, clusterName string) ([]*infrav1.ICSVM, error) {
+	labels := map[string]string{clusterv1.ClusterLabelName: clusterName}
+	vmList := &infrav1.ICSVMList{}
+
+	if err := controllerClient.List(
+		ctx, vmList,
+		client.InNamespace(namespace),
+		client.MatchingLabels(labels)); err != nil {
+		return nil, err
+	}
+
+	vms := make([]*infrav1.ICSVM, len(vmList.Items))
+	for i := range vmList.Items {
+		vms[i] = &vmList.Items[i]
+	}
+
+	return vms, nil
+}
+
 // GetControlPlaneICSMachinesInCluster gets a cluster's ICSMachine resources.
 func GetControlPlaneICSMachinesInCluster(ctx context.Context, controllerClient client.Client,
 	namespace, clusterName string) ([]*infrav1.ICSMachine, error) {
@@ -140,6 +162,35 @@ func IsControlPlaneMachine(machine metav1.Object) bool {
 	return ok
 }
 
+// IsLoadBalancerVM returns true if the ICSVM is the VM of an
+// ICSHAProxyLoadBalancer.
+func IsLoadBalancerVM(icsVM *infrav1.ICSVM) bool {
+	for _, ref := range icsVM.OwnerReferences {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			continue
+		}
+		if ref.Kind == "ICSHAProxyLoadBalancer" && gv.Group == infrav1.GroupVersion.Group {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMachinePoolVM returns true if the ICSVM is a VM of an ICSMachinePool.
+func IsMachinePoolVM(icsVM *infrav1.ICSVM) bool {
+	for _, ref := range icsVM.OwnerReferences {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			continue
+		}
+		if ref.Kind == "ICSMachinePool" && gv.Group == infrav1.GroupVersion.Group {
+			return true
+		}
+	}
+	return false
+}
+
 // GetMachineMetadata returns the cloud-init metadata as a base-64 encoded
 // string for a given ICSMachine.
 func GetMachineMetadata(hostname string, icsVM infrav1.ICSVM, networkStatuses ...infrav1.NetworkStatus) ([]byte, error) {
@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1 "github.com/ics-sigs/cluster-api-provider-ics/api/v1beta1"
+)
+
+// vmOwnerPrefix starts the line of a VM description that records which
+// ICSVM created the VM.
+const vmOwnerPrefix = "capics-owner:"
+
+// Roles recorded in the ownership metadata of a VM.
+const (
+	VMRoleControlPlane = "control-plane"
+	VMRoleWorker       = "worker"
+	VMRoleLoadBalancer = "load-balancer"
+)
+
+// VMOwner identifies the ICSVM resource that created a VM in ICS.
+type VMOwner struct {
+	Cluster   string
+	Namespace string
+	Name      string
+	UID       string
+	Role      string
+	// Retained is set on VMs that were kept on purpose when their ICSVM was
+	// deleted, so that they are not collected as orphans.
+	Retained bool
+}
+
+// VMOwnerFor returns the VMOwner for the given ICSVM.
+func VMOwnerFor(icsVM *infrav1.ICSVM) VMOwner {
+	role := VMRoleWorker
+	if IsControlPlaneMachine(icsVM) {
+		role = VMRoleControlPlane
+	} else if IsLoadBalancerVM(icsVM) {
+		role = VMRoleLoadBalancer
+	}
+	return VMOwner{
+		Cluster:   icsVM.Labels[clusterv1.ClusterLabelName],
+		Namespace: icsVM.Namespace,
+		Name:      icsVM.Name,
+		UID:       string(icsVM.UID),
+		Role:      role,
+	}
+}
+
+// Description returns the ownership line written to the description of a
+// VM when it is created.
+func (o VMOwner) Description() string {
+	description := fmt.Sprintf("%scluster=%s,namespace=%s,name=%s,uid=%s,role=%s",
+		vmOwnerPrefix, o.Cluster, o.Namespace, o.Name, o.UID, o.Role)
+	if o.Retained {
+		description += ",retained=true"
+	}
+	return description
+}
+
+// SetVMOwnerDescription returns the description with its ownership line
+// replaced by the one of the given owner. Any other text in the description
+// is kept.
+func SetVMOwnerDescription(description string, owner VMOwner) string {
+	lines := []string{owner.Description()}
+	for _, line := range strings.Split(description, "\n") {
+		if line == "" || strings.HasPrefix(strings.TrimSpace(line), vmOwnerPrefix) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ParseVMOwner extracts the VMOwner from the description of a VM. It returns
+// false if the VM was not created by this provider.
+func ParseVMOwner(description string) (VMOwner, bool) {
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, vmOwnerPrefix) {
+			continue
+		}
+		owner := VMOwner{}
+		for _, pair := range strings.Split(strings.TrimPrefix(line, vmOwnerPrefix), ",") {
+			kv := strings.SplitN(pair, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			switch kv[0] {
+			case "cluster":
+				owner.Cluster = kv[1]
+			case "namespace":
+				owner.Namespace = kv[1]
+			case "name":
+				owner.Name = kv[1]
+			case "uid":
+				owner.UID = kv[1]
+			case "role":
+				owner.Role = kv[1]
+			case "retained":
+				owner.Retained = kv[1] == "true"
+			}
+		}
+		return owner, owner.UID != ""
+	}
+	return VMOwner{}, false
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseVMOwner(t *testing.T) {
+	testCases := []struct {
+		name        string
+		description string
+		want        VMOwner
+		wantOK      bool
+	}{
+		{
+			name:        "ownership line",
+			description: "capics-owner:cluster=c1,namespace=default,name=vm-0,uid=1234,role=worker",
+			want:        VMOwner{Cluster: "c1", Namespace: "default", Name: "vm-0", UID: "1234", Role: VMRoleWorker},
+			wantOK:      true,
+		},
+		{
+			name:        "ownership line among other text",
+			description: "notes\n  capics-owner:uid=1234,role=control-plane,retained=true  \nmore notes",
+			want:        VMOwner{UID: "1234", Role: VMRoleControlPlane, Retained: true},
+			wantOK:      true,
+		},
+		{
+			name:        "unknown and malformed pairs",
+			description: "capics-owner:uid=1234,zone=a,broken,name=vm=0",
+			want:        VMOwner{UID: "1234", Name: "vm=0"},
+			wantOK:      true,
+		},
+		{
+			name:        "ownership line without uid",
+			description: "capics-owner:cluster=c1,name=vm-0",
+			want:        VMOwner{Cluster: "c1", Name: "vm-0"},
+		},
+		{
+			name:        "no ownership line",
+			description: "created by hand",
+		},
+		{
+			name: "empty description",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := ParseVMOwner(tc.description)
+			if ok != tc.wantOK || got != tc.want {
+				t.Errorf("got %+v, %t, want %+v, %t", got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestSetVMOwnerDescription(t *testing.T) {
+	owner := VMOwner{Cluster: "c1", Namespace: "default", Name: "vm-0", UID: "1234", Role: VMRoleWorker}
+	stale := owner
+	stale.UID = "5678"
+
+	testCases := []struct {
+		name        string
+		description string
+		want        string
+	}{
+		{
+			name: "empty description",
+			want: owner.Description(),
+		},
+		{
+			name:        "other text is kept",
+			description: "notes\n\nmore notes",
+			want:        owner.Description() + "\nnotes\nmore notes",
+		},
+		{
+			name:        "stale ownership line is replaced",
+			description: "notes\n" + stale.Description(),
+			want:        owner.Description() + "\nnotes",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := SetVMOwnerDescription(tc.description, owner)
+			if got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+			if parsed, ok := ParseVMOwner(got); !ok || parsed != owner {
+				t.Errorf("got owner %+v from %q, want %+v", parsed, got, owner)
+			}
+		})
+	}
+}
+
+func TestVMOwnerDescriptionRetained(t *testing.T) {
+	owner := VMOwner{UID: "1234"}
+	if strings.Contains(owner.Description(), "retained") {
+		t.Errorf("description %q of a vm that is not retained mentions retention", owner.Description())
+	}
+	owner.Retained = true
+	if parsed, ok := ParseVMOwner(owner.Description()); !ok || !parsed.Retained {
+		t.Errorf("got owner %+v from %q, want it retained", parsed, owner.Description())
+	}
+}